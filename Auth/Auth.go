@@ -1,107 +1,294 @@
 package auth
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
-	"fmt"
+	"log"
 	"strings"
 	"time"
 
 	"Adornme/config"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 var cfg = config.LoadConfig()
 
-var jwtSecret = []byte(cfg.JWTSecret)
-var refreshSecret = []byte(cfg.RefreshSecret)
+// keyRing signs and verifies every token; LoadKeys sets it at startup
+var keyRing *KeyRing
+
+// Token use values; access and refresh tokens share the key ring, so the
+// claim keeps one from being accepted in place of the other.
+const (
+	tokenUseAccess    = "access"
+	tokenUseRefresh   = "refresh"
+	tokenUseMFA       = "mfa_pending"
+	tokenUseMagicLink = "magic_link"
+)
+
+// MFATokenTTL is how long a password-verified login may wait for its second factor
+const MFATokenTTL = 5 * time.Minute
+
+// LoadKeys makes the key ring configured in c the process-wide one.
+// The server calls it once at startup, before any token is signed or parsed.
+func LoadKeys(c *config.Config) error {
+	ring, err := keyRingFor(c)
+	if err != nil {
+		return err
+	}
+	keyRing = ring
+	return nil
+}
+
+// keyRingFor loads the configured key files. Without any, dev mode signs with
+// an ephemeral key and every other mode refuses to start.
+func keyRingFor(c *config.Config) (*KeyRing, error) {
+	if len(c.JWTKeyFiles) == 0 {
+		if !c.DevMode {
+			return nil, ErrNoSigningKeys
+		}
+		log.Println("JWT_KEY_FILES not set, signing with an ephemeral Ed25519 key (dev mode)")
+		return ephemeralKeyRing(), nil
+	}
+	return LoadKeyRing(c.JWTKeyFiles, c.JWTSigningKID)
+}
 
 type AuthClaims struct {
-	UserID string `json:"user_id"`
+	UserID    string   `json:"user_id"`
+	TokenUse  string   `json:"token_use"`
+	SessionID string   `json:"sid,omitempty"`
+	FamilyID  string   `json:"fid,omitempty"` // refresh tokens only: rotation family
+	Roles     []string `json:"roles,omitempty"`
+	Act       *Actor   `json:"act,omitempty"` // set while a support agent impersonates the user
 	jwt.RegisteredClaims
 }
 
+// Actor is the RFC 8693 "act" claim: who is really behind the token
+type Actor struct {
+	Subject string `json:"sub"`
+}
+
+// RefreshTokenTTL is how long a refresh token (and its session) stays valid
+func RefreshTokenTTL() time.Duration {
+	return time.Duration(cfg.RefreshTokenExpiryDays) * 24 * time.Hour
+}
+
+// AccessTokenTTL is how long an access token stays valid
+func AccessTokenTTL() time.Duration {
+	return time.Duration(cfg.AccessTokenExpiryHours) * time.Hour
+}
+
 // 🔹 Generate Access Token
-func GenerateToken(userID string) (string, error) {
+// The JTI lets a single access token be denylisted before it expires.
+func GenerateToken(userID string, sessionID string, roles []string) (token string, jti string, err error) {
 	if userID == "" {
-		return "", errors.New("userID cannot be empty")
+		return "", "", errors.New("userID cannot be empty")
+	}
+
+	jti = uuid.New().String()
+	claims := AuthClaims{
+		UserID:    userID,
+		TokenUse:  tokenUseAccess,
+		SessionID: sessionID,
+		Roles:     roles,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(AccessTokenTTL())), // ✅ FIXED
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Issuer:    "Adornme.in",
+			Subject:   userID,
+		},
 	}
 
+	token, err = keyRing.sign(claims)
+	if err != nil {
+		return "", "", err
+	}
+	return token, jti, nil
+}
+
+// 🔹 Generate Impersonation Token
+// An access token for the target user carrying an act claim naming the agent.
+// It belongs to no session and cannot be refreshed.
+func GenerateImpersonationToken(userID string, agentID string, roles []string, ttl time.Duration) (token string, jti string, err error) {
+	if userID == "" || agentID == "" {
+		return "", "", errors.New("userID and agentID cannot be empty")
+	}
+
+	jti = uuid.New().String()
 	claims := AuthClaims{
-		UserID: userID,
+		UserID:   userID,
+		TokenUse: tokenUseAccess,
+		Roles:    roles,
+		Act:      &Actor{Subject: agentID},
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(cfg.AccessTokenExpiryHours) * time.Hour)), // ✅ FIXED
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    "Adornme.in",
 			Subject:   userID,
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(jwtSecret)
+	token, err = keyRing.sign(claims)
+	if err != nil {
+		return "", "", err
+	}
+	return token, jti, nil
 }
 
 // 🔹 Generate Refresh Token
-func GenerateRefreshToken(userID string) (string, error) {
+// Every refresh token gets a fresh JTI; rotated tokens keep the family ID of
+// the token they replace so reuse of an old one can be traced to its family.
+func GenerateRefreshToken(userID string, sessionID string, familyID string) (token string, jti string, err error) {
+	jti = uuid.New().String()
 	claims := AuthClaims{
-		UserID: userID,
+		UserID:    userID,
+		TokenUse:  tokenUseRefresh,
+		SessionID: sessionID,
+		FamilyID:  familyID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(cfg.RefreshTokenExpiryDays) * 24 * time.Hour)),
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(RefreshTokenTTL())),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    "Adornme",
 			Subject:   userID,
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(refreshSecret)
+	token, err = keyRing.sign(claims)
+	if err != nil {
+		return "", "", err
+	}
+	return token, jti, nil
+}
+
+// 🔹 Generate MFA Pending Token
+// Proves the password step of a login; only /auth/mfa/* accept it.
+func GenerateMFAToken(userID string) (string, error) {
+	if userID == "" {
+		return "", errors.New("userID cannot be empty")
+	}
+
+	claims := AuthClaims{
+		UserID:   userID,
+		TokenUse: tokenUseMFA,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(MFATokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Issuer:    "Adornme",
+			Subject:   userID,
+		},
+	}
+
+	return keyRing.sign(claims)
+}
+
+// 🔹 Parse MFA Pending Token
+func ParseMFAToken(tokenString string) (*AuthClaims, error) {
+	return parseToken(strings.TrimSpace(tokenString), tokenUseMFA)
+}
+
+// 🔹 Generate Magic Link Token
+// Signed into an emailed sign-in link; the caller stores its hash so it works once.
+func GenerateMagicLinkToken(userID string, ttl time.Duration) (string, error) {
+	if userID == "" {
+		return "", errors.New("userID cannot be empty")
+	}
+
+	claims := AuthClaims{
+		UserID:   userID,
+		TokenUse: tokenUseMagicLink,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Issuer:    "Adornme",
+			Subject:   userID,
+		},
+	}
+
+	return keyRing.sign(claims)
+}
+
+// 🔹 Parse Magic Link Token
+func ParseMagicLinkToken(tokenString string) (*AuthClaims, error) {
+	return parseToken(strings.TrimSpace(tokenString), tokenUseMagicLink)
 }
 
 // 🔹 Validate Access Token
 func ValidateAccessToken(tokenString string) (string, error) {
 	token := extractToken(tokenString)
-	return validateToken(token, jwtSecret)
+	return validateToken(token, tokenUseAccess)
+}
+
+// 🔹 Parse Access Token (returns full claims incl. roles)
+func ParseAccessToken(tokenString string) (*AuthClaims, error) {
+	token := extractToken(tokenString)
+	return parseToken(token, tokenUseAccess)
 }
 
 // 🔹 Validate Refresh Token
 func ValidateRefreshToken(tokenString string) (string, error) {
-	return validateToken(strings.TrimSpace(tokenString), refreshSecret)
+	return validateToken(strings.TrimSpace(tokenString), tokenUseRefresh)
+}
+
+// 🔹 Parse Refresh Token (returns full claims incl. session ID)
+func ParseRefreshToken(tokenString string) (*AuthClaims, error) {
+	return parseToken(strings.TrimSpace(tokenString), tokenUseRefresh)
+}
+
+// 🔹 Hash Token (refresh tokens are stored hashed, never in clear)
+func HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
 }
 
 // 🔹 Core Validation
-func validateToken(tokenString string, secret []byte) (string, error) {
+func validateToken(tokenString string, use string) (string, error) {
+	claims, err := parseToken(tokenString, use)
+	if err != nil {
+		return "", err
+	}
+
+	return claims.UserID, nil
+}
+
+func parseToken(tokenString string, use string) (*AuthClaims, error) {
 	if tokenString == "" {
-		return "", errors.New("token is empty")
+		return nil, errors.New("token is empty")
 	}
 
-	token, err := jwt.ParseWithClaims(tokenString, &AuthClaims{}, func(t *jwt.Token) (interface{}, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
-		}
-		return secret, nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &AuthClaims{}, keyRing.keyFunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}))
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrSignatureInvalid) {
-			return "", errors.New("invalid token signature")
+			return nil, errors.New("invalid token signature")
 		}
 		if errors.Is(err, jwt.ErrTokenExpired) {
-			return "", errors.New("token expired")
+			return nil, errors.New("token expired")
 		}
-		return "", err
+		return nil, err
 	}
 
 	claims, ok := token.Claims.(*AuthClaims)
 	if !ok || !token.Valid {
-		return "", errors.New("invalid token")
+		return nil, errors.New("invalid token")
+	}
+
+	if claims.TokenUse != use {
+		return nil, errors.New("wrong token type")
 	}
 
 	if claims.UserID == "" {
-		return "", errors.New("user_id missing")
+		return nil, errors.New("user_id missing")
 	}
 
-	fmt.Println(claims.UserID)
-	return claims.UserID, nil
+	return claims, nil
 }
 
 // 🔹 Extract Bearer Token
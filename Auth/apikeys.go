@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+)
+
+// APIKeyPrefix starts every API key so leaked keys are easy to grep for
+const APIKeyPrefix = "ak_"
+
+// GenerateAPIKey returns a new key of the form ak_<8 hex>.<64 hex>, its public
+// prefix (ak_<8 hex>, stored in clear for lookup) and the hash to store.
+func GenerateAPIKey() (key string, prefix string, hash string, err error) {
+	id := make([]byte, 4)
+	secret := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", "", err
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", "", err
+	}
+
+	prefix = APIKeyPrefix + hex.EncodeToString(id)
+	key = prefix + "." + hex.EncodeToString(secret)
+	return key, prefix, HashToken(key), nil
+}
+
+// SplitAPIKey returns the public prefix of key, or false if key is malformed
+func SplitAPIKey(key string) (prefix string, ok bool) {
+	prefix, secret, ok := strings.Cut(strings.TrimSpace(key), ".")
+	if !ok || !strings.HasPrefix(prefix, APIKeyPrefix) || len(prefix) != len(APIKeyPrefix)+8 || len(secret) != 64 {
+		return "", false
+	}
+	return prefix, true
+}
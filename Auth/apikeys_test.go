@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateAPIKey(t *testing.T) {
+	key, prefix, hash, err := GenerateAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(key, prefix+".") {
+		t.Fatalf("key %q does not start with its prefix %q", key, prefix)
+	}
+	if hash != HashToken(key) {
+		t.Fatal("hash is not HashToken(key)")
+	}
+	if got, ok := SplitAPIKey(key); !ok || got != prefix {
+		t.Fatalf("SplitAPIKey(generated) = %q, %t; want %q", got, ok, prefix)
+	}
+
+	other, otherPrefix, _, err := GenerateAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == key || otherPrefix == prefix {
+		t.Fatal("two generated keys share a prefix or secret")
+	}
+}
+
+func TestSplitAPIKey(t *testing.T) {
+	secret := strings.Repeat("ab", 32)
+	tests := []struct {
+		name   string
+		key    string
+		prefix string
+		ok     bool
+	}{
+		{"valid", "ak_0123abcd." + secret, "ak_0123abcd", true},
+		{"surrounding space", " ak_0123abcd." + secret + "\n", "ak_0123abcd", true},
+		{"empty", "", "", false},
+		{"no secret", "ak_0123abcd", "", false},
+		{"short secret", "ak_0123abcd." + secret[:63], "", false},
+		{"long secret", "ak_0123abcd." + secret + "a", "", false},
+		{"short prefix", "ak_0123abc." + secret, "", false},
+		{"other scheme", "sk_0123abcd." + secret, "", false},
+		{"bearer token", "eyJhbGciOi.eyJzdWIiOi", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix, ok := SplitAPIKey(tt.key)
+			if prefix != tt.prefix || ok != tt.ok {
+				t.Fatalf("SplitAPIKey(%q) = %q, %t; want %q, %t", tt.key, prefix, ok, tt.prefix, tt.ok)
+			}
+		})
+	}
+}
+
+func TestScopeAllows(t *testing.T) {
+	tests := []struct {
+		op     string
+		scopes []string
+		want   bool
+	}{
+		{"listUsers", []string{ScopeUsersRead}, true},
+		{"listUsers", []string{ScopeUsersWrite}, false},
+		{"updateUser", []string{ScopeUsersRead, ScopeUsersWrite}, true},
+		{"createVariant", []string{ScopeProductsWrite}, true},
+		{"createVariant", []string{ScopeUsersWrite}, false},
+		{"listUsers", nil, false},
+		{"listUsers", []string{RoleAdmin}, false},
+		{"createAPIKey", []string{ScopeUsersRead, ScopeUsersWrite, ScopeProductsWrite}, false},
+		{"getUserProfile", []string{ScopeUsersRead}, false},
+	}
+	for _, tt := range tests {
+		if got := ScopeAllows(tt.op, tt.scopes); got != tt.want {
+			t.Errorf("ScopeAllows(%q, %v) = %t, want %t", tt.op, tt.scopes, got, tt.want)
+		}
+	}
+}
+
+func TestIsValidScope(t *testing.T) {
+	for _, s := range []string{ScopeUsersRead, ScopeUsersWrite, ScopeProductsWrite} {
+		if !IsValidScope(s) {
+			t.Errorf("IsValidScope(%q) = false", s)
+		}
+	}
+	for _, s := range []string{"", RoleAdmin, "users:*", "USERS:READ"} {
+		if IsValidScope(s) {
+			t.Errorf("IsValidScope(%q) = true", s)
+		}
+	}
+}
+
+// TestScopedOperationsAreStaffOnly keeps a scope from opening an operation
+// that users reach by role: every scoped operation is also role-gated.
+func TestScopedOperationsAreStaffOnly(t *testing.T) {
+	for op := range operationScopes {
+		roles, ok := operationRoles[op]
+		if !ok || HasRole(roles, RoleCustomer) {
+			t.Errorf("scoped operation %s is not staff-only in operationRoles", op)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrNoSigningKeys is returned outside dev mode when JWT_KEY_FILES is empty
+var ErrNoSigningKeys = errors.New("JWT_KEY_FILES is empty; set it, or APP_ENV=development for an ephemeral key")
+
+// signingKey is one entry of the key ring; private is nil for verify-only keys
+type signingKey struct {
+	kid     string
+	method  jwt.SigningMethod
+	public  crypto.PublicKey
+	private crypto.Signer
+}
+
+// KeyRing holds every key that may validate a token, and the one used to sign new tokens.
+// Keys listed in JWT_KEY_FILES keep validating after the signing key moves on;
+// dropping a key from the list retires it and tokens signed with it stop validating.
+type KeyRing struct {
+	signing *signingKey
+	keys    map[string]*signingKey
+	order   []string
+}
+
+// JWK is the public half of a key as published on /.well-known/jwks.json
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// LoadKeyRing reads "kid=path.pem" entries and selects signingKID (first private key if empty).
+// A public-key PEM makes a verify-only entry, for a retired key whose private half is gone.
+func LoadKeyRing(entries []string, signingKID string) (*KeyRing, error) {
+	ring := &KeyRing{keys: map[string]*signingKey{}}
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		kid, path, ok := strings.Cut(entry, "=")
+		if !ok || kid == "" || path == "" {
+			return nil, fmt.Errorf("invalid key entry %q, want kid=path", entry)
+		}
+
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read key %s: %w", kid, err)
+		}
+		key, err := parseKey(kid, raw)
+		if err != nil {
+			return nil, err
+		}
+		if _, dup := ring.keys[kid]; dup {
+			return nil, fmt.Errorf("duplicate kid %s", kid)
+		}
+		ring.keys[kid] = key
+		ring.order = append(ring.order, kid)
+	}
+
+	if len(ring.order) == 0 {
+		return nil, errors.New("no signing keys configured")
+	}
+
+	if signingKID == "" {
+		for _, kid := range ring.order {
+			if ring.keys[kid].private != nil {
+				signingKID = kid
+				break
+			}
+		}
+		if signingKID == "" {
+			return nil, errors.New("no private key to sign with; every entry is verify-only")
+		}
+	}
+	signing, ok := ring.keys[signingKID]
+	if !ok {
+		return nil, fmt.Errorf("signing kid %s is not in the key ring", signingKID)
+	}
+	if signing.private == nil {
+		return nil, fmt.Errorf("signing kid %s is a public key and can only verify", signingKID)
+	}
+	ring.signing = signing
+
+	return ring, nil
+}
+
+// ephemeralKeyRing is the dev fallback when no key files are configured.
+// Tokens do not survive a restart.
+func ephemeralKeyRing() *KeyRing {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		log.Fatalf("failed to generate ephemeral signing key: %v", err)
+	}
+	key := &signingKey{kid: "ephemeral", method: jwt.SigningMethodEdDSA, public: priv.Public(), private: priv}
+	return &KeyRing{
+		signing: key,
+		keys:    map[string]*signingKey{key.kid: key},
+		order:   []string{key.kid},
+	}
+}
+
+// parseKey reads a private key (signs and verifies) or a public key (verifies only)
+func parseKey(kid string, raw []byte) (*signingKey, error) {
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, fmt.Errorf("key %s: no PEM block found", kid)
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("key %s: unsupported PEM block %q", kid, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("key %s: %w", kid, err)
+	}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		return &signingKey{kid: kid, method: jwt.SigningMethodRS256, public: k.Public(), private: k}, nil
+	case ed25519.PrivateKey:
+		return &signingKey{kid: kid, method: jwt.SigningMethodEdDSA, public: k.Public(), private: k}, nil
+	case *rsa.PublicKey:
+		return &signingKey{kid: kid, method: jwt.SigningMethodRS256, public: k}, nil
+	case ed25519.PublicKey:
+		return &signingKey{kid: kid, method: jwt.SigningMethodEdDSA, public: k}, nil
+	default:
+		return nil, fmt.Errorf("key %s: only RSA and Ed25519 keys are supported", kid)
+	}
+}
+
+// sign signs claims with the current signing key and stamps its kid in the header
+func (r *KeyRing) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(r.signing.method, claims)
+	token.Header["kid"] = r.signing.kid
+	return token.SignedString(r.signing.private)
+}
+
+// keyFunc resolves the verification key from the token's kid header
+func (r *KeyRing) keyFunc(t *jwt.Token) (interface{}, error) {
+	kid, _ := t.Header["kid"].(string)
+	key, ok := r.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown or retired signing key: %q", kid)
+	}
+	if t.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+	}
+	return key.public, nil
+}
+
+// JWKS returns the public keys of the ring in configuration order
+func (r *KeyRing) JWKS() []JWK {
+	out := make([]JWK, 0, len(r.order))
+	for _, kid := range r.order {
+		key := r.keys[kid]
+		switch pub := key.public.(type) {
+		case *rsa.PublicKey:
+			out = append(out, JWK{
+				Kty: "RSA",
+				Kid: kid,
+				Use: "sig",
+				Alg: key.method.Alg(),
+				N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			out = append(out, JWK{
+				Kty: "OKP",
+				Kid: kid,
+				Use: "sig",
+				Alg: key.method.Alg(),
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(pub),
+			})
+		}
+	}
+	return out
+}
+
+// JWKS returns the public signing keys of the process-wide key ring
+func JWKS() []JWK {
+	return keyRing.JWKS()
+}
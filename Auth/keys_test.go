@@ -0,0 +1,258 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"Adornme/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Generated once; RSA key generation is slow
+var (
+	testRSAKey, _        = rsa.GenerateKey(rand.Reader, 2048)
+	_, testEd25519Key, _ = ed25519.GenerateKey(rand.Reader)
+	_, testOtherEdKey, _ = ed25519.GenerateKey(rand.Reader)
+)
+
+// writeKey stores key as a PEM file and returns its "kid=path" entry.
+// Private keys are written as PKCS#8, public keys as PKIX.
+func writeKey(t *testing.T, kid string, key any) string {
+	t.Helper()
+	var block *pem.Block
+	if _, private := key.(crypto.Signer); private {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block = &pem.Block{Type: "PRIVATE KEY", Bytes: der}
+	} else {
+		der, err := x509.MarshalPKIXPublicKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block = &pem.Block{Type: "PUBLIC KEY", Bytes: der}
+	}
+	path := filepath.Join(t.TempDir(), kid+".pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return kid + "=" + path
+}
+
+func mustLoad(t *testing.T, entries []string, signingKID string) *KeyRing {
+	t.Helper()
+	ring, err := LoadKeyRing(entries, signingKID)
+	if err != nil {
+		t.Fatalf("LoadKeyRing() error = %v", err)
+	}
+	return ring
+}
+
+func testClaims() AuthClaims {
+	return AuthClaims{
+		UserID:   "42",
+		TokenUse: tokenUseAccess,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	}
+}
+
+// verify parses token against ring the way parseToken does against the process ring
+func verify(ring *KeyRing, token string) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(token, &AuthClaims{}, ring.keyFunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}))
+}
+
+func TestKeyRingSignAndVerify(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     any
+		wantAlg string
+	}{
+		{"rsa", testRSAKey, "RS256"},
+		{"ed25519", testEd25519Key, "EdDSA"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ring := mustLoad(t, []string{writeKey(t, "k1", tt.key)}, "")
+			token, err := ring.sign(testClaims())
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsed, err := verify(ring, token)
+			if err != nil {
+				t.Fatalf("verify() error = %v", err)
+			}
+			if parsed.Header["kid"] != "k1" || parsed.Method.Alg() != tt.wantAlg {
+				t.Fatalf("header = %v, want kid k1 and alg %s", parsed.Header, tt.wantAlg)
+			}
+		})
+	}
+}
+
+func TestKeyRingRotation(t *testing.T) {
+	oldRing := mustLoad(t, []string{writeKey(t, "old", testEd25519Key)}, "")
+	oldToken, err := oldRing.sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The new key signs; only the public half of the old one is kept
+	rotated := mustLoad(t, []string{
+		writeKey(t, "old", testEd25519Key.Public()),
+		writeKey(t, "new", testRSAKey),
+	}, "new")
+	if _, err := verify(rotated, oldToken); err != nil {
+		t.Fatalf("token of the verify-only key rejected: %v", err)
+	}
+	newToken, err := rotated.sign(testClaims())
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := verify(rotated, newToken)
+	if err != nil {
+		t.Fatalf("new token rejected: %v", err)
+	}
+	if parsed.Header["kid"] != "new" {
+		t.Fatalf("new token signed with kid %v, want new", parsed.Header["kid"])
+	}
+
+	// Dropping the old key retires its tokens
+	retired := mustLoad(t, []string{writeKey(t, "new", testRSAKey)}, "")
+	if _, err := verify(retired, oldToken); err == nil {
+		t.Fatal("token of a retired key accepted")
+	}
+}
+
+func TestKeyRingRejects(t *testing.T) {
+	ring := mustLoad(t, []string{writeKey(t, "ed", testEd25519Key), writeKey(t, "rsa", testRSAKey)}, "ed")
+
+	signWith := func(method jwt.SigningMethod, kid string, key crypto.Signer) string {
+		t.Helper()
+		tok := jwt.NewWithClaims(method, testClaims())
+		if kid != "" {
+			tok.Header["kid"] = kid
+		}
+		s, err := tok.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"unknown kid", signWith(jwt.SigningMethodEdDSA, "nope", testEd25519Key)},
+		{"no kid", signWith(jwt.SigningMethodEdDSA, "", testEd25519Key)},
+		{"known kid, other key", signWith(jwt.SigningMethodEdDSA, "ed", testOtherEdKey)},
+		{"alg of another key", signWith(jwt.SigningMethodEdDSA, "rsa", testEd25519Key)},
+		{"hs256", func() string {
+			s, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims()).SignedString([]byte("secret"))
+			return s
+		}()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := verify(ring, tt.token); err == nil {
+				t.Fatal("token accepted")
+			}
+		})
+	}
+}
+
+func TestLoadKeyRingErrors(t *testing.T) {
+	pub := writeKey(t, "pub", testEd25519Key.Public())
+	priv := writeKey(t, "priv", testEd25519Key)
+	junk := filepath.Join(t.TempDir(), "junk.pem")
+	if err := os.WriteFile(junk, []byte("not a key"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		entries    []string
+		signingKID string
+		wantErr    string
+	}{
+		{"no entries", nil, "", "no signing keys"},
+		{"only verify-only keys", []string{pub}, "", "every entry is verify-only"},
+		{"signing kid is verify-only", []string{pub, priv}, "pub", "can only verify"},
+		{"signing kid not in ring", []string{priv}, "other", "not in the key ring"},
+		{"duplicate kid", []string{priv, priv}, "", "duplicate kid"},
+		{"malformed entry", []string{"priv"}, "", "want kid=path"},
+		{"missing file", []string{"k=" + filepath.Join(t.TempDir(), "none.pem")}, "", "read key"},
+		{"not pem", []string{"k=" + junk}, "", "no PEM block"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadKeyRing(tt.entries, tt.signingKID)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("LoadKeyRing() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeyRingFor(t *testing.T) {
+	if _, err := keyRingFor(&config.Config{}); !errors.Is(err, ErrNoSigningKeys) {
+		t.Fatalf("no keys outside dev mode: error = %v, want %v", err, ErrNoSigningKeys)
+	}
+
+	ring, err := keyRingFor(&config.Config{DevMode: true})
+	if err != nil {
+		t.Fatalf("no keys in dev mode: %v", err)
+	}
+	if ring.signing.kid != "ephemeral" || ring.signing.method != jwt.SigningMethodEdDSA {
+		t.Fatalf("dev fallback signs with %s/%s", ring.signing.kid, ring.signing.method.Alg())
+	}
+
+	entry := writeKey(t, "k1", testEd25519Key)
+	ring, err = keyRingFor(&config.Config{JWTKeyFiles: []string{entry}})
+	if err != nil || ring.signing.kid != "k1" {
+		t.Fatalf("configured keys outside dev mode: ring = %v, err = %v", ring, err)
+	}
+}
+
+func TestJWKS(t *testing.T) {
+	ring := mustLoad(t, []string{
+		writeKey(t, "rsa", testRSAKey),
+		writeKey(t, "ed", testEd25519Key.Public()),
+	}, "")
+
+	jwks := ring.JWKS()
+	if len(jwks) != 2 {
+		t.Fatalf("JWKS() has %d keys, want 2", len(jwks))
+	}
+
+	r := jwks[0]
+	if r.Kid != "rsa" || r.Kty != "RSA" || r.Alg != "RS256" || r.Use != "sig" || r.E != "AQAB" {
+		t.Errorf("rsa jwk = %+v", r)
+	}
+	if n, _ := base64.RawURLEncoding.DecodeString(r.N); string(n) != string(testRSAKey.N.Bytes()) {
+		t.Error("rsa jwk modulus does not match the key")
+	}
+
+	e := jwks[1]
+	if e.Kid != "ed" || e.Kty != "OKP" || e.Crv != "Ed25519" || e.Alg != "EdDSA" {
+		t.Errorf("ed25519 jwk = %+v", e)
+	}
+	if x, _ := base64.RawURLEncoding.DecodeString(e.X); string(x) != string(testEd25519Key.Public().(ed25519.PublicKey)) {
+		t.Error("ed25519 jwk x does not match the key")
+	}
+}
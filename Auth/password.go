@@ -0,0 +1,213 @@
+package auth
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"unicode"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// MaxPasswordLength is fixed by bcrypt, which ignores everything past 72 bytes
+const MaxPasswordLength = 72
+
+// Character classes a policy can require
+const (
+	ClassLetter = "letter"
+	ClassUpper  = "upper"
+	ClassLower  = "lower"
+	ClassDigit  = "digit"
+	ClassSymbol = "symbol"
+)
+
+// currentHashPrefix marks hashes made by HashPassword; hashes from other bcrypt
+// implementations ($2b$, $2y$) or other schemes are rewritten on the next login.
+const currentHashPrefix = "$2a$"
+
+// personalMinLength is the shortest email or name part a password may not contain
+const personalMinLength = 3
+
+// ErrBreachedPassword is returned for passwords found in the breached-password list
+var ErrBreachedPassword = errors.New("password has appeared in a data breach, choose another")
+
+// PasswordPolicy holds the rules every new password must pass
+type PasswordPolicy struct {
+	MinLength  int
+	Classes    []string
+	BcryptCost int
+	breached   map[string]struct{} // upper-case SHA-1 hex of breached passwords
+}
+
+var passwordPolicy = loadPasswordPolicy()
+
+func loadPasswordPolicy() *PasswordPolicy {
+	p := &PasswordPolicy{
+		MinLength:  cfg.PasswordMinLength,
+		BcryptCost: cfg.PasswordBcryptCost,
+	}
+	for _, c := range cfg.PasswordRequiredClasses {
+		c = strings.ToLower(c)
+		switch c {
+		case ClassLetter, ClassUpper, ClassLower, ClassDigit, ClassSymbol:
+			p.Classes = append(p.Classes, c)
+		default:
+			log.Fatalf("PASSWORD_REQUIRED_CLASSES: unknown class %q", c)
+		}
+	}
+	if cfg.PasswordBreachedFile != "" {
+		breached, err := loadBreachedList(cfg.PasswordBreachedFile)
+		if err != nil {
+			log.Fatalf("failed to load breached-password list: %v", err)
+		}
+		p.breached = breached
+		log.Printf("breached-password list loaded: %d entries", len(breached))
+	}
+	return p
+}
+
+// loadBreachedList reads one password per line. Lines that are a SHA-1 hex
+// digest, optionally followed by ":count" as in the Pwned Passwords dumps,
+// are taken as already hashed.
+func loadBreachedList(path string) (map[string]struct{}, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	out := make(map[string]struct{})
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if h, _, _ := strings.Cut(line, ":"); isSHA1Hex(h) {
+			out[strings.ToUpper(h)] = struct{}{}
+			continue
+		}
+		out[sha1Hex(line)] = struct{}{}
+	}
+	return out, sc.Err()
+}
+
+// ValidatePassword enforces the configured policy. personal holds the user's
+// email, name and the like; the password may not contain any part of them.
+func ValidatePassword(password string, personal ...string) error {
+	p := passwordPolicy
+
+	if len(password) < p.MinLength {
+		return fmt.Errorf("password must be at least %d characters", p.MinLength)
+	}
+	if len(password) > MaxPasswordLength {
+		return errors.New("password must be at most 72 bytes")
+	}
+
+	has := map[string]bool{}
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			has[ClassLetter], has[ClassUpper] = true, true
+		case unicode.IsLower(r):
+			has[ClassLetter], has[ClassLower] = true, true
+		case unicode.IsLetter(r):
+			has[ClassLetter] = true
+		case unicode.IsDigit(r):
+			has[ClassDigit] = true
+		default:
+			has[ClassSymbol] = true
+		}
+	}
+	var missing []string
+	for _, c := range p.Classes {
+		if !has[c] {
+			missing = append(missing, classLabel(c))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("password must contain %s", strings.Join(missing, ", "))
+	}
+
+	lower := strings.ToLower(password)
+	for _, part := range personalParts(personal) {
+		if strings.Contains(lower, part) {
+			return errors.New("password must not contain your name or email")
+		}
+	}
+
+	if _, ok := p.breached[sha1Hex(password)]; ok {
+		return ErrBreachedPassword
+	}
+	return nil
+}
+
+// HashPassword hashes a password with bcrypt at the configured cost
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), passwordPolicy.BcryptCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// NeedsRehash reports whether a stored hash is weaker than what HashPassword
+// makes now. Call it after the password matched, then store a fresh hash.
+func NeedsRehash(hash string) bool {
+	if !strings.HasPrefix(hash, currentHashPrefix) {
+		return true
+	}
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost < passwordPolicy.BcryptCost
+}
+
+// personalParts splits emails and names into the lower-case pieces worth
+// checking: each word of a name and of an email's local part.
+func personalParts(values []string) []string {
+	var parts []string
+	for _, v := range values {
+		v = strings.ToLower(strings.TrimSpace(v))
+		v, _, _ = strings.Cut(v, "@")
+		for _, f := range strings.FieldsFunc(v, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			if len(f) >= personalMinLength {
+				parts = append(parts, f)
+			}
+		}
+	}
+	return parts
+}
+
+func classLabel(c string) string {
+	switch c {
+	case ClassLetter:
+		return "a letter"
+	case ClassUpper:
+		return "an upper-case letter"
+	case ClassLower:
+		return "a lower-case letter"
+	case ClassDigit:
+		return "a digit"
+	default:
+		return "a symbol"
+	}
+}
+
+func sha1Hex(s string) string {
+	sum := sha1.Sum([]byte(s))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+func isSHA1Hex(s string) bool {
+	if len(s) != sha1.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
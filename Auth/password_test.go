@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// withPolicy swaps the process-wide policy for the length of a test
+func withPolicy(t *testing.T, p *PasswordPolicy) {
+	t.Helper()
+	saved := passwordPolicy
+	passwordPolicy = p
+	t.Cleanup(func() { passwordPolicy = saved })
+}
+
+func TestValidatePassword(t *testing.T) {
+	withPolicy(t, &PasswordPolicy{
+		MinLength:  8,
+		Classes:    []string{ClassLetter, ClassDigit},
+		BcryptCost: bcrypt.MinCost,
+		breached:   map[string]struct{}{sha1Hex("password1"): {}},
+	})
+
+	tests := []struct {
+		name     string
+		password string
+		personal []string
+		wantErr  string // substring; empty means valid
+	}{
+		{"letters and digits", "correct4horse", nil, ""},
+		{"non-ASCII letters count", "ñandú2024", nil, ""},
+		{"exactly min length", "abcdefg1", nil, ""},
+		{"72 bytes", strings.Repeat("a", 71) + "1", nil, ""},
+		{"too short", "abc1", nil, "at least 8"},
+		{"too long", strings.Repeat("a", 72) + "1", nil, "at most 72"},
+		{"no digit", "correcthorse", nil, "a digit"},
+		{"no letter", "1234567890", nil, "a letter"},
+		{"breached", "password1", nil, "data breach"},
+		{"contains email local part", "asha.rao2024", []string{"asha.rao@example.com"}, "name or email"},
+		{"contains name, any case", "xRAO12345x", []string{"Asha Rao"}, "name or email"},
+		{"short name parts ignored", "jo12345678", []string{"Jo Li"}, ""},
+		{"email domain ignored", "example123", []string{"asha@example.com"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePassword(tt.password, tt.personal...)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("ValidatePassword(%q) = %v, want nil", tt.password, err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Fatalf("ValidatePassword(%q) = %v, want error containing %q", tt.password, err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := ValidatePassword("password1"); !errors.Is(err, ErrBreachedPassword) {
+		t.Fatalf("breached password error = %v, want %v", err, ErrBreachedPassword)
+	}
+}
+
+func TestValidatePasswordClasses(t *testing.T) {
+	tests := []struct {
+		classes  []string
+		password string
+		wantOK   bool
+	}{
+		{[]string{ClassUpper}, "lowercase1", false},
+		{[]string{ClassUpper}, "Uppercase1", true},
+		{[]string{ClassLower}, "UPPERCASE1", false},
+		{[]string{ClassSymbol}, "nosymbol12", false},
+		{[]string{ClassSymbol}, "has symbol", true},
+		{[]string{ClassUpper, ClassLower, ClassDigit, ClassSymbol}, "Tr0ub4dor&3", true},
+		{nil, "anything goes", true},
+	}
+	for _, tt := range tests {
+		withPolicy(t, &PasswordPolicy{MinLength: 8, Classes: tt.classes, BcryptCost: bcrypt.MinCost})
+		if err := ValidatePassword(tt.password); (err == nil) != tt.wantOK {
+			t.Errorf("classes %v, password %q: error = %v, want ok=%t", tt.classes, tt.password, err, tt.wantOK)
+		}
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	withPolicy(t, &PasswordPolicy{MinLength: 8, BcryptCost: 10})
+
+	hashAt := func(cost int) string {
+		h, err := bcrypt.GenerateFromPassword([]byte("correct4horse"), cost)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(h)
+	}
+	current := hashAt(10)
+
+	tests := []struct {
+		name string
+		hash string
+		want bool
+	}{
+		{"current cost", current, false},
+		{"higher cost", hashAt(11), false},
+		{"lower cost", hashAt(bcrypt.MinCost), true},
+		{"2b variant", "$2b$" + strings.TrimPrefix(current, "$2a$"), true},
+		{"2y variant", "$2y$" + strings.TrimPrefix(current, "$2a$"), true},
+		{"other scheme", "$argon2id$v=19$m=65536,t=3,p=4$c2FsdA$aGFzaA", true},
+		{"plain text", "correct4horse", true},
+		{"empty", "", true},
+		{"truncated bcrypt", "$2a$10$", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NeedsRehash(tt.hash); got != tt.want {
+				t.Fatalf("NeedsRehash(%q) = %t, want %t", tt.hash, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHashPassword(t *testing.T) {
+	withPolicy(t, &PasswordPolicy{MinLength: 8, BcryptCost: bcrypt.MinCost})
+
+	hash, err := HashPassword("correct4horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bcrypt.CompareHashAndPassword([]byte(hash), []byte("correct4horse")) != nil {
+		t.Fatal("hash does not match its password")
+	}
+	if NeedsRehash(hash) {
+		t.Fatal("a fresh hash needs rehashing")
+	}
+}
+
+func TestLoadBreachedList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	content := strings.Join([]string{
+		"# comment",
+		"",
+		"hunter22",
+		"letmein1\r",
+		strings.ToLower(sha1Hex("qwerty123")) + ":4711",
+		sha1Hex("iloveyou2"),
+	}, "\n")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	breached, err := loadBreachedList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(breached) != 4 {
+		t.Fatalf("loaded %d entries, want 4", len(breached))
+	}
+	for _, pw := range []string{"hunter22", "letmein1", "qwerty123", "iloveyou2"} {
+		if _, ok := breached[sha1Hex(pw)]; !ok {
+			t.Errorf("%q not in the list", pw)
+		}
+	}
+
+	if _, err := loadBreachedList(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Fatal("missing file loaded without error")
+	}
+}
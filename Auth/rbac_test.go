@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// routePattern matches go-swagger's "swagger:route METHOD /path Tag operationId" comments
+var routePattern = regexp.MustCompile(`swagger:route (\S+) (\S+) (\S+) (\S+)`)
+
+// TestAdminOperationsHaveRoles keeps CanAccess from failing open: every
+// generated operation tagged Admin* must be listed in operationRoles.
+func TestAdminOperationsHaveRoles(t *testing.T) {
+	files, err := filepath.Glob("../restapi/operations/*/*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	admin := 0
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range routePattern.FindAllStringSubmatch(string(src), -1) {
+			tag, op := m[3], m[4]
+			if !strings.HasPrefix(tag, "Admin") {
+				continue
+			}
+			admin++
+			roles, ok := operationRoles[op]
+			if !ok || len(roles) == 0 {
+				t.Errorf("%s (%s %s, tag %s) is not in operationRoles", op, m[1], m[2], tag)
+				continue
+			}
+			if HasRole(roles, RoleCustomer) {
+				t.Errorf("%s is open to customers", op)
+			}
+		}
+	}
+	if admin == 0 {
+		t.Fatal("no admin operations found under restapi/operations")
+	}
+}
+
+func TestCanAccess(t *testing.T) {
+	tests := []struct {
+		op    string
+		roles []string
+		want  bool
+	}{
+		{"updateUser", []string{RoleAdmin}, true},
+		{"updateUser", []string{RoleSupport}, false},
+		{"updateUser", []string{RoleCustomer}, false},
+		{"updateUser", nil, false},
+		{"listUsers", []string{RoleSupport}, true},
+		{"listUsers", []string{RoleCatalogManager}, false},
+		{"impersonateUser", []string{RoleAdmin}, true},
+		{"impersonateUser", []string{RoleSupport}, false},
+		{"createProduct", []string{RoleCatalogManager}, true},
+		{"createProduct", []string{RoleSupport}, false},
+		{"createAPIKey", []string{RoleCatalogManager, RoleSupport}, false},
+		{"createAPIKey", []string{RoleCustomer, RoleAdmin}, true},
+		{"getUserProfile", []string{RoleCustomer}, true},
+	}
+	for _, tt := range tests {
+		if got := CanAccess(tt.op, tt.roles); got != tt.want {
+			t.Errorf("CanAccess(%q, %v) = %t, want %t", tt.op, tt.roles, got, tt.want)
+		}
+	}
+}
+
+func TestBaseRole(t *testing.T) {
+	tests := []struct {
+		roles []string
+		want  string
+	}{
+		{nil, RoleCustomer},
+		{[]string{RoleCustomer}, RoleCustomer},
+		{[]string{RoleCustomer, RoleSupport}, RoleSupport},
+		{[]string{RoleSupport, RoleCatalogManager}, RoleCatalogManager},
+		{[]string{RoleCatalogManager, RoleAdmin}, RoleAdmin},
+		{[]string{"unknown"}, RoleCustomer},
+	}
+	for _, tt := range tests {
+		if got := BaseRole(tt.roles); got != tt.want {
+			t.Errorf("BaseRole(%v) = %q, want %q", tt.roles, got, tt.want)
+		}
+	}
+}
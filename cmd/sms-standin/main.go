@@ -0,0 +1,24 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"Adornme/internal/otp"
+)
+
+// Local stand-in for the SMS gateway. Run it and start the API with
+//
+//	SMS_PROVIDER=msg91 SMS_BASE_URL=http://localhost:9099
+//
+// then read OTPs from http://localhost:9099/messages.
+func main() {
+	addr := flag.String("addr", ":9099", "listen address")
+	flag.Parse()
+
+	log.Printf("SMS stand-in listening on %s", *addr)
+	if err := http.ListenAndServe(*addr, otp.NewFakeSMSProvider()); err != nil {
+		log.Fatalln(err)
+	}
+}
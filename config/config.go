@@ -1,14 +1,19 @@
 package config
 
 import (
+	"Adornme/internal/phone"
 	"log"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
+	// 🧪 Environment
+	DevMode bool // APP_ENV=development; enables local-only fallbacks: an ephemeral JWT key, the fake SMS provider
+
 	// DBs
 	PostgresDSN   string
 	MongoURI      string
@@ -22,10 +27,63 @@ type Config struct {
 	MinioSecretKey string
 
 	// 🔐 Auth (NEW)
-	JWTSecret              string
-	RefreshSecret          string
+	JWTKeyFiles            []string // "kid=path.pem" entries, every listed key validates; public-key PEMs only verify
+	JWTSigningKID          string   // kid used to sign new tokens (first entry if empty)
 	AccessTokenExpiryHours int
 	RefreshTokenExpiryDays int
+	TokenDenylistRequired  bool // refuse to start without Redis, which holds revoked access tokens
+
+	// ✅ Verification policy
+	RequireVerifiedForCheckout      bool // checkout needs at least one verified contact
+	RequireVerifiedForPasswordReset bool // reset links only go to verified emails
+
+	// 📱 SMS
+	SMSProvider          string // msg91 | twilio | fake (dev mode only)
+	SMSBaseURL           string // gateway URL override (local stand-in)
+	SMSAuthKey           string // MSG91 authkey / Twilio auth token
+	SMSAccountSID        string // Twilio only
+	SMSSenderID          string // DLT sender header / Twilio From or Messaging Service SID
+	SMSDLTTemplateID     string // DLT template the OTP text is registered under
+	SMSFlowID            string // MSG91 flow template
+	SMSStatusCallbackURL string // public URL of /webhooks/sms/{provider}
+	SMSWebhookToken      string // shared secret for providers that don't sign callbacks
+
+	// ☎️ Phone numbers
+	PhoneDefaultRegion string // ISO country assumed for numbers typed without +<calling code>
+
+	// 🔑 Password policy
+	PasswordMinLength       int      // shortest accepted password
+	PasswordRequiredClasses []string // any of letter, upper, lower, digit, symbol
+	PasswordBreachedFile    string   // one breached password (or SHA-1 hex, HIBP style) per line
+	PasswordBcryptCost      int      // stored hashes below this cost are upgraded at login
+
+	// 🚦 Login throttling
+	LoginMaxFailures    int // failed logins before an account is locked
+	LoginIPMaxFailures  int // failed logins from one IP (any account) before it is locked
+	LoginBackoffAfter   int // failures before exponential backoff kicks in
+	LoginLockoutMinutes int // lockout length, also the failure-counter window
+	AuthIPRatePerMinute int // identify / OTP send requests allowed per IP per minute
+
+	// 🪪 Passkeys (WebAuthn)
+	WebAuthnRPID           string   // relying party id: the site's registrable domain
+	WebAuthnRPName         string   // name the authenticator shows
+	WebAuthnOrigins        []string // allowed origins, incl. android:apk-key-hash:<hash> for the app
+	WebAuthnTimeoutSeconds int      // how long a ceremony may take
+
+	// 🔗 Magic links
+	MagicLinkTTLMinutes int // lifetime of an emailed sign-in link
+	MagicLinkMaxPerHour int // links one email address may request per hour
+
+	// 🕵️ Impersonation
+	ImpersonationTTLMinutes int // lifetime of a support agent's read-only token
+
+	// 🗂️ Privacy (data export / erasure)
+	PrivacyExportBucket    string // MinIO bucket holding export archives
+	UploadsBucket          string // MinIO bucket of user uploads, one users/<id>/ prefix per user
+	PrivacyExportLinkHours int    // how long an export archive can be downloaded
+
+	// 🗃️ Catalog
+	CategoryTreeCacheSeconds int // how long the category tree is served from Redis
 }
 
 func LoadConfig() *Config {
@@ -35,6 +93,9 @@ func LoadConfig() *Config {
 	}
 
 	cfg := &Config{
+		// Environment
+		DevMode: getEnv("APP_ENV", "production") == "development",
+
 		// DB
 		PostgresDSN:   getEnv("POSTGRES_DSN", ""),
 		MongoURI:      getEnv("MONGO_URI", ""),
@@ -48,10 +109,63 @@ func LoadConfig() *Config {
 		MinioSecretKey: getEnv("MINIO_SECRET_KEY", ""),
 
 		// 🔐 Auth
-		JWTSecret:              getEnv("JWT_SECRET", "dev-secret"), // fallback for dev
-		RefreshSecret:          getEnv("REFRESH_SECRET", "dev-refresh-secret"),
+		JWTKeyFiles:            getEnvAsList("JWT_KEY_FILES"), // empty → ephemeral key, dev mode only
+		JWTSigningKID:          getEnv("JWT_SIGNING_KID", ""),
 		AccessTokenExpiryHours: getEnvAsInt("ACCESS_TOKEN_EXPIRY_HOURS", 1),
 		RefreshTokenExpiryDays: getEnvAsInt("REFRESH_TOKEN_EXPIRY_DAYS", 1),
+		TokenDenylistRequired:  getEnvAsBool("TOKEN_DENYLIST_REQUIRED", true),
+
+		// ✅ Verification policy
+		RequireVerifiedForCheckout:      getEnvAsBool("REQUIRE_VERIFIED_FOR_CHECKOUT", false),
+		RequireVerifiedForPasswordReset: getEnvAsBool("REQUIRE_VERIFIED_FOR_PASSWORD_RESET", false),
+
+		// 📱 SMS
+		SMSProvider:          getEnv("SMS_PROVIDER", ""),
+		SMSBaseURL:           getEnv("SMS_BASE_URL", ""),
+		SMSAuthKey:           getEnv("SMS_AUTH_KEY", ""),
+		SMSAccountSID:        getEnv("SMS_ACCOUNT_SID", ""),
+		SMSSenderID:          getEnv("SMS_SENDER_ID", ""),
+		SMSDLTTemplateID:     getEnv("SMS_DLT_TEMPLATE_ID", ""),
+		SMSFlowID:            getEnv("SMS_FLOW_ID", ""),
+		SMSStatusCallbackURL: getEnv("SMS_STATUS_CALLBACK_URL", ""),
+		SMSWebhookToken:      getEnv("SMS_WEBHOOK_TOKEN", ""),
+
+		// ☎️ Phone numbers
+		PhoneDefaultRegion: getEnv("PHONE_DEFAULT_REGION", "IN"),
+
+		// 🔑 Password policy
+		PasswordMinLength:       getEnvAsInt("PASSWORD_MIN_LENGTH", 8),
+		PasswordRequiredClasses: getEnvAsListOr("PASSWORD_REQUIRED_CLASSES", []string{"letter", "digit"}),
+		PasswordBreachedFile:    getEnv("PASSWORD_BREACHED_FILE", ""),
+		PasswordBcryptCost:      getEnvAsInt("PASSWORD_BCRYPT_COST", 12),
+
+		// 🚦 Login throttling
+		LoginMaxFailures:    getEnvAsInt("LOGIN_MAX_FAILURES", 10),
+		LoginIPMaxFailures:  getEnvAsInt("LOGIN_IP_MAX_FAILURES", 50),
+		LoginBackoffAfter:   getEnvAsInt("LOGIN_BACKOFF_AFTER", 3),
+		LoginLockoutMinutes: getEnvAsInt("LOGIN_LOCKOUT_MINUTES", 15),
+		AuthIPRatePerMinute: getEnvAsInt("AUTH_IP_RATE_PER_MINUTE", 20),
+
+		// 🪪 Passkeys
+		WebAuthnRPID:           getEnv("WEBAUTHN_RP_ID", "localhost"),
+		WebAuthnRPName:         getEnv("WEBAUTHN_RP_NAME", "Adornme"),
+		WebAuthnOrigins:        getEnvAsListOr("WEBAUTHN_ORIGINS", []string{"http://localhost:3000"}),
+		WebAuthnTimeoutSeconds: getEnvAsInt("WEBAUTHN_TIMEOUT_SECONDS", 120),
+
+		// 🔗 Magic links
+		MagicLinkTTLMinutes: getEnvAsInt("MAGIC_LINK_TTL_MINUTES", 15),
+		MagicLinkMaxPerHour: getEnvAsInt("MAGIC_LINK_MAX_PER_HOUR", 5),
+
+		// 🕵️ Impersonation
+		ImpersonationTTLMinutes: getEnvAsInt("IMPERSONATION_TTL_MINUTES", 15),
+
+		// 🗂️ Privacy
+		PrivacyExportBucket:    getEnv("PRIVACY_EXPORT_BUCKET", "privacy-exports"),
+		UploadsBucket:          getEnv("UPLOADS_BUCKET", "uploads"),
+		PrivacyExportLinkHours: getEnvAsInt("PRIVACY_EXPORT_LINK_HOURS", 24),
+
+		// 🗃️ Catalog
+		CategoryTreeCacheSeconds: getEnvAsInt("CATEGORY_TREE_CACHE_SECONDS", 300),
 	}
 
 	validateConfig(cfg)
@@ -81,12 +195,51 @@ func getEnvAsInt(key string, defaultVal int) int {
 	return val
 }
 
+func getEnvAsBool(key string, defaultVal bool) bool {
+	valStr := os.Getenv(key)
+	if valStr == "" {
+		return defaultVal
+	}
+
+	val, err := strconv.ParseBool(valStr)
+	if err != nil {
+		log.Printf("Invalid bool for %s, using default %t\n", key, defaultVal)
+		return defaultVal
+	}
+	return val
+}
+
+func getEnvAsList(key string) []string {
+	var out []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// getEnvAsListOr is getEnvAsList with a default for an unset variable
+func getEnvAsListOr(key string, defaultVal []string) []string {
+	if os.Getenv(key) == "" {
+		return defaultVal
+	}
+	return getEnvAsList(key)
+}
+
 // 🔥 Validate critical configs (production safety)
 func validateConfig(cfg *Config) {
-	if cfg.JWTSecret == "" {
-		log.Fatal("JWT_SECRET is required")
+	if cfg.JWTSigningKID != "" && len(cfg.JWTKeyFiles) == 0 {
+		log.Fatal("JWT_SIGNING_KID is set but JWT_KEY_FILES is empty")
+	}
+	// bcrypt accepts costs 4..31; below 10 is too cheap for stored passwords
+	if cfg.PasswordBcryptCost < 10 || cfg.PasswordBcryptCost > 31 {
+		log.Fatalf("PASSWORD_BCRYPT_COST %d is out of range (10-31)", cfg.PasswordBcryptCost)
+	}
+	if cfg.PasswordMinLength < 8 || cfg.PasswordMinLength > 72 {
+		log.Fatalf("PASSWORD_MIN_LENGTH %d is out of range (8-72)", cfg.PasswordMinLength)
 	}
-	if cfg.RefreshSecret == "" {
-		log.Fatal("REFRESH_SECRET is required")
+	if _, ok := phone.CountryByISO(cfg.PhoneDefaultRegion); !ok {
+		log.Fatalf("PHONE_DEFAULT_REGION %q is not a supported region", cfg.PhoneDefaultRegion)
 	}
 }
@@ -0,0 +1,211 @@
+package products
+
+import (
+	db "Adornme/databases"
+	"Adornme/models"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Limits on admin product input
+const (
+	maxProductImages   = 10
+	maxImageURLLength  = 2048
+	maxProductPrice    = 99999999.99 // NUMERIC(10,2)
+	defaultCurrency    = "INR"
+	maxProductNameSize = 200
+)
+
+// Admin catalog errors
+var (
+	ErrInvalidProduct = errors.New("invalid product")
+	ErrStaleProduct   = errors.New("product was changed since you loaded it; reload and retry")
+)
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// CreateProduct adds a product to the catalog
+func (p *Product) CreateProduct(ctx context.Context, actorID string, req *models.ProductCreateRequest) (*models.Product, error) {
+	logs.Infof(ctx, "CreateProduct called | actor_id=%s", actorID)
+
+	prod := &db.Product{
+		Name:        strings.TrimSpace(*req.Name),
+		Description: strings.TrimSpace(req.Description),
+		Price:       float64(*req.Price),
+		Currency:    req.Currency,
+		Inventory:   int(*req.Stock),
+		CategoryID:  req.CategoryID,
+		Images:      req.Images,
+		Options:     productOptions(req.Options),
+	}
+	if prod.Currency == "" {
+		prod.Currency = defaultCurrency
+	}
+	if err := validateProduct(prod); err != nil {
+		return nil, err
+	}
+	if err := p.checkCategory(ctx, *prod.CategoryID); err != nil {
+		return nil, err
+	}
+	attrs, err := requestAttributes(req.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	if prod.Attributes, err = p.productAttributes(ctx, *prod.CategoryID, attrs, true); err != nil {
+		return nil, err
+	}
+
+	if err := p.DB.CreateProduct(ctx, prod); err != nil {
+		return nil, errors.New("failed to create product")
+	}
+
+	logs.Infof(ctx, "product created | actor_id=%s product_id=%d", actorID, prod.ID)
+	return productModel(prod, p.breadcrumbIndex(ctx)), nil
+}
+
+// UpdateProduct applies the fields set in req, provided nobody changed the
+// product since req.Version
+func (p *Product) UpdateProduct(ctx context.Context, actorID string, id int64, req *models.ProductUpdateRequest) (*models.Product, error) {
+	logs.Infof(ctx, "UpdateProduct called | actor_id=%s product_id=%d version=%d", actorID, id, *req.Version)
+
+	prod, err := p.DB.GetProduct(ctx, int(id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrProductNotFound
+		}
+		return nil, errors.New("failed to load product")
+	}
+	if prod.ArchivedAt != nil {
+		return nil, ErrProductNotFound
+	}
+	if prod.Version != *req.Version {
+		return nil, ErrStaleProduct
+	}
+	if err := p.loadDetails(ctx, prod); err != nil {
+		return nil, errors.New("failed to load product")
+	}
+	if len(prod.Variants) > 0 && (req.Price != nil || req.Stock != nil) {
+		return nil, fmt.Errorf("%w: price and stock come from the variants; update those instead", ErrInvalidProduct)
+	}
+
+	// 🔹 1. Merge (omitted fields stay as they are)
+	if req.Name != nil {
+		prod.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		prod.Description = strings.TrimSpace(*req.Description)
+	}
+	if req.Price != nil {
+		prod.Price = float64(*req.Price)
+	}
+	if req.Currency != nil {
+		prod.Currency = *req.Currency
+	}
+	if req.Stock != nil {
+		prod.Inventory = int(*req.Stock)
+	}
+	if req.CategoryID != nil {
+		prod.CategoryID = req.CategoryID
+	}
+	if req.Images != nil {
+		prod.Images = req.Images
+	}
+	if req.Options != nil {
+		prod.Options = productOptions(req.Options)
+	}
+	if err := validateProduct(prod); err != nil {
+		return nil, err
+	}
+	for _, v := range prod.Variants {
+		if err := fitsOptions(v.Options, prod.Options); err != nil {
+			return nil, fmt.Errorf("%w: variant %s %v", ErrInvalidProduct, v.SKU, err)
+		}
+	}
+	if err := p.checkCategory(ctx, *prod.CategoryID); err != nil {
+		return nil, err
+	}
+	// Given attributes replace the stored ones and must all fit the category;
+	// otherwise the stored ones carry over, minus any the category no longer defines
+	attrs, strict := prod.Attributes, req.Attributes != nil
+	if strict {
+		if attrs, err = requestAttributes(req.Attributes); err != nil {
+			return nil, err
+		}
+	}
+	if prod.Attributes, err = p.productAttributes(ctx, *prod.CategoryID, attrs, strict); err != nil {
+		return nil, err
+	}
+
+	// 🔹 2. Write, checking the version again in the same statement
+	if err := p.DB.UpdateProduct(ctx, prod); err != nil {
+		switch {
+		case errors.Is(err, db.ErrStaleProduct):
+			return nil, ErrStaleProduct
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, ErrProductNotFound
+		}
+		logs.Errorf(ctx, "failed to update product %d: %v", id, err)
+		return nil, errors.New("failed to update product")
+	}
+
+	logs.Infof(ctx, "product updated | actor_id=%s product_id=%d version=%d", actorID, id, prod.Version)
+	return productModel(prod, p.breadcrumbIndex(ctx)), nil
+}
+
+// DeleteProduct archives a product: it leaves listings but stays readable by id for past orders
+func (p *Product) DeleteProduct(ctx context.Context, actorID string, id int64) error {
+	logs.Infof(ctx, "DeleteProduct called | actor_id=%s product_id=%d", actorID, id)
+
+	if err := p.DB.ArchiveProduct(ctx, int(id)); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrProductNotFound
+		}
+		logs.Errorf(ctx, "failed to archive product %d: %v", id, err)
+		return errors.New("failed to delete product")
+	}
+
+	logs.Infof(ctx, "product archived | actor_id=%s product_id=%d", actorID, id)
+	return nil
+}
+
+// validateProduct checks what the spec cannot, and normalises images and options to non-nil lists
+func validateProduct(prod *db.Product) error {
+	switch {
+	case prod.Name == "" || len(prod.Name) > maxProductNameSize:
+		return fmt.Errorf("%w: name must be 1 to %d characters", ErrInvalidProduct, maxProductNameSize)
+	case prod.Price <= 0 || prod.Price > maxProductPrice:
+		return fmt.Errorf("%w: price must be greater than 0 and at most %.2f", ErrInvalidProduct, maxProductPrice)
+	case !currencyPattern.MatchString(prod.Currency):
+		return fmt.Errorf("%w: currency must be an ISO 4217 code such as INR", ErrInvalidProduct)
+	case prod.Inventory < 0:
+		return fmt.Errorf("%w: stock must not be negative", ErrInvalidProduct)
+	case prod.CategoryID == nil || *prod.CategoryID < 1:
+		return fmt.Errorf("%w: categoryId is required", ErrInvalidProduct)
+	case len(prod.Images) > maxProductImages:
+		return fmt.Errorf("%w: at most %d images", ErrInvalidProduct, maxProductImages)
+	}
+	if prod.Options == nil {
+		prod.Options = []db.ProductOption{}
+	}
+	if err := validateOptions(prod.Options); err != nil {
+		return err
+	}
+
+	images := make([]string, 0, len(prod.Images))
+	for _, raw := range prod.Images {
+		raw = strings.TrimSpace(raw)
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || len(raw) > maxImageURLLength {
+			return fmt.Errorf("%w: image %q is not an absolute http(s) URL", ErrInvalidProduct, raw)
+		}
+		images = append(images, raw)
+	}
+	prod.Images = images
+	return nil
+}
@@ -0,0 +1,463 @@
+package products
+
+import (
+	db "Adornme/databases"
+	"Adornme/models"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Limits on attribute definitions, product attribute values and attr filters
+const (
+	maxAttributeCodeSize  = 50
+	maxAttributeLabelSize = 100
+	maxAttributeValues    = 100
+	maxAttributeValueSize = 100
+	maxAttributeUnitSize  = 20
+	maxAttributeFilters   = 10
+	maxFilterValues       = 20
+	maxFacetValues        = 50
+	attributeFilterPrefix = "attr."
+	attributeRangeSep     = ".."
+)
+
+// Attribute errors
+var (
+	ErrAttributeNotFound      = errors.New("attribute not found")
+	ErrInvalidAttribute       = errors.New("invalid attribute")
+	ErrAttributeExists        = errors.New("category already defines this attribute")
+	ErrInvalidAttributeFilter = errors.New("invalid attr filter")
+)
+
+var attributeCodePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// ListCategoryAttributes returns the attributes products in a category can
+// have, including those inherited from its parents
+func (p *Product) ListCategoryAttributes(ctx context.Context, categoryID int64) ([]*models.AttributeDefinition, error) {
+	logs.Infof(ctx, "ListCategoryAttributes called with requestID: %s, categoryID: %d", p.RequestID, categoryID)
+
+	if _, err := p.DB.GetCategory(ctx, categoryID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, errors.New("failed to load category")
+	}
+	defs, err := p.DB.ListAttributeDefinitions(ctx, categoryID)
+	if err != nil {
+		return nil, errors.New("failed to list attributes")
+	}
+
+	out := make([]*models.AttributeDefinition, 0, len(defs))
+	for _, d := range defs {
+		out = append(out, attributeModel(d))
+	}
+	return out, nil
+}
+
+// CreateCategoryAttribute defines a typed attribute on a category; its subcategories inherit it
+func (p *Product) CreateCategoryAttribute(ctx context.Context, actorID string, categoryID int64, req *models.AttributeDefinitionCreateRequest) (*models.AttributeDefinition, error) {
+	logs.Infof(ctx, "CreateCategoryAttribute called | actor_id=%s category_id=%d", actorID, categoryID)
+
+	if _, err := p.DB.GetCategory(ctx, categoryID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, errors.New("failed to load category")
+	}
+
+	d := &db.AttributeDefinition{
+		CategoryID: categoryID,
+		Code:       strings.TrimSpace(*req.Code),
+		Label:      strings.TrimSpace(*req.Label),
+		Type:       *req.Type,
+		Values:     req.Values,
+		Unit:       strings.TrimSpace(req.Unit),
+		Min:        req.Min,
+		Max:        req.Max,
+		Required:   req.Required,
+		Position:   int(req.Position),
+	}
+	if err := validateDefinition(d); err != nil {
+		return nil, err
+	}
+
+	if err := p.DB.CreateAttributeDefinition(ctx, d); err != nil {
+		if errors.Is(err, db.ErrAttributeExists) {
+			return nil, ErrAttributeExists
+		}
+		return nil, errors.New("failed to create attribute")
+	}
+
+	logs.Infof(ctx, "attribute created | actor_id=%s category_id=%d code=%s", actorID, categoryID, d.Code)
+	return attributeModel(d), nil
+}
+
+// UpdateCategoryAttribute changes an attribute defined on the category itself.
+// Products keep their stored values until they are next saved.
+func (p *Product) UpdateCategoryAttribute(ctx context.Context, actorID string, categoryID int64, code string, req *models.AttributeDefinitionUpdateRequest) (*models.AttributeDefinition, error) {
+	logs.Infof(ctx, "UpdateCategoryAttribute called | actor_id=%s category_id=%d code=%s", actorID, categoryID, code)
+
+	d, err := p.DB.GetAttributeDefinition(ctx, categoryID, code)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAttributeNotFound
+		}
+		logs.Errorf(ctx, "failed to load attribute %s of category %d: %v", code, categoryID, err)
+		return nil, errors.New("failed to load attribute")
+	}
+
+	// 🔹 1. Merge (omitted fields stay as they are)
+	if req.Label != nil {
+		d.Label = strings.TrimSpace(*req.Label)
+	}
+	if req.Values != nil {
+		d.Values = req.Values
+	}
+	if req.Unit != nil {
+		d.Unit = strings.TrimSpace(*req.Unit)
+	}
+	if req.Min != nil {
+		d.Min = req.Min
+	}
+	if req.Max != nil {
+		d.Max = req.Max
+	}
+	if req.Required != nil {
+		d.Required = *req.Required
+	}
+	if req.Position != nil {
+		d.Position = int(*req.Position)
+	}
+	if err := validateDefinition(d); err != nil {
+		return nil, err
+	}
+
+	// 🔹 2. Write
+	if err := p.DB.UpdateAttributeDefinition(ctx, d); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAttributeNotFound
+		}
+		logs.Errorf(ctx, "failed to update attribute %s of category %d: %v", code, categoryID, err)
+		return nil, errors.New("failed to update attribute")
+	}
+
+	logs.Infof(ctx, "attribute updated | actor_id=%s category_id=%d code=%s", actorID, categoryID, code)
+	return attributeModel(d), nil
+}
+
+// DeleteCategoryAttribute removes an attribute defined on the category itself
+func (p *Product) DeleteCategoryAttribute(ctx context.Context, actorID string, categoryID int64, code string) error {
+	logs.Infof(ctx, "DeleteCategoryAttribute called | actor_id=%s category_id=%d code=%s", actorID, categoryID, code)
+
+	if err := p.DB.DeleteAttributeDefinition(ctx, categoryID, code); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAttributeNotFound
+		}
+		logs.Errorf(ctx, "failed to delete attribute %s of category %d: %v", code, categoryID, err)
+		return errors.New("failed to delete attribute")
+	}
+
+	logs.Infof(ctx, "attribute deleted | actor_id=%s category_id=%d code=%s", actorID, categoryID, code)
+	return nil
+}
+
+// productAttributes checks in against the attributes of the category and
+// returns them in canonical form. When strict is false, values the category no
+// longer defines or allows are dropped instead of refused and required
+// attributes are not enforced; that is how stored values are carried over.
+func (p *Product) productAttributes(ctx context.Context, categoryID int64, in []db.ProductAttribute, strict bool) ([]db.ProductAttribute, error) {
+	defs, err := p.DB.ListAttributeDefinitions(ctx, categoryID)
+	if err != nil {
+		return nil, errors.New("failed to load category attributes")
+	}
+	return resolveAttributes(defs, in, strict)
+}
+
+func resolveAttributes(defs []*db.AttributeDefinition, in []db.ProductAttribute, strict bool) ([]db.ProductAttribute, error) {
+	byCode := make(map[string]*db.AttributeDefinition, len(defs))
+	for _, d := range defs {
+		byCode[d.Code] = d
+	}
+
+	seen := make(map[string]bool, len(in))
+	out := make([]db.ProductAttribute, 0, len(in))
+	for _, a := range in {
+		d, ok := byCode[a.Name]
+		switch {
+		case seen[a.Name] && strict:
+			return nil, fmt.Errorf("%w: attribute %q given twice", ErrInvalidProduct, a.Name)
+		case seen[a.Name]:
+			continue
+		case !ok && strict:
+			return nil, fmt.Errorf("%w: the category has no attribute %q", ErrInvalidProduct, a.Name)
+		case !ok:
+			continue
+		}
+		value, err := attributeValue(d, a.Value)
+		if err != nil {
+			if strict {
+				return nil, fmt.Errorf("%w: attribute %s %v", ErrInvalidProduct, a.Name, err)
+			}
+			continue
+		}
+		seen[a.Name] = true
+		out = append(out, value)
+	}
+
+	if strict {
+		for _, d := range defs {
+			if d.Required && !seen[d.Code] {
+				return nil, fmt.Errorf("%w: attribute %s is required", ErrInvalidProduct, d.Code)
+			}
+		}
+	}
+	return out, nil
+}
+
+// attributeValue parses raw as a value of d in its canonical text form:
+// enum values as spelled in the definition, numbers without trailing zeros,
+// booleans as true or false
+func attributeValue(d *db.AttributeDefinition, raw string) (db.ProductAttribute, error) {
+	out := db.ProductAttribute{Name: d.Code}
+	raw = strings.TrimSpace(raw)
+	switch d.Type {
+	case db.AttributeEnum:
+		for _, v := range d.Values {
+			if strings.EqualFold(v, raw) {
+				out.Value = v
+				return out, nil
+			}
+		}
+		return out, fmt.Errorf("must be one of %s", strings.Join(d.Values, ", "))
+	case db.AttributeNumber:
+		n, err := parseFinite(raw)
+		if err != nil {
+			return out, errors.New("must be a number")
+		}
+		if (d.Min != nil && n < *d.Min) || (d.Max != nil && n > *d.Max) {
+			return out, fmt.Errorf("must be between %s", numberRange(d))
+		}
+		out.Value = strconv.FormatFloat(n, 'f', -1, 64)
+		out.Number = &n
+		return out, nil
+	case db.AttributeBoolean:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return out, errors.New("must be true or false")
+		}
+		out.Value = strconv.FormatBool(b)
+		return out, nil
+	}
+	return out, fmt.Errorf("has unknown type %q", d.Type)
+}
+
+// numberRange renders the bounds of a number attribute, e.g. "0.1 and 10 ct"
+func numberRange(d *db.AttributeDefinition) string {
+	bound := func(v *float64, open string) string {
+		if v == nil {
+			return open
+		}
+		return strconv.FormatFloat(*v, 'f', -1, 64)
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s and %s %s", bound(d.Min, "-∞"), bound(d.Max, "∞"), d.Unit))
+}
+
+// requestAttributes maps request name/value pairs to their stored form, still unchecked
+func requestAttributes(in []*models.ProductAttribute) ([]db.ProductAttribute, error) {
+	out := make([]db.ProductAttribute, 0, len(in))
+	for _, a := range in {
+		if a == nil || a.Name == nil || a.Value == nil {
+			return nil, fmt.Errorf("%w: every attribute needs a name and a value", ErrInvalidProduct)
+		}
+		out = append(out, db.ProductAttribute{Name: strings.TrimSpace(*a.Name), Value: *a.Value})
+	}
+	return out, nil
+}
+
+// AttributeFilters reads the attr.<code> parameters of a listing query.
+// Repeated parameters match any of their values; a single min..max value
+// filters a number attribute by range, either end optional.
+func AttributeFilters(query url.Values) ([]db.AttributeFilter, error) {
+	filters := []db.AttributeFilter{}
+	for key, values := range query {
+		if !strings.HasPrefix(key, attributeFilterPrefix) {
+			continue
+		}
+		code := strings.TrimPrefix(key, attributeFilterPrefix)
+		if !attributeCodePattern.MatchString(code) || len(code) > maxAttributeCodeSize {
+			return nil, fmt.Errorf("%w: %q is not an attribute code", ErrInvalidAttributeFilter, code)
+		}
+		if len(values) > maxFilterValues {
+			return nil, fmt.Errorf("%w: at most %d values for %s", ErrInvalidAttributeFilter, maxFilterValues, code)
+		}
+
+		f := db.AttributeFilter{Name: code}
+		if len(values) == 1 && strings.Contains(values[0], attributeRangeSep) {
+			lo, hi, _ := strings.Cut(values[0], attributeRangeSep)
+			var err error
+			if f.Min, err = rangeBound(lo); err == nil {
+				f.Max, err = rangeBound(hi)
+			}
+			if err != nil || (f.Min == nil && f.Max == nil) {
+				return nil, fmt.Errorf("%w: %s range must look like 1..5, 1.. or ..5", ErrInvalidAttributeFilter, code)
+			}
+			if f.Min != nil && f.Max != nil && *f.Min > *f.Max {
+				return nil, fmt.Errorf("%w: %s range starts above its end", ErrInvalidAttributeFilter, code)
+			}
+		} else {
+			for _, v := range values {
+				v = strings.ToLower(strings.TrimSpace(v))
+				if v == "" || len(v) > maxAttributeValueSize {
+					return nil, fmt.Errorf("%w: %s values must be 1 to %d characters", ErrInvalidAttributeFilter, code, maxAttributeValueSize)
+				}
+				f.Values = append(f.Values, v)
+			}
+		}
+		filters = append(filters, f)
+	}
+	if len(filters) > maxAttributeFilters {
+		return nil, fmt.Errorf("%w: at most %d attributes", ErrInvalidAttributeFilter, maxAttributeFilters)
+	}
+	sort.Slice(filters, func(i, j int) bool { return filters[i].Name < filters[j].Name })
+	return filters, nil
+}
+
+// rangeBound parses one end of a min..max range; an empty end is open
+func rangeBound(raw string) (*float64, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+	n, err := parseFinite(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// parseFinite parses a number, refusing the NaN and Inf spellings ParseFloat accepts
+func parseFinite(raw string) (float64, error) {
+	n, err := strconv.ParseFloat(raw, 64)
+	if err == nil && (math.IsNaN(n) || math.IsInf(n, 0)) {
+		err = strconv.ErrSyntax
+	}
+	return n, err
+}
+
+// facetModels groups value counts by attribute: attributes by name, values by
+// count then value, at most maxFacetValues each
+func facetModels(counts []db.FacetCount) []*models.Facet {
+	byName := map[string]*models.Facet{}
+	for _, c := range counts {
+		f, ok := byName[c.Name]
+		if !ok {
+			name := c.Name
+			f = &models.Facet{Name: &name, Values: []*models.FacetValue{}}
+			byName[c.Name] = f
+		}
+		value, count := c.Value, c.Count
+		f.Values = append(f.Values, &models.FacetValue{Value: &value, Count: &count})
+	}
+
+	facets := make([]*models.Facet, 0, len(byName))
+	for _, f := range byName {
+		sort.Slice(f.Values, func(i, j int) bool {
+			if *f.Values[i].Count != *f.Values[j].Count {
+				return *f.Values[i].Count > *f.Values[j].Count
+			}
+			return *f.Values[i].Value < *f.Values[j].Value
+		})
+		if len(f.Values) > maxFacetValues {
+			f.Values = f.Values[:maxFacetValues]
+		}
+		facets = append(facets, f)
+	}
+	sort.Slice(facets, func(i, j int) bool { return *facets[i].Name < *facets[j].Name })
+	return facets
+}
+
+// attributeModel maps a stored attribute definition to its API shape
+func attributeModel(d *db.AttributeDefinition) *models.AttributeDefinition {
+	out := &models.AttributeDefinition{
+		ID:         &d.ID,
+		CategoryID: &d.CategoryID,
+		Code:       &d.Code,
+		Label:      &d.Label,
+		Type:       &d.Type,
+		Values:     d.Values,
+		Unit:       d.Unit,
+		Min:        d.Min,
+		Max:        d.Max,
+		Required:   &d.Required,
+		Position:   int64(d.Position),
+	}
+	if out.Values == nil {
+		out.Values = []string{}
+	}
+	return out
+}
+
+// validateDefinition checks what the spec cannot, and normalises values to a trimmed, non-nil list
+func validateDefinition(d *db.AttributeDefinition) error {
+	switch {
+	case !attributeCodePattern.MatchString(d.Code) || len(d.Code) > maxAttributeCodeSize:
+		return fmt.Errorf("%w: code must be lowercase letters, digits and underscores, starting with a letter", ErrInvalidAttribute)
+	case d.Label == "" || len(d.Label) > maxAttributeLabelSize:
+		return fmt.Errorf("%w: label must be 1 to %d characters", ErrInvalidAttribute, maxAttributeLabelSize)
+	case len(d.Unit) > maxAttributeUnitSize:
+		return fmt.Errorf("%w: unit must be at most %d characters", ErrInvalidAttribute, maxAttributeUnitSize)
+	case d.Position < 0:
+		return fmt.Errorf("%w: position must not be negative", ErrInvalidAttribute)
+	case len(d.Values) > maxAttributeValues:
+		return fmt.Errorf("%w: at most %d values", ErrInvalidAttribute, maxAttributeValues)
+	}
+
+	values := make([]string, 0, len(d.Values))
+	seen := make(map[string]bool, len(d.Values))
+	for _, v := range d.Values {
+		v = strings.TrimSpace(v)
+		key := strings.ToLower(v)
+		switch {
+		case v == "" || len(v) > maxAttributeValueSize:
+			return fmt.Errorf("%w: values must be 1 to %d characters", ErrInvalidAttribute, maxAttributeValueSize)
+		case seen[key]:
+			return fmt.Errorf("%w: value %q given twice", ErrInvalidAttribute, v)
+		}
+		seen[key] = true
+		values = append(values, v)
+	}
+	d.Values = values
+
+	switch d.Type {
+	case db.AttributeEnum:
+		if len(d.Values) == 0 {
+			return fmt.Errorf("%w: enum attributes need values", ErrInvalidAttribute)
+		}
+	case db.AttributeNumber:
+		if len(d.Values) > 0 {
+			return fmt.Errorf("%w: only enum attributes take values", ErrInvalidAttribute)
+		}
+		if d.Min != nil && d.Max != nil && *d.Min > *d.Max {
+			return fmt.Errorf("%w: min must not be greater than max", ErrInvalidAttribute)
+		}
+	case db.AttributeBoolean:
+		if len(d.Values) > 0 {
+			return fmt.Errorf("%w: only enum attributes take values", ErrInvalidAttribute)
+		}
+	default:
+		return fmt.Errorf("%w: type must be enum, number or boolean", ErrInvalidAttribute)
+	}
+	if d.Type != db.AttributeNumber && (d.Unit != "" || d.Min != nil || d.Max != nil) {
+		return fmt.Errorf("%w: unit, min and max apply to number attributes only", ErrInvalidAttribute)
+	}
+	return nil
+}
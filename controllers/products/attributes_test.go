@@ -0,0 +1,160 @@
+package products
+
+import (
+	db "Adornme/databases"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func ptr(f float64) *float64 { return &f }
+
+func TestAttributeFilters(t *testing.T) {
+	manyValues := url.Values{}
+	for i := 0; i <= maxFilterValues; i++ {
+		manyValues.Add("attr.metal", fmt.Sprintf("m%d", i))
+	}
+	manyFilters := url.Values{}
+	for i := 0; i <= maxAttributeFilters; i++ {
+		manyFilters.Set(fmt.Sprintf("attr.a%d", i), "x")
+	}
+
+	tests := []struct {
+		name    string
+		query   url.Values
+		want    []db.AttributeFilter
+		wantErr bool
+	}{
+		{"no attr parameters", url.Values{"category": {"3"}, "sort": {"price"}}, []db.AttributeFilter{}, false},
+		{"one value", url.Values{"attr.metal": {"Gold"}}, []db.AttributeFilter{{Name: "metal", Values: []string{"gold"}}}, false},
+		{"values trimmed and lowercased", url.Values{"attr.metal": {" Gold ", "ROSE GOLD"}}, []db.AttributeFilter{{Name: "metal", Values: []string{"gold", "rose gold"}}}, false},
+		{"sorted by code", url.Values{"attr.purity": {"22k"}, "attr.metal": {"gold"}}, []db.AttributeFilter{
+			{Name: "metal", Values: []string{"gold"}},
+			{Name: "purity", Values: []string{"22k"}},
+		}, false},
+		{"closed range", url.Values{"attr.carat": {"0.5..2"}}, []db.AttributeFilter{{Name: "carat", Min: ptr(0.5), Max: ptr(2)}}, false},
+		{"open end", url.Values{"attr.carat": {"1.."}}, []db.AttributeFilter{{Name: "carat", Min: ptr(1)}}, false},
+		{"open start", url.Values{"attr.carat": {"..5"}}, []db.AttributeFilter{{Name: "carat", Max: ptr(5)}}, false},
+		{"range with spaces", url.Values{"attr.carat": {" 1 .. 5 "}}, []db.AttributeFilter{{Name: "carat", Min: ptr(1), Max: ptr(5)}}, false},
+		{"single point range", url.Values{"attr.carat": {"2..2"}}, []db.AttributeFilter{{Name: "carat", Min: ptr(2), Max: ptr(2)}}, false},
+		{"repeated range is a value list", url.Values{"attr.size": {"1..2", "3"}}, []db.AttributeFilter{{Name: "size", Values: []string{"1..2", "3"}}}, false},
+
+		{"empty code", url.Values{"attr.": {"x"}}, nil, true},
+		{"uppercase code", url.Values{"attr.Metal": {"gold"}}, nil, true},
+		{"code with a dot", url.Values{"attr.metal.colour": {"gold"}}, nil, true},
+		{"code too long", url.Values{"attr." + strings.Repeat("a", maxAttributeCodeSize+1): {"x"}}, nil, true},
+		{"empty value", url.Values{"attr.metal": {"  "}}, nil, true},
+		{"value too long", url.Values{"attr.metal": {strings.Repeat("a", maxAttributeValueSize+1)}}, nil, true},
+		{"too many values", manyValues, nil, true},
+		{"too many filters", manyFilters, nil, true},
+		{"range without ends", url.Values{"attr.carat": {".."}}, nil, true},
+		{"range of words", url.Values{"attr.carat": {"a..b"}}, nil, true},
+		{"range starts above its end", url.Values{"attr.carat": {"5..1"}}, nil, true},
+		{"NaN range", url.Values{"attr.carat": {"NaN..5"}}, nil, true},
+		{"infinite range", url.Values{"attr.carat": {"..Inf"}}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AttributeFilters(tt.query)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidAttributeFilter) {
+					t.Fatalf("AttributeFilters() error = %v, want %v", err, ErrInvalidAttributeFilter)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AttributeFilters() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("AttributeFilters() = %s, want %s", describeFilters(got), describeFilters(tt.want))
+			}
+		})
+	}
+}
+
+func describeFilters(fs []db.AttributeFilter) string {
+	parts := []string{}
+	bound := func(v *float64) string {
+		if v == nil {
+			return "-"
+		}
+		return fmt.Sprint(*v)
+	}
+	for _, f := range fs {
+		parts = append(parts, fmt.Sprintf("%s%v[%s,%s]", f.Name, f.Values, bound(f.Min), bound(f.Max)))
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestResolveAttributes(t *testing.T) {
+	defs := []*db.AttributeDefinition{
+		{Code: "metal", Type: db.AttributeEnum, Values: []string{"Gold", "Rose Gold", "Silver"}, Required: true},
+		{Code: "carat", Type: db.AttributeNumber, Min: ptr(0.1), Max: ptr(10), Unit: "ct"},
+		{Code: "hallmarked", Type: db.AttributeBoolean},
+	}
+	attr := func(name, value string) db.ProductAttribute { return db.ProductAttribute{Name: name, Value: value} }
+
+	tests := []struct {
+		name    string
+		in      []db.ProductAttribute
+		strict  bool
+		want    []string // name=value, in order
+		wantErr string   // substring; empty means no error
+	}{
+		{"canonical forms", []db.ProductAttribute{
+			attr("metal", " rose gold "), attr("carat", "2.50"), attr("hallmarked", "TRUE"),
+		}, true, []string{"metal=Rose Gold", "carat=2.5", "hallmarked=true"}, ""},
+		{"boolean digits", []db.ProductAttribute{attr("metal", "gold"), attr("hallmarked", "0")}, true, []string{"metal=Gold", "hallmarked=false"}, ""},
+		{"number bounds inclusive", []db.ProductAttribute{attr("metal", "gold"), attr("carat", "10")}, true, []string{"metal=Gold", "carat=10"}, ""},
+		{"exponent normalised", []db.ProductAttribute{attr("metal", "gold"), attr("carat", "5e-1")}, true, []string{"metal=Gold", "carat=0.5"}, ""},
+
+		{"required missing", []db.ProductAttribute{attr("carat", "1")}, true, nil, "metal is required"},
+		{"unknown attribute", []db.ProductAttribute{attr("metal", "gold"), attr("stone", "ruby")}, true, nil, `no attribute "stone"`},
+		{"given twice", []db.ProductAttribute{attr("metal", "gold"), attr("metal", "silver")}, true, nil, "given twice"},
+		{"enum value not allowed", []db.ProductAttribute{attr("metal", "platinum")}, true, nil, "must be one of Gold, Rose Gold, Silver"},
+		{"number below range", []db.ProductAttribute{attr("metal", "gold"), attr("carat", "0.05")}, true, nil, "between 0.1 and 10 ct"},
+		{"number above range", []db.ProductAttribute{attr("metal", "gold"), attr("carat", "11")}, true, nil, "between 0.1 and 10 ct"},
+		{"not a number", []db.ProductAttribute{attr("metal", "gold"), attr("carat", "two")}, true, nil, "must be a number"},
+		{"NaN", []db.ProductAttribute{attr("metal", "gold"), attr("carat", "NaN")}, true, nil, "must be a number"},
+		{"not a boolean", []db.ProductAttribute{attr("metal", "gold"), attr("hallmarked", "yes")}, true, nil, "true or false"},
+
+		// carried-over values: bad ones are dropped, required ones are not enforced
+		{"lenient drops unknown and invalid", []db.ProductAttribute{
+			attr("stone", "ruby"), attr("carat", "99"), attr("hallmarked", "false"),
+		}, false, []string{"hallmarked=false"}, ""},
+		{"lenient keeps the first of duplicates", []db.ProductAttribute{
+			attr("metal", "silver"), attr("metal", "gold"),
+		}, false, []string{"metal=Silver"}, ""},
+		{"lenient skips an invalid duplicate", []db.ProductAttribute{
+			attr("metal", "platinum"), attr("metal", "gold"),
+		}, false, []string{"metal=Gold"}, ""},
+		{"lenient empty", nil, false, []string{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveAttributes(defs, tt.in, tt.strict)
+			if tt.wantErr != "" {
+				if !errors.Is(err, ErrInvalidProduct) || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("resolveAttributes() error = %v, want %v containing %q", err, ErrInvalidProduct, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveAttributes() error = %v", err)
+			}
+			pairs := []string{}
+			for _, a := range got {
+				pairs = append(pairs, a.Name+"="+a.Value)
+				if (a.Number != nil) != (a.Name == "carat") {
+					t.Errorf("%s: value_num set = %t", a.Name, a.Number != nil)
+				}
+			}
+			if !reflect.DeepEqual(pairs, tt.want) {
+				t.Fatalf("resolveAttributes() = %v, want %v", pairs, tt.want)
+			}
+		})
+	}
+}
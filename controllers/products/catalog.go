@@ -0,0 +1,138 @@
+package products
+
+import (
+	db "Adornme/databases"
+	"Adornme/models"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jackc/pgx/v5"
+)
+
+// maxProductListLimit caps the page size of the storefront listing
+const maxProductListLimit = 100
+
+// Catalog errors
+var (
+	ErrProductNotFound        = errors.New("product not found")
+	ErrInvalidProductListPage = errors.New("page must be at least 1 and limit between 1 and 100")
+	ErrInvalidPriceRange      = errors.New("minPrice must not be greater than maxPrice")
+	ErrInvalidProductSort     = errors.New("sort must be one of newest, price_asc, price_desc, popularity")
+)
+
+// ListProducts returns one page of the catalog matching filter
+func (p *Product) ListProducts(ctx context.Context, filter db.ProductFilter, page, limit int64) (*models.ProductListResponse, error) {
+	logs.Infof(ctx, "ListProducts called with requestID: %s, page: %d, limit: %d", p.RequestID, page, limit)
+
+	if page < 1 || limit < 1 || limit > maxProductListLimit {
+		return nil, ErrInvalidProductListPage
+	}
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return nil, ErrInvalidPriceRange
+	}
+	switch filter.Sort {
+	case "":
+		filter.Sort = db.ProductSortNewest
+	case db.ProductSortNewest, db.ProductSortPriceAsc, db.ProductSortPriceDesc, db.ProductSortPopular:
+	default:
+		return nil, ErrInvalidProductSort
+	}
+	filter.Search = strings.TrimSpace(filter.Search)
+	filter.Limit = int(limit)
+	filter.Offset = int((page - 1) * limit)
+
+	products, total, err := p.DB.ListProducts(ctx, filter)
+	if err != nil {
+		return nil, errors.New("failed to list products")
+	}
+	if err := p.loadDetails(ctx, products...); err != nil {
+		return nil, errors.New("failed to list products")
+	}
+
+	// Facets are a refinement aid; the page still renders without them
+	facets := []*models.Facet{}
+	if counts, err := p.DB.ProductFacets(ctx, filter); err == nil {
+		facets = facetModels(counts)
+	}
+
+	idx := p.breadcrumbIndex(ctx)
+	items := make([]*models.Product, 0, len(products))
+	for _, prod := range products {
+		items = append(items, productModel(prod, idx))
+	}
+	return &models.ProductListResponse{
+		Facets:     facets,
+		Items:      items,
+		Total:      total,
+		TotalPages: (total + limit - 1) / limit,
+		Page:       page,
+		Limit:      limit,
+	}, nil
+}
+
+// GetProduct returns a single product; archived ones still resolve, marked with archivedAt
+func (p *Product) GetProduct(ctx context.Context, id int64) (*models.Product, error) {
+	logs.Infof(ctx, "GetProduct called with requestID: %s, productID: %d", p.RequestID, id)
+
+	prod, err := p.DB.GetProduct(ctx, int(id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrProductNotFound
+		}
+		logs.Errorf(ctx, "failed to load product %d: %v", id, err)
+		return nil, errors.New("failed to load product")
+	}
+	if err := p.loadDetails(ctx, prod); err != nil {
+		return nil, errors.New("failed to load product")
+	}
+	return productModel(prod, p.breadcrumbIndex(ctx)), nil
+}
+
+// productModel maps a stored product to its API shape; uncategorised products report category 0.
+// Breadcrumbs come from idx and are left empty when it is nil.
+func productModel(prod *db.Product, idx *categoryIndex) *models.Product {
+	id := int64(prod.ID)
+	price := float32(prod.Price)
+	stock := int64(prod.Inventory)
+	var categoryID int64
+	if prod.CategoryID != nil {
+		categoryID = *prod.CategoryID
+	}
+	out := &models.Product{
+		ID:          &id,
+		Name:        &prod.Name,
+		Description: prod.Description,
+		Price:       &price,
+		Currency:    &prod.Currency,
+		Stock:       &stock,
+		CategoryID:  &categoryID,
+		Images:      prod.Images,
+		Breadcrumbs: []*models.CategoryRef{},
+		Options:     make([]*models.ProductOption, 0, len(prod.Options)),
+		Variants:    make([]*models.ProductVariant, 0, len(prod.Variants)),
+		Attributes:  make([]*models.ProductAttribute, 0, len(prod.Attributes)),
+		Version:     &prod.Version,
+		CreatedAt:   strfmt.DateTime(prod.CreatedAt),
+		UpdatedAt:   strfmt.DateTime(prod.UpdatedAt),
+	}
+	for _, axis := range prod.Options {
+		out.Options = append(out.Options, &models.ProductOption{Name: &axis.Name, Values: axis.Values})
+	}
+	for _, v := range prod.Variants {
+		out.Variants = append(out.Variants, variantModel(v, prod.Options))
+	}
+	for _, a := range prod.Attributes {
+		name, value := a.Name, a.Value
+		out.Attributes = append(out.Attributes, &models.ProductAttribute{Name: &name, Value: &value})
+	}
+	if idx != nil && prod.CategoryID != nil {
+		out.Breadcrumbs = idx.breadcrumbs(*prod.CategoryID)
+	}
+	if prod.ArchivedAt != nil {
+		archivedAt := strfmt.DateTime(*prod.ArchivedAt)
+		out.ArchivedAt = &archivedAt
+	}
+	return out
+}
@@ -0,0 +1,321 @@
+package products
+
+import (
+	db "Adornme/databases"
+	"Adornme/models"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+// categoryCacheKey holds the flat category list that the tree and breadcrumbs are built from
+const categoryCacheKey = "catalog:categories"
+
+// Limits on category input
+const maxCategoryNameSize = 100
+
+// Category errors
+var (
+	ErrCategoryNotFound  = errors.New("category not found")
+	ErrInvalidCategory   = errors.New("invalid category")
+	ErrCategorySlugTaken = errors.New("category slug already in use")
+	ErrCategoryInUse     = errors.New("category still has subcategories or products")
+)
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+var slugSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+
+// redisStore returns the Redis provider, or nil when Redis is disabled.
+// The category cache is skipped without Redis; reads go to Postgres.
+func redisStore() *db.RedisProvider {
+	rp, ok := db.Do["redis"].(*db.RedisProvider)
+	if !ok || rp == nil || rp.Client == nil {
+		return nil
+	}
+	return rp
+}
+
+// categoryIndex is the category table in memory, looked up by id and by parent
+type categoryIndex struct {
+	byID     map[int64]*db.Category
+	children map[int64][]*db.Category // top-level categories under 0, siblings in display order
+}
+
+func newCategoryIndex(categories []*db.Category) *categoryIndex {
+	idx := &categoryIndex{
+		byID:     make(map[int64]*db.Category, len(categories)),
+		children: make(map[int64][]*db.Category),
+	}
+	for _, c := range categories {
+		idx.byID[c.ID] = c
+		var parent int64
+		if c.ParentID != nil {
+			parent = *c.ParentID
+		}
+		idx.children[parent] = append(idx.children[parent], c)
+	}
+	return idx
+}
+
+// tree returns the categories below parent, nested
+func (idx *categoryIndex) tree(parent int64, depth int) []*models.CategoryNode {
+	nodes := make([]*models.CategoryNode, 0, len(idx.children[parent]))
+	if depth > db.MaxCategoryDepth {
+		return nodes
+	}
+	for _, c := range idx.children[parent] {
+		nodes = append(nodes, &models.CategoryNode{
+			ID:       &c.ID,
+			Name:     &c.Name,
+			Slug:     &c.Slug,
+			Position: int64(c.Position),
+			Children: idx.tree(c.ID, depth+1),
+		})
+	}
+	return nodes
+}
+
+// breadcrumbs returns the path from the root down to id, inclusive
+func (idx *categoryIndex) breadcrumbs(id int64) []*models.CategoryRef {
+	path := []*models.CategoryRef{}
+	c := idx.byID[id]
+	for depth := 0; c != nil && depth <= db.MaxCategoryDepth; depth++ {
+		path = append([]*models.CategoryRef{{ID: &c.ID, Name: &c.Name, Slug: &c.Slug}}, path...)
+		if c.ParentID == nil {
+			break
+		}
+		c = idx.byID[*c.ParentID]
+	}
+	return path
+}
+
+// categoryIndex loads every category, from the Redis cache when it is warm
+func (p *Product) categoryIndex(ctx context.Context) (*categoryIndex, error) {
+	rp := redisStore()
+	if rp != nil {
+		cached, err := rp.GetCached(ctx, categoryCacheKey)
+		if err == nil {
+			var categories []*db.Category
+			if err := json.Unmarshal([]byte(cached), &categories); err == nil {
+				return newCategoryIndex(categories), nil
+			}
+		} else if !errors.Is(err, redis.Nil) {
+			logs.Errorf(ctx, "category cache lookup failed: %v", err)
+		}
+	}
+
+	categories, err := p.DB.ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rp != nil {
+		ttl := time.Duration(cfg.CategoryTreeCacheSeconds) * time.Second
+		if raw, err := json.Marshal(categories); err == nil && ttl > 0 {
+			if err := rp.SetCached(ctx, categoryCacheKey, string(raw), ttl); err != nil {
+				logs.Errorf(ctx, "failed to cache categories: %v", err)
+			}
+		}
+	}
+	return newCategoryIndex(categories), nil
+}
+
+// breadcrumbIndex is categoryIndex for product responses: without it they
+// still render, just without breadcrumbs
+func (p *Product) breadcrumbIndex(ctx context.Context) *categoryIndex {
+	idx, err := p.categoryIndex(ctx)
+	if err != nil {
+		logs.Errorf(ctx, "failed to load categories for breadcrumbs: %v", err)
+		return nil
+	}
+	return idx
+}
+
+// dropCategoryCache makes the next read rebuild the tree after a write
+func dropCategoryCache(ctx context.Context) {
+	rp := redisStore()
+	if rp == nil {
+		return
+	}
+	if err := rp.DropCached(ctx, categoryCacheKey); err != nil {
+		logs.Errorf(ctx, "failed to drop category cache: %v", err)
+	}
+}
+
+// ListCategories returns the whole category tree for navigation menus
+func (p *Product) ListCategories(ctx context.Context) ([]*models.CategoryNode, error) {
+	logs.Infof(ctx, "ListCategories called with requestID: %s", p.RequestID)
+
+	idx, err := p.categoryIndex(ctx)
+	if err != nil {
+		return nil, errors.New("failed to load categories")
+	}
+	return idx.tree(0, 0), nil
+}
+
+// CreateCategory adds a category, at the top level or under parentId.
+// The slug is derived from the name when not given.
+func (p *Product) CreateCategory(ctx context.Context, actorID string, req *models.CategoryCreateRequest) (*models.Category, error) {
+	logs.Infof(ctx, "CreateCategory called | actor_id=%s", actorID)
+
+	c := &db.Category{
+		Name:     strings.TrimSpace(*req.Name),
+		Slug:     strings.TrimSpace(req.Slug),
+		Position: int(req.Position),
+	}
+	if req.ParentID > 0 {
+		c.ParentID = &req.ParentID
+	}
+	if c.Slug == "" {
+		c.Slug = slugify(c.Name)
+	}
+	if err := validateCategory(c); err != nil {
+		return nil, err
+	}
+
+	if err := p.DB.CreateCategory(ctx, c); err != nil {
+		return nil, categoryWriteError(err, "failed to create category")
+	}
+	dropCategoryCache(ctx)
+
+	logs.Infof(ctx, "category created | actor_id=%s category_id=%d", actorID, c.ID)
+	return p.categoryModel(ctx, c), nil
+}
+
+// UpdateCategory renames, reorders or moves a category; parentId 0 moves it to the top level
+func (p *Product) UpdateCategory(ctx context.Context, actorID string, id int64, req *models.CategoryUpdateRequest) (*models.Category, error) {
+	logs.Infof(ctx, "UpdateCategory called | actor_id=%s category_id=%d", actorID, id)
+
+	c, err := p.DB.GetCategory(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, errors.New("failed to load category")
+	}
+
+	// 🔹 1. Merge (omitted fields stay as they are)
+	if req.Name != nil {
+		c.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Slug != nil {
+		c.Slug = strings.TrimSpace(*req.Slug)
+	}
+	if req.Position != nil {
+		c.Position = int(*req.Position)
+	}
+	if req.ParentID != nil {
+		c.ParentID = nil
+		if *req.ParentID > 0 {
+			c.ParentID = req.ParentID
+		}
+	}
+	if err := validateCategory(c); err != nil {
+		return nil, err
+	}
+
+	// 🔹 2. Write; the store refuses moves that would create a loop or nest too deep
+	if err := p.DB.UpdateCategory(ctx, c); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, categoryWriteError(err, "failed to update category")
+	}
+	dropCategoryCache(ctx)
+
+	logs.Infof(ctx, "category updated | actor_id=%s category_id=%d", actorID, id)
+	return p.categoryModel(ctx, c), nil
+}
+
+// DeleteCategory removes a category that has no subcategories and no live products;
+// archived products in it are left without a category
+func (p *Product) DeleteCategory(ctx context.Context, actorID string, id int64) error {
+	logs.Infof(ctx, "DeleteCategory called | actor_id=%s category_id=%d", actorID, id)
+
+	if err := p.DB.DeleteCategory(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return ErrCategoryNotFound
+		case errors.Is(err, db.ErrCategoryInUse):
+			return ErrCategoryInUse
+		}
+		logs.Errorf(ctx, "failed to delete category %d: %v", id, err)
+		return errors.New("failed to delete category")
+	}
+	dropCategoryCache(ctx)
+
+	logs.Infof(ctx, "category deleted | actor_id=%s category_id=%d", actorID, id)
+	return nil
+}
+
+// checkCategory makes sure a product points at an existing category
+func (p *Product) checkCategory(ctx context.Context, id int64) error {
+	if _, err := p.DB.GetCategory(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: category %d does not exist", ErrInvalidProduct, id)
+		}
+		logs.Errorf(ctx, "failed to load category %d: %v", id, err)
+		return errors.New("failed to load category")
+	}
+	return nil
+}
+
+// categoryModel maps a stored category to its API shape, with its breadcrumbs
+func (p *Product) categoryModel(ctx context.Context, c *db.Category) *models.Category {
+	out := &models.Category{
+		ID:          &c.ID,
+		Name:        &c.Name,
+		Slug:        &c.Slug,
+		ParentID:    c.ParentID,
+		Position:    int64(c.Position),
+		Breadcrumbs: []*models.CategoryRef{},
+		CreatedAt:   strfmt.DateTime(c.CreatedAt),
+		UpdatedAt:   strfmt.DateTime(c.UpdatedAt),
+	}
+	if idx := p.breadcrumbIndex(ctx); idx != nil {
+		out.Breadcrumbs = idx.breadcrumbs(c.ID)
+	}
+	return out
+}
+
+// categoryWriteError maps store errors on category writes to service errors
+func categoryWriteError(err error, fallback string) error {
+	switch {
+	case errors.Is(err, db.ErrSlugTaken):
+		return ErrCategorySlugTaken
+	case errors.Is(err, db.ErrUnknownParent):
+		return fmt.Errorf("%w: parent category does not exist", ErrInvalidCategory)
+	case errors.Is(err, db.ErrCategoryCycle):
+		return fmt.Errorf("%w: a category cannot be moved under itself or one of its subcategories", ErrInvalidCategory)
+	case errors.Is(err, db.ErrCategoryDepth):
+		return fmt.Errorf("%w: categories can be nested at most %d levels deep", ErrInvalidCategory, db.MaxCategoryDepth+1)
+	}
+	return errors.New(fallback)
+}
+
+// validateCategory checks what the spec cannot
+func validateCategory(c *db.Category) error {
+	switch {
+	case c.Name == "" || len(c.Name) > maxCategoryNameSize:
+		return fmt.Errorf("%w: name must be 1 to %d characters", ErrInvalidCategory, maxCategoryNameSize)
+	case !slugPattern.MatchString(c.Slug) || len(c.Slug) > maxCategoryNameSize:
+		return fmt.Errorf("%w: slug must be lowercase letters and digits separated by single hyphens", ErrInvalidCategory)
+	case c.Position < 0:
+		return fmt.Errorf("%w: position must not be negative", ErrInvalidCategory)
+	case c.ParentID != nil && *c.ParentID == c.ID:
+		return fmt.Errorf("%w: a category cannot be its own parent", ErrInvalidCategory)
+	}
+	return nil
+}
+
+// slugify turns "Engagement Rings" into "engagement-rings"
+func slugify(name string) string {
+	return strings.Trim(slugSeparators.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
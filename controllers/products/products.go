@@ -0,0 +1,64 @@
+package products
+
+import (
+	"Adornme/config"
+	db "Adornme/databases"
+	"Adornme/logging"
+	"Adornme/models"
+	"context"
+)
+
+var logs = logging.Component("products")
+
+var cfg = config.LoadConfig()
+
+// Product struct holds request-related metadata for tracking
+type Product struct {
+	RequestID   string
+	InstanceID  string
+	ServiceName string
+	AcceptLang  string
+	DB          db.PostgresProvider
+	Inventory   *db.PostgresProvider // inventorydb; nil when it is not configured
+}
+
+// Products interface defines catalog operations
+type Products interface {
+	ListProducts(ctx context.Context, filter db.ProductFilter, page, limit int64) (*models.ProductListResponse, error)
+	GetProduct(ctx context.Context, id int64) (*models.Product, error)
+	CreateProduct(ctx context.Context, actorID string, req *models.ProductCreateRequest) (*models.Product, error)
+	UpdateProduct(ctx context.Context, actorID string, id int64, req *models.ProductUpdateRequest) (*models.Product, error)
+	DeleteProduct(ctx context.Context, actorID string, id int64) error
+	CreateVariant(ctx context.Context, actorID string, productID int64, req *models.VariantCreateRequest) (*models.ProductVariant, error)
+	UpdateVariant(ctx context.Context, actorID string, productID, variantID int64, req *models.VariantUpdateRequest) (*models.ProductVariant, error)
+	DeleteVariant(ctx context.Context, actorID string, productID, variantID int64) error
+	ListCategories(ctx context.Context) ([]*models.CategoryNode, error)
+	CreateCategory(ctx context.Context, actorID string, req *models.CategoryCreateRequest) (*models.Category, error)
+	UpdateCategory(ctx context.Context, actorID string, id int64, req *models.CategoryUpdateRequest) (*models.Category, error)
+	DeleteCategory(ctx context.Context, actorID string, id int64) error
+	ListCategoryAttributes(ctx context.Context, categoryID int64) ([]*models.AttributeDefinition, error)
+	CreateCategoryAttribute(ctx context.Context, actorID string, categoryID int64, req *models.AttributeDefinitionCreateRequest) (*models.AttributeDefinition, error)
+	UpdateCategoryAttribute(ctx context.Context, actorID string, categoryID int64, code string, req *models.AttributeDefinitionUpdateRequest) (*models.AttributeDefinition, error)
+	DeleteCategoryAttribute(ctx context.Context, actorID string, categoryID int64, code string) error
+}
+
+// NewProduct initializes a Product instance with request metadata
+func NewProduct(reqID, acceptLang, instanceID, serviceName string) Products {
+	// Get the postgres client from registry
+	pgAny := db.Do["postgres"]
+
+	// Type assert to PostgresClients
+	pgClients, ok := pgAny.(*db.PostgresClients)
+	if !ok || pgClients.ProductsDB == nil {
+		panic("postgres client not initialized properly")
+	}
+
+	return &Product{
+		RequestID:   reqID,
+		InstanceID:  instanceID,
+		ServiceName: serviceName,
+		AcceptLang:  acceptLang,
+		DB:          *pgClients.ProductsDB, // ✅ inject ProductsDB
+		Inventory:   pgClients.InventoryDB,
+	}
+}
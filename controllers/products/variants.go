@@ -0,0 +1,392 @@
+package products
+
+import (
+	db "Adornme/databases"
+	"Adornme/models"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Limits on option axes and variants
+const (
+	maxProductOptions = 5
+	maxOptionValues   = 50
+	maxOptionNameSize = 50
+)
+
+// Variant errors
+var (
+	ErrVariantNotFound = errors.New("variant not found")
+	ErrInvalidVariant  = errors.New("invalid variant")
+	ErrSKUTaken        = errors.New("sku already in use")
+	ErrBarcodeTaken    = errors.New("barcode already in use")
+	ErrVariantExists   = errors.New("product already has a variant with these options")
+)
+
+var (
+	skuPattern     = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+	barcodePattern = regexp.MustCompile(`^[0-9]{8,14}$`)
+)
+
+// CreateVariant adds a SKU to a product. Its options must pick exactly one
+// value from each of the product's option axes.
+func (p *Product) CreateVariant(ctx context.Context, actorID string, productID int64, req *models.VariantCreateRequest) (*models.ProductVariant, error) {
+	logs.Infof(ctx, "CreateVariant called | actor_id=%s product_id=%d", actorID, productID)
+
+	prod, err := p.liveProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := variantOptions(req.Options)
+	if err != nil {
+		return nil, err
+	}
+	v := &db.ProductVariant{
+		ProductID:   prod.ID,
+		SKU:         strings.TrimSpace(*req.Sku),
+		Options:     options,
+		Price:       float64(*req.Price),
+		WeightGrams: float64(req.Weight),
+		Stock:       int(*req.Stock),
+	}
+	if barcode := strings.TrimSpace(req.Barcode); barcode != "" {
+		v.Barcode = &barcode
+	}
+	if err := validateVariant(v, prod.Options); err != nil {
+		return nil, err
+	}
+
+	if err := p.DB.CreateVariant(ctx, v, prod.Version); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrProductNotFound
+		}
+		return nil, variantWriteError(err, "failed to create variant")
+	}
+
+	p.syncInventory(ctx, v.ProductID, v.ID, v.Stock)
+
+	logs.Infof(ctx, "variant created | actor_id=%s product_id=%d variant_id=%d sku=%s", actorID, productID, v.ID, v.SKU)
+	return variantModel(v, prod.Options), nil
+}
+
+// UpdateVariant applies the fields set in req to a live variant
+func (p *Product) UpdateVariant(ctx context.Context, actorID string, productID, variantID int64, req *models.VariantUpdateRequest) (*models.ProductVariant, error) {
+	logs.Infof(ctx, "UpdateVariant called | actor_id=%s product_id=%d variant_id=%d", actorID, productID, variantID)
+
+	prod, err := p.liveProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	v, err := p.DB.GetVariant(ctx, prod.ID, variantID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrVariantNotFound
+		}
+		return nil, errors.New("failed to load variant")
+	}
+
+	// 🔹 1. Merge (omitted fields stay as they are)
+	if req.Sku != nil {
+		v.SKU = strings.TrimSpace(*req.Sku)
+	}
+	if req.Options != nil {
+		if v.Options, err = variantOptions(req.Options); err != nil {
+			return nil, err
+		}
+	}
+	if req.Price != nil {
+		v.Price = float64(*req.Price)
+	}
+	if req.Weight != nil {
+		v.WeightGrams = float64(*req.Weight)
+	}
+	if req.Stock != nil {
+		v.Stock = int(*req.Stock)
+	}
+	if req.Barcode != nil {
+		v.Barcode = nil
+		if barcode := strings.TrimSpace(*req.Barcode); barcode != "" {
+			v.Barcode = &barcode
+		}
+	}
+	if err := validateVariant(v, prod.Options); err != nil {
+		return nil, err
+	}
+
+	// 🔹 2. Write; the product's price and stock follow in the same transaction
+	if err := p.DB.UpdateVariant(ctx, v, prod.Version); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrVariantNotFound
+		}
+		return nil, variantWriteError(err, "failed to update variant")
+	}
+
+	p.syncInventory(ctx, v.ProductID, v.ID, v.Stock)
+
+	logs.Infof(ctx, "variant updated | actor_id=%s product_id=%d variant_id=%d", actorID, productID, variantID)
+	return variantModel(v, prod.Options), nil
+}
+
+// DeleteVariant archives a variant: it leaves the product but carts and orders still resolve it
+func (p *Product) DeleteVariant(ctx context.Context, actorID string, productID, variantID int64) error {
+	logs.Infof(ctx, "DeleteVariant called | actor_id=%s product_id=%d variant_id=%d", actorID, productID, variantID)
+
+	if err := p.DB.ArchiveVariant(ctx, int(productID), variantID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrVariantNotFound
+		}
+		logs.Errorf(ctx, "failed to archive variant %d: %v", variantID, err)
+		return errors.New("failed to delete variant")
+	}
+
+	// an archived SKU can no longer be sold
+	p.syncInventory(ctx, int(productID), variantID, 0)
+
+	logs.Infof(ctx, "variant archived | actor_id=%s product_id=%d variant_id=%d", actorID, productID, variantID)
+	return nil
+}
+
+// syncInventory mirrors a variant's stock into its inventorydb row. The two
+// databases cannot share a transaction, so product_variants stays the source of
+// truth and a failed mirror is only logged; the next write of the variant
+// repairs it.
+func (p *Product) syncInventory(ctx context.Context, productID int, variantID int64, stock int) {
+	if p.Inventory == nil {
+		return
+	}
+	if err := p.Inventory.SetSkuStock(ctx, productID, variantID, stock); err != nil {
+		logs.Errorf(ctx, "inventory out of step with variant %d: %v", variantID, err)
+	}
+}
+
+// liveProduct loads a product that variants can be written to
+func (p *Product) liveProduct(ctx context.Context, id int64) (*db.Product, error) {
+	prod, err := p.DB.GetProduct(ctx, int(id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrProductNotFound
+		}
+		logs.Errorf(ctx, "failed to load product %d: %v", id, err)
+		return nil, errors.New("failed to load product")
+	}
+	if prod.ArchivedAt != nil {
+		return nil, ErrProductNotFound
+	}
+	return prod, nil
+}
+
+// loadDetails attaches the live variants and the attribute values to each product
+func (p *Product) loadDetails(ctx context.Context, products ...*db.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+	ids := make([]int, 0, len(products))
+	for _, prod := range products {
+		ids = append(ids, prod.ID)
+	}
+	variants, err := p.DB.ListVariants(ctx, ids)
+	if err != nil {
+		return err
+	}
+	attributes, err := p.DB.ListProductAttributes(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, prod := range products {
+		prod.Variants = variants[prod.ID]
+		prod.Attributes = attributes[prod.ID]
+	}
+	return nil
+}
+
+// variantOptions turns the request's name/value pairs into a map, refusing repeated names
+func variantOptions(in []*models.VariantOption) (map[string]string, error) {
+	out := make(map[string]string, len(in))
+	for _, o := range in {
+		if o == nil || o.Name == nil || o.Value == nil {
+			return nil, fmt.Errorf("%w: every option needs a name and a value", ErrInvalidVariant)
+		}
+		name := strings.TrimSpace(*o.Name)
+		if _, dup := out[name]; dup {
+			return nil, fmt.Errorf("%w: option %q given twice", ErrInvalidVariant, name)
+		}
+		out[name] = strings.TrimSpace(*o.Value)
+	}
+	return out, nil
+}
+
+// productOptions maps request option axes to their stored form
+func productOptions(in []*models.ProductOption) []db.ProductOption {
+	out := make([]db.ProductOption, 0, len(in))
+	for _, o := range in {
+		if o == nil {
+			continue
+		}
+		var name string
+		if o.Name != nil {
+			name = *o.Name
+		}
+		out = append(out, db.ProductOption{Name: name, Values: o.Values})
+	}
+	return out
+}
+
+// validateOptions checks and trims a product's option axes
+func validateOptions(axes []db.ProductOption) error {
+	if len(axes) > maxProductOptions {
+		return fmt.Errorf("%w: at most %d options", ErrInvalidProduct, maxProductOptions)
+	}
+	names := make(map[string]bool, len(axes))
+	for i := range axes {
+		axis := &axes[i]
+		axis.Name = strings.TrimSpace(axis.Name)
+		if axis.Name == "" || len(axis.Name) > maxOptionNameSize || names[strings.ToLower(axis.Name)] {
+			return fmt.Errorf("%w: option names must be unique and 1 to %d characters", ErrInvalidProduct, maxOptionNameSize)
+		}
+		names[strings.ToLower(axis.Name)] = true
+
+		if len(axis.Values) == 0 || len(axis.Values) > maxOptionValues {
+			return fmt.Errorf("%w: option %q needs 1 to %d values", ErrInvalidProduct, axis.Name, maxOptionValues)
+		}
+		values := make(map[string]bool, len(axis.Values))
+		for j, value := range axis.Values {
+			value = strings.TrimSpace(value)
+			if value == "" || len(value) > maxOptionNameSize || values[value] {
+				return fmt.Errorf("%w: values of option %q must be unique and 1 to %d characters", ErrInvalidProduct, axis.Name, maxOptionNameSize)
+			}
+			values[value] = true
+			axis.Values[j] = value
+		}
+	}
+	return nil
+}
+
+// fitsOptions reports whether options pick exactly one offered value per axis
+func fitsOptions(options map[string]string, axes []db.ProductOption) error {
+	if len(options) != len(axes) {
+		return fmt.Errorf("needs exactly one value for each of the %d product options", len(axes))
+	}
+	for _, axis := range axes {
+		value, ok := options[axis.Name]
+		if !ok {
+			return fmt.Errorf("has no value for option %q", axis.Name)
+		}
+		offered := false
+		for _, v := range axis.Values {
+			offered = offered || v == value
+		}
+		if !offered {
+			return fmt.Errorf("value %q is not offered for option %q", value, axis.Name)
+		}
+	}
+	return nil
+}
+
+// validateVariant checks what the spec cannot
+func validateVariant(v *db.ProductVariant, axes []db.ProductOption) error {
+	switch {
+	case !skuPattern.MatchString(v.SKU):
+		return fmt.Errorf("%w: sku must be 1 to 64 letters, digits, dots, dashes or underscores", ErrInvalidVariant)
+	case v.Price <= 0 || v.Price > maxProductPrice:
+		return fmt.Errorf("%w: price must be greater than 0 and at most %.2f", ErrInvalidVariant, maxProductPrice)
+	case v.WeightGrams < 0:
+		return fmt.Errorf("%w: weight must not be negative", ErrInvalidVariant)
+	case v.Stock < 0:
+		return fmt.Errorf("%w: stock must not be negative", ErrInvalidVariant)
+	case v.Barcode != nil && !validGTIN(*v.Barcode):
+		return fmt.Errorf("%w: barcode is not a valid GTIN", ErrInvalidVariant)
+	}
+	if err := fitsOptions(v.Options, axes); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidVariant, err)
+	}
+	return nil
+}
+
+// validGTIN checks the length and mod-10 check digit of an EAN/UPC code
+func validGTIN(code string) bool {
+	switch len(code) {
+	case 8, 12, 13, 14:
+	default:
+		return false
+	}
+	if !barcodePattern.MatchString(code) {
+		return false
+	}
+	sum := 0
+	for i := len(code) - 2; i >= 0; i-- {
+		d := int(code[i] - '0')
+		if (len(code)-2-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10-sum%10)%10 == int(code[len(code)-1]-'0')
+}
+
+// variantModel maps a stored variant to its API shape, options in the product's axis order
+func variantModel(v *db.ProductVariant, axes []db.ProductOption) *models.ProductVariant {
+	id := v.ID
+	productID := int64(v.ProductID)
+	sku := v.SKU
+	price := float32(v.Price)
+	stock := int64(v.Stock)
+
+	names := make([]string, 0, len(v.Options))
+	known := make(map[string]bool, len(axes))
+	for _, axis := range axes {
+		known[axis.Name] = true
+		if _, ok := v.Options[axis.Name]; ok {
+			names = append(names, axis.Name)
+		}
+	}
+	var extra []string
+	for name := range v.Options {
+		if !known[name] {
+			extra = append(extra, name)
+		}
+	}
+	sort.Strings(extra)
+	names = append(names, extra...)
+
+	options := make([]*models.VariantOption, 0, len(names))
+	for _, name := range names {
+		value := v.Options[name]
+		options = append(options, &models.VariantOption{Name: &name, Value: &value})
+	}
+
+	out := &models.ProductVariant{
+		ID:        &id,
+		ProductID: &productID,
+		Sku:       &sku,
+		Options:   options,
+		Price:     &price,
+		Weight:    float32(v.WeightGrams),
+		Stock:     &stock,
+	}
+	if v.Barcode != nil {
+		out.Barcode = *v.Barcode
+	}
+	return out
+}
+
+// variantWriteError maps store errors on variant writes to service errors
+func variantWriteError(err error, fallback string) error {
+	switch {
+	case errors.Is(err, db.ErrSKUTaken):
+		return ErrSKUTaken
+	case errors.Is(err, db.ErrBarcodeTaken):
+		return ErrBarcodeTaken
+	case errors.Is(err, db.ErrVariantExists):
+		return ErrVariantExists
+	case errors.Is(err, db.ErrStaleProduct):
+		return ErrStaleProduct
+	}
+	return errors.New(fallback)
+}
@@ -0,0 +1,328 @@
+package users
+
+import (
+	auth "Adornme/Auth"
+	db "Adornme/databases"
+	"Adornme/models"
+	"Adornme/utils"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jackc/pgx/v5"
+)
+
+// Admin user management errors
+var (
+	ErrAccountDisabled     = errors.New("account is disabled")
+	ErrAlreadyDisabled     = errors.New("account is already disabled")
+	ErrNotDisabled         = errors.New("account is not disabled")
+	ErrInvalidRole         = errors.New("invalid role")
+	ErrContactInUse        = errors.New("email or phone already in use")
+	ErrInvalidUserUpdate   = errors.New("invalid user update")
+	ErrSelfManagement      = errors.New("admins cannot disable, delete or demote their own account")
+	ErrInvalidUserListPage = errors.New("invalid page or limit")
+)
+
+// Security event types (admin)
+const (
+	EventAccountDisabled = "account_disabled"
+	EventAccountEnabled  = "account_enabled"
+	EventAccountDeleted  = "account_deleted"
+	EventRolesChanged    = "roles_changed"
+)
+
+const maxUserListLimit = 100
+
+// ListUsers returns one page of users matching filter; page is 1-based
+func (u *User) ListUsers(ctx context.Context, filter db.UserFilter, page, limit int64) (*models.UserListResponse, error) {
+	logs.Infof(ctx, "ListUsers called with requestID: %s, page: %d, limit: %d", u.RequestID, page, limit)
+
+	if page < 1 || limit < 1 || limit > maxUserListLimit {
+		return nil, ErrInvalidUserListPage
+	}
+	if filter.Role != "" && !auth.IsValidRole(filter.Role) {
+		return nil, ErrInvalidRole
+	}
+	filter.Limit = int(limit)
+	filter.Offset = int((page - 1) * limit)
+
+	users, total, err := u.DB.ListUsers(ctx, filter)
+	if err != nil {
+		return nil, errors.New("failed to list users")
+	}
+
+	items := make([]*models.User, 0, len(users))
+	for _, dbUser := range users {
+		items = append(items, adminUserModel(dbUser, dbUser.Roles))
+	}
+	return &models.UserListResponse{
+		Items: items,
+		Total: total,
+		Page:  page,
+		Limit: limit,
+	}, nil
+}
+
+// AdminGetUser returns a user with roles and account state
+func (u *User) AdminGetUser(ctx context.Context, userID string) (*models.User, error) {
+	dbUser, err := u.loadActiveUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return adminUserModel(dbUser, u.userRoles(ctx, userID)), nil
+}
+
+// AdminUpdateUser changes profile fields and, when given, replaces the roles.
+// Changed roles take effect at the next refresh: live access tokens are revoked.
+func (u *User) AdminUpdateUser(ctx context.Context, actorID string, userID string, req *models.AdminUserUpdateRequest) (*models.User, error) {
+	logs.Infof(ctx, "AdminUpdateUser called | actor_id=%s user_id=%s", actorID, userID)
+
+	dbUser, err := u.loadActiveUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🔹 1. Profile fields (omitted ones stay as they are)
+	updated := *dbUser
+	if name := strings.TrimSpace(req.Name); name != "" {
+		updated.Name = name
+	}
+	if req.Email != "" {
+		updated.Email = utils.NormalizeEmail(req.Email.String())
+		if updated.Email != dbUser.Email {
+			if other, err := u.DB.GetUserByEmail(ctx, updated.Email); err == nil && other.ID != dbUser.ID {
+				return nil, ErrContactInUse
+			}
+		}
+	}
+	if req.Phone != "" {
+		phone, err := normalizePhone(req.Phone)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidUserUpdate, err)
+		}
+		updated.Phone = phone
+		if updated.Phone != dbUser.Phone {
+			if other, err := u.DB.GetUserByPhone(ctx, updated.Phone); err == nil && other.ID != dbUser.ID {
+				return nil, ErrContactInUse
+			}
+		}
+	}
+	if updated.Name != dbUser.Name || updated.Email != dbUser.Email || updated.Phone != dbUser.Phone {
+		if err := u.DB.UpdateUserProfile(ctx, &updated); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, ErrUserNotFound
+			}
+			if errors.Is(err, db.ErrContactTaken) {
+				return nil, ErrContactInUse
+			}
+			return nil, errors.New("failed to update user")
+		}
+	}
+
+	// 🔹 2. Roles
+	roles := u.userRoles(ctx, userID)
+	if req.Roles != nil {
+		newRoles, err := normalizeRoles(req.Roles)
+		if err != nil {
+			return nil, err
+		}
+		if actorID == userID && auth.HasRole(roles, auth.RoleAdmin) && !auth.HasRole(newRoles, auth.RoleAdmin) {
+			return nil, ErrSelfManagement
+		}
+		if !sameRoles(roles, newRoles) {
+			if err := u.DB.SetUserRoles(ctx, dbUser.ID, newRoles); err != nil {
+				logs.Errorf(ctx, "FAILED TO SET ROLES: user=%d, err=%v", dbUser.ID, err)
+				return nil, errors.New("failed to update roles")
+			}
+			// Sessions carry the sign-in strength of the old roles (a customer
+			// session has no second factor), so the user signs in again.
+			if err := u.DB.RevokeAllSessions(ctx, userID, ""); err != nil {
+				logs.Errorf(ctx, "FAILED TO REVOKE SESSIONS AFTER ROLE CHANGE: user=%s, err=%v", userID, err)
+			}
+			revokeAccessTokens(ctx, userID, "")
+			u.recordAdminEvent(ctx, actorID, dbUser.ID, EventRolesChanged, map[string]any{"from": roles, "to": newRoles})
+			roles = newRoles
+		}
+	}
+
+	logs.Infof(ctx, "user updated by admin | actor_id=%s user_id=%s", actorID, userID)
+	return u.adminReload(ctx, userID, roles)
+}
+
+// DisableUser blocks an account from signing in and ends every session and access token
+func (u *User) DisableUser(ctx context.Context, actorID string, userID string) error {
+	if actorID == userID {
+		return ErrSelfManagement
+	}
+	dbUser, err := u.loadActiveUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.DB.SetUserDisabled(ctx, dbUser.ID, true); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAlreadyDisabled
+		}
+		return errors.New("failed to disable account")
+	}
+	if err := u.DB.RevokeAllSessions(ctx, userID, ""); err != nil {
+		logs.Errorf(ctx, "FAILED TO REVOKE SESSIONS OF DISABLED USER: user=%s, err=%v", userID, err)
+	}
+	revokeAccessTokens(ctx, userID, "")
+
+	u.recordAdminEvent(ctx, actorID, dbUser.ID, EventAccountDisabled, nil)
+	logs.Warning(ctx, "account disabled by admin", "user_id", userID, "actor_id", actorID)
+	return nil
+}
+
+// EnableUser lifts an admin disable
+func (u *User) EnableUser(ctx context.Context, actorID string, userID string) error {
+	dbUser, err := u.loadActiveUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.DB.SetUserDisabled(ctx, dbUser.ID, false); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotDisabled
+		}
+		return errors.New("failed to enable account")
+	}
+
+	u.recordAdminEvent(ctx, actorID, dbUser.ID, EventAccountEnabled, nil)
+	logs.Info(ctx, "account enabled by admin", "user_id", userID, "actor_id", actorID)
+	return nil
+}
+
+// AdminDeleteUser anonymises an account: personal data is erased but the row
+// stays, so orders placed by the user keep pointing at a valid ID.
+func (u *User) AdminDeleteUser(ctx context.Context, actorID string, userID string) error {
+	if actorID == userID {
+		return ErrSelfManagement
+	}
+	dbUser, err := u.loadActiveUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.DB.AnonymizeUser(ctx, dbUser.ID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		return errors.New("failed to delete user")
+	}
+	revokeAccessTokens(ctx, userID, "")
+	if dbUser.Email != "" {
+		u.clearLoginFailures(ctx, dbUser.Email)
+	}
+
+	u.recordAdminEvent(ctx, actorID, dbUser.ID, EventAccountDeleted, nil)
+	logs.Warning(ctx, "account anonymised by admin", "user_id", userID, "actor_id", actorID)
+	return nil
+}
+
+// checkAccountActive refuses disabled and anonymised accounts
+func checkAccountActive(dbUser *db.User) error {
+	if dbUser.DeletedAt != nil {
+		return ErrUserNotFound
+	}
+	if dbUser.DisabledAt != nil {
+		return ErrAccountDisabled
+	}
+	return nil
+}
+
+// loadActiveUser loads a user, treating anonymised accounts as missing
+func (u *User) loadActiveUser(ctx context.Context, userID string) (*db.User, error) {
+	dbUser, err := u.loadUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if dbUser.DeletedAt != nil {
+		return nil, ErrUserNotFound
+	}
+	return dbUser, nil
+}
+
+func (u *User) adminReload(ctx context.Context, userID string, roles []string) (*models.User, error) {
+	dbUser, err := u.loadUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return adminUserModel(dbUser, roles), nil
+}
+
+func (u *User) recordAdminEvent(ctx context.Context, actorID string, userID int64, eventType string, details map[string]any) {
+	if details == nil {
+		details = map[string]any{}
+	}
+	details["actor_id"] = actorID
+	details["request_id"] = u.RequestID
+
+	userAgent, ip := utils.ClientInfoFromContext(ctx)
+	_ = u.DB.RecordSecurityEvent(ctx, &db.SecurityEvent{
+		UserID:    userID,
+		EventType: eventType,
+		IPAddress: ip,
+		UserAgent: userAgent,
+		Details:   details,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+// normalizeRoles validates and de-duplicates roles; an empty set means customer
+func normalizeRoles(roles []string) ([]string, error) {
+	out := []string{}
+	for _, r := range roles {
+		r = strings.TrimSpace(r)
+		if !auth.IsValidRole(r) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidRole, r)
+		}
+		if !auth.HasRole(out, r) {
+			out = append(out, r)
+		}
+	}
+	if len(out) == 0 {
+		out = append(out, auth.RoleCustomer)
+	}
+	return out, nil
+}
+
+func sameRoles(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, r := range a {
+		if !auth.HasRole(b, r) {
+			return false
+		}
+	}
+	return true
+}
+
+func adminUserModel(dbUser *db.User, roles []string) *models.User {
+	id, name := dbUser.ID, dbUser.Name
+	out := &models.User{
+		ID:            &id,
+		Name:          &name,
+		Phone:         dbUser.Phone,
+		PhoneCountry:  phoneCountry(dbUser.Phone),
+		EmailVerified: dbUser.EmailVerified,
+		PhoneVerified: dbUser.PhoneVerified,
+		CreatedAt:     strfmt.DateTime(dbUser.CreatedAt),
+		Roles:         roles,
+		Disabled:      dbUser.DisabledAt != nil,
+	}
+	if !dbUser.UpdatedAt.IsZero() {
+		out.UpdatedAt = strfmt.DateTime(dbUser.UpdatedAt)
+	}
+	if dbUser.Email != "" {
+		e := strfmt.Email(dbUser.Email)
+		out.Email = &e
+	}
+	return out
+}
@@ -0,0 +1,181 @@
+package users
+
+import (
+	auth "Adornme/Auth"
+	db "Adornme/databases"
+	"Adornme/models"
+	"Adornme/utils"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jackc/pgx/v5"
+)
+
+// API key errors
+var (
+	ErrAPIKeyNotFound       = errors.New("api key not found")
+	ErrInvalidAPIKey        = errors.New("invalid api key")
+	ErrInvalidAPIKeyRequest = errors.New("invalid api key request")
+)
+
+// Security event types (api keys)
+const (
+	EventAPIKeyCreated = "api_key_created"
+	EventAPIKeyRevoked = "api_key_revoked"
+)
+
+// CreateAPIKey issues a key; the secret is returned once and only its hash is kept
+func (u *User) CreateAPIKey(ctx context.Context, createdBy string, req *models.APIKeyCreateRequest) (*models.APIKeyCreated, error) {
+	// 🔹 1. Validate
+	name := strings.TrimSpace(*req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidAPIKeyRequest)
+	}
+	if len(req.Scopes) == 0 {
+		return nil, fmt.Errorf("%w: at least one scope is required", ErrInvalidAPIKeyRequest)
+	}
+	for _, s := range req.Scopes {
+		if !auth.IsValidScope(s) {
+			return nil, fmt.Errorf("%w: unknown scope %q", ErrInvalidAPIKeyRequest, s)
+		}
+	}
+	if req.ExpiresInDays < 0 {
+		return nil, fmt.Errorf("%w: expiresInDays must be positive", ErrInvalidAPIKeyRequest)
+	}
+
+	// 🔹 2. Generate + store
+	key, prefix, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, errors.New("failed to generate api key")
+	}
+
+	k := &db.APIKey{Name: name, Prefix: prefix, KeyHash: hash, Scopes: req.Scopes}
+	if id, err := strconv.ParseInt(createdBy, 10, 64); err == nil {
+		k.CreatedBy = &id
+	}
+	if req.ExpiresInDays > 0 {
+		exp := time.Now().UTC().Add(time.Duration(req.ExpiresInDays) * 24 * time.Hour)
+		k.ExpiresAt = &exp
+	}
+	if err := u.DB.CreateAPIKey(ctx, k); err != nil {
+		return nil, errors.New("failed to create api key")
+	}
+
+	// 🔹 3. Audit
+	u.recordAPIKeyEvent(ctx, createdBy, k, EventAPIKeyCreated)
+	logs.Info(ctx, "api key created", "prefix", prefix, "scopes", strings.Join(req.Scopes, ","), "created_by", createdBy)
+
+	return &models.APIKeyCreated{Key: &key, APIKey: apiKeyModel(k)}, nil
+}
+
+// ListAPIKeys returns every key, revoked ones included, newest first
+func (u *User) ListAPIKeys(ctx context.Context) ([]*models.APIKey, error) {
+	keys, err := u.DB.ListAPIKeys(ctx)
+	if err != nil {
+		logs.Errorf(ctx, "LIST API KEYS FAILED: %v", err)
+		return nil, errors.New("failed to list api keys")
+	}
+
+	out := make([]*models.APIKey, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, apiKeyModel(k))
+	}
+	return out, nil
+}
+
+// RevokeAPIKey stops a key from authenticating, effective immediately
+func (u *User) RevokeAPIKey(ctx context.Context, revokedBy string, id int64) error {
+	if err := u.DB.RevokeAPIKey(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAPIKeyNotFound
+		}
+		logs.Errorf(ctx, "REVOKE API KEY FAILED: id=%d, err=%v", id, err)
+		return errors.New("failed to revoke api key")
+	}
+
+	u.recordAPIKeyEvent(ctx, revokedBy, &db.APIKey{ID: id}, EventAPIKeyRevoked)
+	logs.Info(ctx, "api key revoked", "id", id, "revoked_by", revokedBy)
+	return nil
+}
+
+// AuthenticateAPIKey resolves an X-API-Key header to a principal. Its UserAccess
+// carries auth.APIKeyBaseRole and the key's scopes in place of roles.
+func (u *User) AuthenticateAPIKey(ctx context.Context, key string) (*models.Principal, error) {
+	prefix, ok := auth.SplitAPIKey(key)
+	if !ok {
+		return nil, ErrInvalidAPIKey
+	}
+
+	k, err := u.DB.GetAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, ErrInvalidAPIKey
+	}
+	if subtle.ConstantTimeCompare([]byte(k.KeyHash), []byte(auth.HashToken(strings.TrimSpace(key)))) != 1 {
+		logs.Warning(ctx, "api key secret mismatch", "prefix", prefix)
+		return nil, ErrInvalidAPIKey
+	}
+	if k.RevokedAt != nil || (k.ExpiresAt != nil && time.Now().After(*k.ExpiresAt)) {
+		logs.Info(ctx, "revoked or expired api key used", "prefix", prefix)
+		return nil, ErrInvalidAPIKey
+	}
+
+	if err := u.DB.TouchAPIKey(ctx, k.ID); err != nil {
+		logs.Errorf(ctx, "failed to record api key use: %v", err)
+	}
+
+	principalID := fmt.Sprintf("apikey:%d", k.ID)
+	return &models.Principal{
+		UserID:   principalID,
+		AuthID:   k.Prefix,
+		AuthName: k.Name,
+		UserAccess: &models.UserAccess{
+			UserID:   principalID,
+			BaseRole: auth.APIKeyBaseRole,
+			MemberOf: k.Scopes,
+		},
+	}, nil
+}
+
+// recordAPIKeyEvent audits a key change under the acting admin
+func (u *User) recordAPIKeyEvent(ctx context.Context, actorID string, k *db.APIKey, eventType string) {
+	actor, _ := strconv.ParseInt(actorID, 10, 64)
+	userAgent, ip := utils.ClientInfoFromContext(ctx)
+	_ = u.DB.RecordSecurityEvent(ctx, &db.SecurityEvent{
+		UserID:    actor,
+		EventType: eventType,
+		IPAddress: ip,
+		UserAgent: userAgent,
+		Details:   map[string]any{"api_key_id": k.ID, "prefix": k.Prefix, "request_id": u.RequestID},
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func apiKeyModel(k *db.APIKey) *models.APIKey {
+	id, name, prefix := k.ID, k.Name, k.Prefix
+	out := &models.APIKey{
+		ID:        &id,
+		Name:      &name,
+		Prefix:    &prefix,
+		Scopes:    k.Scopes,
+		CreatedAt: strfmt.DateTime(k.CreatedAt),
+	}
+	if k.CreatedBy != nil {
+		out.CreatedBy = *k.CreatedBy
+	}
+	if k.ExpiresAt != nil {
+		out.ExpiresAt = strfmt.DateTime(*k.ExpiresAt)
+	}
+	if k.LastUsedAt != nil {
+		out.LastUsedAt = strfmt.DateTime(*k.LastUsedAt)
+	}
+	if k.RevokedAt != nil {
+		out.RevokedAt = strfmt.DateTime(*k.RevokedAt)
+	}
+	return out
+}
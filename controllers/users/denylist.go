@@ -0,0 +1,69 @@
+package users
+
+import (
+	auth "Adornme/Auth"
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrDenylistUnavailable is returned when revoked tokens cannot be looked up
+var ErrDenylistUnavailable = errors.New("token denylist unavailable")
+
+// checkDenylistStore refuses to start without Redis: nothing could be revoked,
+// so logged-out, disabled and erased accounts would keep working until their
+// tokens expire
+func checkDenylistStore() {
+	if cfg.TokenDenylistRequired && redisStore() == nil {
+		logs.Fatalf(context.Background(), "Redis is required for the access token denylist; enable it or set TOKEN_DENYLIST_REQUIRED=false")
+	}
+}
+
+// trackAccessToken records a freshly issued access token so logout, session
+// revoke and password reset can denylist it before it expires.
+func trackAccessToken(ctx context.Context, userID, sessionID, jti string) {
+	rp := redisStore()
+	if rp == nil {
+		return
+	}
+	expiresAt := time.Now().Add(auth.AccessTokenTTL())
+	if err := rp.TrackAccessToken(ctx, userID, sessionID, jti, expiresAt); err != nil {
+		logs.Errorf(ctx, "failed to track access token: user_id=%s, err=%v", userID, err)
+	}
+}
+
+// revokeAccessTokens denylists the live access tokens of a session, or of every
+// session of the user when sessionID is empty.
+func revokeAccessTokens(ctx context.Context, userID, sessionID string) {
+	rp := redisStore()
+	if rp == nil {
+		return
+	}
+	n, err := rp.DenyAccessTokens(ctx, userID, sessionID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to denylist access tokens: user_id=%s, session_id=%s, err=%v", userID, sessionID, err)
+		return
+	}
+	logs.Infof(ctx, "access tokens denylisted | user_id=%s session_id=%s count=%d", userID, sessionID, n)
+}
+
+// IsAccessTokenRevoked reports whether the access token with jti was denylisted.
+// Tokens issued before JTIs existed carry none and pass until they expire.
+// A failed lookup returns ErrDenylistUnavailable, and callers must refuse the
+// token: a Redis outage must not bring revoked tokens back.
+func IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	if jti == "" {
+		return false, nil
+	}
+	rp := redisStore()
+	if rp == nil {
+		// only reachable with TOKEN_DENYLIST_REQUIRED=false, see init
+		return false, nil
+	}
+	denied, err := rp.IsAccessTokenDenied(ctx, jti)
+	if err != nil {
+		logs.Errorf(ctx, "denylist lookup failed: %v", err)
+		return false, ErrDenylistUnavailable
+	}
+	return denied, nil
+}
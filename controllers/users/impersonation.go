@@ -0,0 +1,151 @@
+package users
+
+import (
+	auth "Adornme/Auth"
+	db "Adornme/databases"
+	dbmodels "Adornme/databases/models"
+	"Adornme/models"
+	"Adornme/utils"
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// Impersonation errors
+var (
+	ErrCannotImpersonate   = errors.New("this account cannot be impersonated")
+	ErrImpersonationReason = errors.New("a reason is required to impersonate a user")
+	ErrAuditUnavailable    = errors.New("audit log unavailable, impersonation refused")
+)
+
+// Audit actions written to the event log
+const (
+	ActionImpersonationStarted = "impersonation_started"
+	ActionImpersonatedRequest  = "impersonated_request"
+)
+
+const auditServiceName = "users"
+
+func mongoStore() *db.MongoProvider {
+	mp, ok := db.Do["mongo"].(*db.MongoProvider)
+	if !ok || mp == nil || mp.Client == nil {
+		return nil
+	}
+	return mp
+}
+
+func impersonationTTL() time.Duration {
+	return time.Duration(cfg.ImpersonationTTLMinutes) * time.Minute
+}
+
+// ImpersonateUser issues a short-lived, read-only access token for a customer,
+// carrying an act claim that names the support agent. Staff accounts and the
+// agent's own account are refused, and no token is issued unless its audit
+// entry was written.
+func (u *User) ImpersonateUser(ctx context.Context, agentID string, targetID string, reason string) (*models.ImpersonationResponse, error) {
+	logs.Infof(ctx, "ImpersonateUser called | agent_id=%s target_id=%s", agentID, targetID)
+
+	if reason == "" {
+		return nil, ErrImpersonationReason
+	}
+	if agentID == targetID {
+		return nil, ErrCannotImpersonate
+	}
+
+	// 🔹 1. Load target; staff must never be impersonated
+	dbUser, err := u.loadActiveUser(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	roles := u.userRoles(ctx, targetID)
+	if auth.RequiresMFA(roles) {
+		logs.Warningf(ctx, "impersonation refused for staff account | agent_id=%s target_id=%s", agentID, targetID)
+		return nil, ErrCannotImpersonate
+	}
+
+	// 🔹 2. Issue token (no session, so it cannot be refreshed)
+	ttl := impersonationTTL()
+	token, jti, err := auth.GenerateImpersonationToken(targetID, agentID, roles, ttl)
+	if err != nil {
+		return nil, errors.New("failed to generate impersonation token")
+	}
+
+	// 🔹 3. Audit; the token is only handed out once the entry is stored
+	err = auditEvent(ctx, dbUser.ID, ActionImpersonationStarted, map[string]any{
+		"agent_id":   agentID,
+		"reason":     reason,
+		"jti":        jti,
+		"expires_at": time.Now().Add(ttl).UTC(),
+		"request_id": u.RequestID,
+	})
+	if err != nil {
+		logs.Errorf(ctx, "impersonation refused, audit entry not stored | agent_id=%s target_id=%s err=%v", agentID, targetID, err)
+		return nil, ErrAuditUnavailable
+	}
+	trackAccessToken(ctx, targetID, "", jti)
+
+	var email *strfmt.Email
+	if dbUser.Email != "" {
+		e := strfmt.Email(dbUser.Email)
+		email = &e
+	}
+
+	expiresIn := int64(ttl.Seconds())
+
+	logs.Infof(ctx, "impersonation started | agent_id=%s target_id=%s ttl=%s", agentID, targetID, ttl)
+	return &models.ImpersonationResponse{
+		Token:     &token,
+		ExpiresIn: &expiresIn,
+		User: &models.User{
+			ID:            &dbUser.ID,
+			Name:          &dbUser.Name,
+			Email:         email,
+			Phone:         dbUser.Phone,
+			PhoneCountry:  phoneCountry(dbUser.Phone),
+			EmailVerified: dbUser.EmailVerified,
+			PhoneVerified: dbUser.PhoneVerified,
+		},
+	}, nil
+}
+
+// RecordImpersonatedRequest audits a request made with an impersonation token,
+// whether it was allowed or blocked.
+func RecordImpersonatedRequest(ctx context.Context, p *models.Principal, method, path, operationID string, allowed bool) {
+	uid, _ := strconv.ParseInt(p.UserID, 10, 64)
+	_ = auditEvent(ctx, uid, ActionImpersonatedRequest, map[string]any{
+		"agent_id":  p.ImpersonatorID,
+		"method":    method,
+		"path":      path,
+		"operation": operationID,
+		"allowed":   allowed,
+	})
+}
+
+// auditEvent writes to the Mongo event log; without Mongo the entry only reaches
+// the logs and ErrAuditUnavailable is returned
+func auditEvent(ctx context.Context, entityID int64, action string, payload map[string]any) error {
+	userAgent, ip := utils.ClientInfoFromContext(ctx)
+	payload["ip_address"] = ip
+	payload["user_agent"] = userAgent
+
+	mp := mongoStore()
+	if mp == nil {
+		logs.Warningf(ctx, "event log unavailable, audit entry not stored | action=%s entity_id=%d payload=%v", action, entityID, payload)
+		return ErrAuditUnavailable
+	}
+	err := mp.InsertEventLog(ctx, &dbmodels.EventLog{
+		ServiceName: auditServiceName,
+		EntityID:    entityID,
+		Action:      action,
+		Payload:     payload,
+		CreatedAt:   time.Now().UTC(),
+	})
+	if err != nil {
+		logs.Errorf(ctx, "FAILED TO WRITE EVENT LOG: action=%s entity_id=%d err=%v", action, entityID, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// Impersonation tokens are only issued once their audit entry is stored, so a
+// missing event log (no db.Connect in tests) must be reported, not skipped.
+func TestAuditEventWithoutEventLog(t *testing.T) {
+	err := auditEvent(context.Background(), 7, ActionImpersonationStarted, map[string]any{"agent_id": "1"})
+	if !errors.Is(err, ErrAuditUnavailable) {
+		t.Fatalf("auditEvent() error = %v, want %v", err, ErrAuditUnavailable)
+	}
+}
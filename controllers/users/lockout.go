@@ -0,0 +1,225 @@
+package users
+
+import (
+	db "Adornme/databases"
+	"Adornme/utils"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ErrTooManyAttempts matches every *ThrottleError
+var ErrTooManyAttempts = errors.New("too many attempts")
+
+// ThrottleError is returned while an account or client IP is locked out
+type ThrottleError struct {
+	RetryAfter time.Duration
+}
+
+func (e *ThrottleError) Error() string {
+	return fmt.Sprintf("too many attempts, try again in %ds", int(math.Ceil(e.RetryAfter.Seconds())))
+}
+
+func (e *ThrottleError) Is(target error) bool { return target == ErrTooManyAttempts }
+
+// Security event types (lockout)
+const (
+	EventAccountLocked   = "account_locked"
+	EventAccountUnlocked = "account_unlocked"
+)
+
+const maxBackoff = 5 * time.Minute
+
+func accountKey(email string) string { return "acct:" + utils.NormalizeEmail(email) }
+func ipKey(ip string) string         { return "ip:" + ip }
+
+// redisStore returns the Redis provider, or nil when Redis is disabled.
+// Throttling and the token denylist fail open without Redis; the rest of auth keeps working.
+func redisStore() *db.RedisProvider {
+	rp, ok := db.Do["redis"].(*db.RedisProvider)
+	if !ok || rp == nil || rp.Client == nil {
+		return nil
+	}
+	return rp
+}
+
+// lockoutStore keeps failure counters and locks; *db.RedisProvider in production
+type lockoutStore interface {
+	IncrFailures(ctx context.Context, key string, window time.Duration) (int64, error)
+	IncrHits(ctx context.Context, key string, window time.Duration) (int64, error)
+	Lock(ctx context.Context, key string, ttl time.Duration) error
+	LockRemaining(ctx context.Context, key string) (time.Duration, error)
+	ClearFailures(ctx context.Context, key string) error
+}
+
+// lockouts returns the lockout store, or nil when Redis is disabled
+var lockouts = func() lockoutStore {
+	if rp := redisStore(); rp != nil {
+		return rp
+	}
+	return nil
+}
+
+func lockoutDuration() time.Duration {
+	return time.Duration(cfg.LoginLockoutMinutes) * time.Minute
+}
+
+// backoffFor returns how long to hold off after the n-th consecutive failure:
+// nothing below LoginBackoffAfter, then 1s doubling per failure, capped at maxBackoff.
+func backoffFor(n int64) time.Duration {
+	over := n - int64(cfg.LoginBackoffAfter)
+	if over < 0 {
+		return 0
+	}
+	if over > 16 {
+		return maxBackoff
+	}
+	return min(time.Second<<over, maxBackoff)
+}
+
+// checkLocked fails with a *ThrottleError while any of keys is locked
+func checkLocked(ctx context.Context, rp lockoutStore, keys ...string) error {
+	var wait time.Duration
+	for _, key := range keys {
+		remaining, err := rp.LockRemaining(ctx, key)
+		if err != nil {
+			logs.Errorf(ctx, "lockout lookup failed: %v", err)
+			continue
+		}
+		wait = max(wait, remaining)
+	}
+	if wait > 0 {
+		return &ThrottleError{RetryAfter: wait}
+	}
+	return nil
+}
+
+// checkLoginAllowed rejects a login for email before the password is looked at
+func (u *User) checkLoginAllowed(ctx context.Context, email string) error {
+	rp := lockouts()
+	if rp == nil {
+		return nil
+	}
+	_, ip := utils.ClientInfoFromContext(ctx)
+	if err := checkLocked(ctx, rp, accountKey(email), ipKey(ip)); err != nil {
+		logs.Info(ctx, "login blocked by lockout", "ip", ip, "retry_after", err.(*ThrottleError).RetryAfter.String())
+		return err
+	}
+	return nil
+}
+
+// recordLoginFailure counts a failed login against the account and the client IP,
+// applying backoff and locking either one once it crosses its limit.
+// dbUser is nil for unknown emails, which are counted the same way.
+func (u *User) recordLoginFailure(ctx context.Context, email string, dbUser *db.User) {
+	rp := lockouts()
+	if rp == nil {
+		return
+	}
+	userAgent, ip := utils.ClientInfoFromContext(ctx)
+	window := lockoutDuration()
+
+	// 🔹 Per account: exponential backoff, then a full lockout
+	if n, err := rp.IncrFailures(ctx, accountKey(email), window); err != nil {
+		logs.Errorf(ctx, "failed to record login failure: %v", err)
+	} else if n >= int64(cfg.LoginMaxFailures) {
+		_ = rp.Lock(ctx, accountKey(email), window)
+		logs.Warning(ctx, "account locked after failed logins", "failures", n, "ip", ip, "lockout", window.String())
+		if dbUser != nil {
+			_ = u.DB.RecordSecurityEvent(ctx, &db.SecurityEvent{
+				UserID:    dbUser.ID,
+				EventType: EventAccountLocked,
+				IPAddress: ip,
+				UserAgent: userAgent,
+				Details:   map[string]any{"failures": n, "lockout_seconds": int(window.Seconds()), "request_id": u.RequestID},
+				CreatedAt: time.Now().UTC(),
+			})
+		}
+	} else if delay := backoffFor(n); delay > 0 {
+		_ = rp.Lock(ctx, accountKey(email), delay)
+		logs.Info(ctx, "login backoff applied", "failures", n, "delay", delay.String())
+	}
+
+	// 🔹 Per IP: no backoff (shared NATs), only a lockout once the limit is hit
+	if ip == "" {
+		return
+	}
+	if n, err := rp.IncrFailures(ctx, ipKey(ip), window); err != nil {
+		logs.Errorf(ctx, "failed to record login failure for ip: %v", err)
+	} else if n >= int64(cfg.LoginIPMaxFailures) {
+		_ = rp.Lock(ctx, ipKey(ip), window)
+		logs.Warning(ctx, "client ip locked after failed logins", "ip", ip, "failures", n, "lockout", window.String())
+	}
+}
+
+// clearLoginFailures resets the account counters after a successful login.
+// The IP counter is left alone so one valid account can't launder a spraying client.
+func (u *User) clearLoginFailures(ctx context.Context, email string) {
+	rp := lockouts()
+	if rp == nil {
+		return
+	}
+	if err := rp.ClearFailures(ctx, accountKey(email)); err != nil {
+		logs.Errorf(ctx, "failed to clear login failures: %v", err)
+	}
+}
+
+// throttleClient limits unauthenticated lookups (identify, OTP send) per client IP
+func (u *User) throttleClient(ctx context.Context, op string) error {
+	rp := lockouts()
+	if rp == nil {
+		return nil
+	}
+	_, ip := utils.ClientInfoFromContext(ctx)
+	if ip == "" {
+		return nil
+	}
+	if err := checkLocked(ctx, rp, ipKey(ip)); err != nil {
+		return err
+	}
+	n, err := rp.IncrHits(ctx, op+":"+ipKey(ip), time.Minute)
+	if err != nil {
+		logs.Errorf(ctx, "rate limit lookup failed: %v", err)
+		return nil
+	}
+	if n > int64(cfg.AuthIPRatePerMinute) {
+		logs.Warning(ctx, "client rate limited", "op", op, "ip", ip, "hits", n)
+		return &ThrottleError{RetryAfter: time.Minute}
+	}
+	return nil
+}
+
+// UnlockAccount clears the failure counters and lockout of a user (admin action)
+func (u *User) UnlockAccount(ctx context.Context, userID string) error {
+	dbUser, err := u.loadUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if dbUser.Email == "" {
+		return nil // phone-only accounts never log in with a password
+	}
+
+	rp := lockouts()
+	if rp == nil {
+		return errors.New("lockout store unavailable")
+	}
+	if err := rp.ClearFailures(ctx, accountKey(dbUser.Email)); err != nil {
+		logs.Errorf(ctx, "UNLOCK FAILED: user=%d, err=%v", dbUser.ID, err)
+		return errors.New("failed to unlock account")
+	}
+
+	userAgent, ip := utils.ClientInfoFromContext(ctx)
+	_ = u.DB.RecordSecurityEvent(ctx, &db.SecurityEvent{
+		UserID:    dbUser.ID,
+		EventType: EventAccountUnlocked,
+		IPAddress: ip,
+		UserAgent: userAgent,
+		Details:   map[string]any{"request_id": u.RequestID},
+		CreatedAt: time.Now().UTC(),
+	})
+
+	logs.Info(ctx, "account unlocked by admin", "user_id", dbUser.ID)
+	return nil
+}
@@ -0,0 +1,162 @@
+package users
+
+import (
+	"Adornme/utils"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// memLockouts is an in-memory lockoutStore; locks never expire on their own
+type memLockouts struct {
+	failures map[string]int64
+	hits     map[string]int64
+	locks    map[string]time.Duration
+}
+
+func (m *memLockouts) IncrFailures(ctx context.Context, key string, window time.Duration) (int64, error) {
+	m.failures[key]++
+	return m.failures[key], nil
+}
+
+func (m *memLockouts) IncrHits(ctx context.Context, key string, window time.Duration) (int64, error) {
+	m.hits[key]++
+	return m.hits[key], nil
+}
+
+func (m *memLockouts) Lock(ctx context.Context, key string, ttl time.Duration) error {
+	m.locks[key] = max(m.locks[key], ttl)
+	return nil
+}
+
+func (m *memLockouts) LockRemaining(ctx context.Context, key string) (time.Duration, error) {
+	return m.locks[key], nil
+}
+
+func (m *memLockouts) ClearFailures(ctx context.Context, key string) error {
+	delete(m.failures, key)
+	delete(m.locks, key)
+	return nil
+}
+
+// withLockouts swaps in an empty memLockouts and the given limits for one test
+func withLockouts(t *testing.T, maxFailures, ipMaxFailures, backoffAfter int) *memLockouts {
+	t.Helper()
+	m := &memLockouts{failures: map[string]int64{}, hits: map[string]int64{}, locks: map[string]time.Duration{}}
+	prevStore, prevCfg := lockouts, *cfg
+	lockouts = func() lockoutStore { return m }
+	cfg.LoginMaxFailures = maxFailures
+	cfg.LoginIPMaxFailures = ipMaxFailures
+	cfg.LoginBackoffAfter = backoffAfter
+	cfg.LoginLockoutMinutes = 15
+	t.Cleanup(func() {
+		lockouts = prevStore
+		*cfg = prevCfg
+	})
+	return m
+}
+
+// retryAfter returns how long a login for email must wait, 0 if it is allowed
+func retryAfter(t *testing.T, ctx context.Context, u *User, email string) time.Duration {
+	t.Helper()
+	err := u.checkLoginAllowed(ctx, email)
+	if err == nil {
+		return 0
+	}
+	var te *ThrottleError
+	if !errors.As(err, &te) || !errors.Is(err, ErrTooManyAttempts) {
+		t.Fatalf("checkLoginAllowed() error = %v, want a *ThrottleError", err)
+	}
+	return te.RetryAfter
+}
+
+func TestBackoffFor(t *testing.T) {
+	withLockouts(t, 10, 50, 3)
+	tests := []struct {
+		n    int64
+		want time.Duration
+	}{
+		{1, 0},
+		{2, 0},
+		{3, time.Second},
+		{4, 2 * time.Second},
+		{6, 8 * time.Second},
+		{11, 256 * time.Second},
+		{12, maxBackoff},
+		{100, maxBackoff},
+	}
+	for _, tt := range tests {
+		if got := backoffFor(tt.n); got != tt.want {
+			t.Errorf("backoffFor(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestLoginLockoutThreshold(t *testing.T) {
+	withLockouts(t, 5, 50, 3)
+	u := &User{RequestID: "req-1"}
+	ctx := utils.WithClientInfo(context.Background(), "test", "203.0.113.7")
+	const email = "Shopper@Example.com"
+
+	want := []time.Duration{0, 0, time.Second, 2 * time.Second, 15 * time.Minute}
+	for i, wait := range want {
+		u.recordLoginFailure(ctx, email, nil)
+		if got := retryAfter(t, ctx, u, email); got != wait {
+			t.Fatalf("after %d failures: retry after %v, want %v", i+1, got, wait)
+		}
+	}
+
+	// The account is locked under any spelling of the address, other accounts are not
+	if got := retryAfter(t, ctx, u, " shopper@example.COM "); got != 15*time.Minute {
+		t.Errorf("other spelling of a locked email: retry after %v", got)
+	}
+	if got := retryAfter(t, ctx, u, "someone@example.com"); got != 0 {
+		t.Errorf("other account from the same ip: retry after %v", got)
+	}
+
+	u.clearLoginFailures(ctx, email)
+	if got := retryAfter(t, ctx, u, email); got != 0 {
+		t.Errorf("after clearing: retry after %v", got)
+	}
+	u.recordLoginFailure(ctx, email, nil)
+	if got := retryAfter(t, ctx, u, email); got != 0 {
+		t.Errorf("first failure after clearing: retry after %v", got)
+	}
+}
+
+func TestLoginLockoutPerIP(t *testing.T) {
+	m := withLockouts(t, 10, 3, 5)
+	u := &User{RequestID: "req-1"}
+	ctx := utils.WithClientInfo(context.Background(), "test", "203.0.113.7")
+	other := utils.WithClientInfo(context.Background(), "test", "198.51.100.1")
+
+	// Spraying one password across accounts trips the ip limit, not the account ones
+	for _, email := range []string{"a@example.com", "b@example.com", "c@example.com"} {
+		u.recordLoginFailure(ctx, email, nil)
+	}
+	if got := retryAfter(t, ctx, u, "d@example.com"); got != 15*time.Minute {
+		t.Fatalf("sprayed ip: retry after %v, want 15m", got)
+	}
+	if got := retryAfter(t, other, u, "a@example.com"); got != 0 {
+		t.Fatalf("account of a sprayed ip from elsewhere: retry after %v", got)
+	}
+
+	// A successful login does not reset the ip counter
+	u.clearLoginFailures(ctx, "a@example.com")
+	if m.failures[ipKey("203.0.113.7")] != 3 {
+		t.Fatalf("ip counter = %d after a successful login, want 3", m.failures[ipKey("203.0.113.7")])
+	}
+}
+
+func TestLockoutsDisabledFailOpen(t *testing.T) {
+	withLockouts(t, 1, 1, 0)
+	lockouts = func() lockoutStore { return nil }
+	u := &User{RequestID: "req-1"}
+	ctx := utils.WithClientInfo(context.Background(), "test", "203.0.113.7")
+
+	u.recordLoginFailure(ctx, "a@example.com", nil)
+	if err := u.checkLoginAllowed(ctx, "a@example.com"); err != nil {
+		t.Fatalf("checkLoginAllowed() without a store = %v", err)
+	}
+}
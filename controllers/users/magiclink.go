@@ -0,0 +1,154 @@
+package users
+
+import (
+	auth "Adornme/Auth"
+	db "Adornme/databases"
+	"Adornme/models"
+	"Adornme/utils"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Magic link errors
+var (
+	ErrInvalidMagicLink = errors.New("invalid or expired sign-in link")
+	ErrMagicLinkDevice  = errors.New("this sign-in link was requested from another device; open it there or request a new one")
+)
+
+func magicLinkTTL() time.Duration {
+	return time.Duration(cfg.MagicLinkTTLMinutes) * time.Minute
+}
+
+func magicLinkKey(email string) string { return "magic:" + accountKey(email) }
+
+// SendMagicLink emails a single-use sign-in link bound to deviceID. Unknown or
+// disabled accounts get the same answer and no mail.
+func (u *User) SendMagicLink(ctx context.Context, email string, deviceID string) error {
+	email = utils.NormalizeEmail(email)
+	logs.Info(ctx, "SendMagicLink service started")
+
+	// 🔹 1. Rate limits: per client, then per address (before the lookup, so they leak nothing)
+	if err := u.throttleClient(ctx, "magic"); err != nil {
+		return err
+	}
+	if err := u.throttleMagicLink(ctx, email); err != nil {
+		return err
+	}
+
+	// 🔹 2. Resolve the account
+	dbUser, err := u.DB.GetUserByEmail(ctx, email)
+	if err != nil {
+		logs.Info(ctx, "user not found for magic link (safe ignore)")
+		return nil
+	}
+	if err := checkAccountActive(dbUser); err != nil {
+		logs.Info(ctx, "inactive account, magic link not sent (safe ignore)", "user_id", dbUser.ID)
+		return nil
+	}
+
+	// 🔹 3. Sign a token and keep only its hash, bound to the device
+	ttl := magicLinkTTL()
+	token, err := auth.GenerateMagicLinkToken(fmt.Sprintf("%d", dbUser.ID), ttl)
+	if err != nil {
+		return errors.New("failed to generate sign-in link")
+	}
+	err = u.DB.SaveMagicLink(ctx, dbUser.ID, auth.HashToken(token), auth.HashToken(deviceID), time.Now().Add(ttl))
+	if err != nil {
+		logs.Error(ctx, "failed to save magic link", "user_id", dbUser.ID, "error", err.Error())
+		return errors.New("failed to send sign-in link")
+	}
+
+	// 🔹 4. Mail it
+	link := fmt.Sprintf("http://localhost:3000/magic-login?token=%s", token)
+	name := dbUser.Name
+	go func() {
+		if err := utils.SendMagicLinkEmail(email, name, link, cfg.MagicLinkTTLMinutes); err != nil {
+			logs.Error(ctx, "failed to send magic link", "user_id", dbUser.ID, "error", err.Error())
+		}
+	}()
+
+	logs.Info(ctx, "magic link sent", "user_id", dbUser.ID)
+	return nil
+}
+
+// VerifyMagicLink signs in with a link from SendMagicLink, on the device that
+// asked for it. Like an OTP login it is one factor, so MFA users get a challenge.
+func (u *User) VerifyMagicLink(ctx context.Context, token string, deviceID string) (*models.AuthResponse, *models.MFAChallenge, error) {
+	token = strings.TrimSpace(token)
+
+	// 1️⃣ Signature and expiry first, then the single-use record
+	claims, err := auth.ParseMagicLinkToken(token)
+	if err != nil {
+		logs.Warningf(ctx, "INVALID MAGIC LINK: %v", err)
+		return nil, nil, ErrInvalidMagicLink
+	}
+	id, err := u.DB.ConsumeMagicLink(ctx, auth.HashToken(token), auth.HashToken(deviceID))
+	if err != nil {
+		if errors.Is(err, db.ErrDeviceMismatch) {
+			logs.Warningf(ctx, "magic link opened on another device | user_id=%s", claims.UserID)
+			return nil, nil, ErrMagicLinkDevice
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			logs.Errorf(ctx, "MAGIC LINK LOOKUP FAILED: %v", err)
+		}
+		return nil, nil, ErrInvalidMagicLink
+	}
+
+	userID := fmt.Sprintf("%d", id)
+	if userID != claims.UserID {
+		return nil, nil, ErrInvalidMagicLink
+	}
+
+	// 2️⃣ Resolve the account
+	dbUser, err := u.loadActiveUser(ctx, userID)
+	if err != nil {
+		return nil, nil, ErrInvalidMagicLink
+	}
+	if err := checkAccountActive(dbUser); err != nil {
+		return nil, nil, err
+	}
+
+	// Opening the link proves possession of the address
+	u.markContactVerified(ctx, dbUser, "email")
+	u.clearLoginFailures(ctx, dbUser.Email)
+
+	roles := u.userRoles(ctx, userID)
+
+	// 3️⃣ Staff and opted-in users go on to MFA
+	challenge, err := u.mfaChallenge(ctx, dbUser, roles)
+	if err != nil || challenge != nil {
+		return nil, challenge, err
+	}
+
+	// 4️⃣ Issue tokens for a new device session
+	accessToken, refreshToken, err := u.startSession(ctx, userID, roles, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logs.Infof(ctx, "magic link login success | user_id=%s", userID)
+	return authResponse(dbUser, accessToken, refreshToken), nil, nil
+}
+
+// throttleMagicLink caps the links one address can be sent per hour
+func (u *User) throttleMagicLink(ctx context.Context, email string) error {
+	rp := redisStore()
+	if rp == nil {
+		return nil
+	}
+	n, err := rp.IncrHits(ctx, magicLinkKey(email), time.Hour)
+	if err != nil {
+		logs.Errorf(ctx, "rate limit lookup failed: %v", err)
+		return nil
+	}
+	if n > int64(cfg.MagicLinkMaxPerHour) {
+		logs.Warning(ctx, "magic links rate limited", "hits", n)
+		return &ThrottleError{RetryAfter: time.Hour}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package users
+
+import (
+	auth "Adornme/Auth"
+	"Adornme/config"
+	"log"
+	"os"
+	"testing"
+)
+
+// TestMain signs the tests' tokens with an ephemeral key, as dev mode does
+func TestMain(m *testing.M) {
+	if err := auth.LoadKeys(&config.Config{DevMode: true}); err != nil {
+		log.Fatalf("failed to load test signing keys: %v", err)
+	}
+	os.Exit(m.Run())
+}
@@ -0,0 +1,337 @@
+package users
+
+import (
+	auth "Adornme/Auth"
+	db "Adornme/databases"
+	"Adornme/internal/otp"
+	"Adornme/models"
+	"Adornme/utils"
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// MFA errors
+var (
+	ErrInvalidMFAToken   = errors.New("invalid or expired mfa token")
+	ErrInvalidMFACode    = errors.New("invalid authenticator or recovery code")
+	ErrMFAAlreadyEnabled = errors.New("mfa is already enabled")
+	ErrMFANotEnrolled    = errors.New("no authenticator enrolled")
+	ErrMFAMandatory      = errors.New("mfa is mandatory for staff accounts")
+	ErrMFAEnrollDenied   = errors.New("a second factor is already enrolled; use it to sign in")
+)
+
+// Security event types (mfa)
+const (
+	EventMFAEnabled       = "mfa_enabled"
+	EventMFADisabled      = "mfa_disabled"
+	EventRecoveryCodeUsed = "mfa_recovery_code_used"
+)
+
+const (
+	mfaIssuer         = "Adornme"
+	recoveryCodeCount = 10
+	maxMFAAttempts    = 5 // wrong codes per pending token
+)
+
+// mfaChallenge returns a pending-MFA challenge when the user must pass a second
+// factor (MFA enabled, or a staff role), or nil when the login may complete.
+func (u *User) mfaChallenge(ctx context.Context, dbUser *db.User, roles []string) (*models.MFAChallenge, error) {
+	totp, passkey, err := u.mfaFactors(ctx, dbUser.ID)
+	if err != nil {
+		logs.Errorf(ctx, "FAILED TO LOAD MFA: user=%d, err=%v", dbUser.ID, err)
+		return nil, errors.New("failed to login")
+	}
+	if !totp && !auth.RequiresMFA(roles) {
+		return nil, nil
+	}
+
+	token, err := auth.GenerateMFAToken(fmt.Sprintf("%d", dbUser.ID))
+	if err != nil {
+		return nil, errors.New("failed to generate mfa token")
+	}
+
+	// A registered passkey is enough to pass the challenge without TOTP
+	methods := []string{}
+	if totp {
+		methods = append(methods, "totp")
+	}
+	if passkey {
+		methods = append(methods, "passkey")
+	}
+	enroll := len(methods) == 0
+
+	logs.Infof(ctx, "mfa challenge issued | user_id=%d enroll_required=%t methods=%v", dbUser.ID, enroll, methods)
+	return &models.MFAChallenge{
+		MfaToken:       &token,
+		EnrollRequired: enroll,
+		ExpiresIn:      int64(auth.MFATokenTTL.Seconds()),
+		Methods:        methods,
+	}, nil
+}
+
+// mfaFactors reports which second factors the user can answer a challenge with.
+// A lookup error is returned, never read as "none": no factor means enrolment is open.
+func (u *User) mfaFactors(ctx context.Context, userID int64) (totp, passkey bool, err error) {
+	m, err := u.DB.GetUserMFA(ctx, userID)
+	switch {
+	case err == nil:
+		totp = m.Enabled
+	case !errors.Is(err, pgx.ErrNoRows):
+		return false, false, err
+	}
+	keys, err := u.DB.ListPasskeys(ctx, userID)
+	if err != nil {
+		return false, false, err
+	}
+	return totp, len(keys) > 0, nil
+}
+
+// EnrollMFA starts enrolment for a login that was challenged with enrollRequired.
+// The mfa token alone proves only the password, so it is refused once the user
+// has any second factor; otherwise it would let a password holder add their own.
+func (u *User) EnrollMFA(ctx context.Context, mfaToken string) (*models.MFAEnrollment, error) {
+	claims, err := auth.ParseMFAToken(mfaToken)
+	if err != nil {
+		logs.Warningf(ctx, "INVALID MFA TOKEN: %v", err)
+		return nil, ErrInvalidMFAToken
+	}
+	dbUser, err := u.loadUser(ctx, claims.UserID)
+	if err != nil {
+		return nil, ErrInvalidMFAToken
+	}
+
+	totp, passkey, err := u.mfaFactors(ctx, dbUser.ID)
+	if err != nil {
+		logs.Errorf(ctx, "FAILED TO LOAD MFA: user=%d, err=%v", dbUser.ID, err)
+		return nil, errors.New("failed to start mfa enrolment")
+	}
+	if totp || passkey {
+		logs.Warningf(ctx, "mfa enrolment refused, factor already enrolled | user_id=%d totp=%t passkey=%t", dbUser.ID, totp, passkey)
+		return nil, ErrMFAEnrollDenied
+	}
+	return u.enrollMFA(ctx, dbUser)
+}
+
+// StartMFAEnrollment starts enrolment for a signed-in user opting in
+func (u *User) StartMFAEnrollment(ctx context.Context, userID string) (*models.MFAEnrollment, error) {
+	dbUser, err := u.loadUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return u.enrollMFA(ctx, dbUser)
+}
+
+// enrollMFA stores a fresh secret and recovery codes; MFA turns on with the first valid code
+func (u *User) enrollMFA(ctx context.Context, dbUser *db.User) (*models.MFAEnrollment, error) {
+	secret, err := otp.GenerateTOTPSecret()
+	if err != nil {
+		return nil, errors.New("failed to start mfa enrolment")
+	}
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, errors.New("failed to start mfa enrolment")
+	}
+
+	if err := u.DB.SaveMFAEnrollment(ctx, dbUser.ID, secret, hashes); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMFAAlreadyEnabled
+		}
+		return nil, errors.New("failed to start mfa enrolment")
+	}
+
+	account := dbUser.Email
+	if account == "" {
+		account = dbUser.Phone
+	}
+
+	logs.Infof(ctx, "mfa enrolment started | user_id=%d", dbUser.ID)
+	uri := otp.TOTPProvisioningURI(secret, mfaIssuer, account)
+	return &models.MFAEnrollment{
+		Secret:        &secret,
+		OtpauthURI:    &uri,
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// VerifyMFA completes a challenged login. A TOTP code also confirms a pending enrolment.
+func (u *User) VerifyMFA(ctx context.Context, mfaToken string, code string) (*models.AuthResponse, error) {
+	claims, err := auth.ParseMFAToken(mfaToken)
+	if err != nil {
+		logs.Warningf(ctx, "INVALID MFA TOKEN: %v", err)
+		return nil, ErrInvalidMFAToken
+	}
+
+	// 🔹 1. Cap guesses per pending token
+	if rp := redisStore(); rp != nil {
+		n, err := rp.IncrFailures(ctx, "mfa:"+claims.ID, auth.MFATokenTTL)
+		if err == nil && n > maxMFAAttempts {
+			logs.Warning(ctx, "mfa attempts exhausted", "user_id", claims.UserID)
+			return nil, &ThrottleError{RetryAfter: time.Until(claims.ExpiresAt.Time)}
+		}
+	}
+
+	// 🔹 2. Load user + enrolment
+	dbUser, err := u.loadUser(ctx, claims.UserID)
+	if err != nil {
+		return nil, ErrInvalidMFAToken
+	}
+	if err := checkAccountActive(dbUser); err != nil {
+		return nil, err
+	}
+	m, err := u.DB.GetUserMFA(ctx, dbUser.ID)
+	if err != nil {
+		return nil, ErrMFANotEnrolled
+	}
+
+	// A pending enrolment may only complete the login when it is the user's
+	// first factor; with a passkey registered the challenge is the passkey's.
+	if !m.Enabled {
+		_, passkey, err := u.mfaFactors(ctx, dbUser.ID)
+		if err != nil {
+			logs.Errorf(ctx, "FAILED TO LOAD MFA: user=%d, err=%v", dbUser.ID, err)
+			return nil, errors.New("failed to verify code")
+		}
+		if passkey {
+			logs.Warningf(ctx, "pending totp refused, passkey enrolled | user_id=%d", dbUser.ID)
+			return nil, ErrMFANotEnrolled
+		}
+	}
+
+	// 🔹 3. Check the code
+	usedRecovery, err := u.checkMFACode(ctx, m, code)
+	if err != nil {
+		logs.Warningf(ctx, "mfa verification failed | user_id=%d err=%v", dbUser.ID, err)
+		return nil, err
+	}
+	if !m.Enabled {
+		u.recordMFAEvent(ctx, dbUser.ID, EventMFAEnabled)
+	}
+	if usedRecovery {
+		u.recordMFAEvent(ctx, dbUser.ID, EventRecoveryCodeUsed)
+	}
+
+	// 🔹 4. Start the session
+	userID := fmt.Sprintf("%d", dbUser.ID)
+	accessToken, refreshToken, err := u.startSession(ctx, userID, u.userRoles(ctx, userID), true)
+	if err != nil {
+		return nil, err
+	}
+
+	logs.Infof(ctx, "mfa login success | user_id=%s recovery_code=%t", userID, usedRecovery)
+	return authResponse(dbUser, accessToken, refreshToken), nil
+}
+
+// ConfirmMFA turns on a pending enrolment with a first authenticator code
+func (u *User) ConfirmMFA(ctx context.Context, userID string, code string) error {
+	dbUser, err := u.loadUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	m, err := u.DB.GetUserMFA(ctx, dbUser.ID)
+	if err != nil {
+		return ErrMFANotEnrolled
+	}
+	if m.Enabled {
+		return ErrMFAAlreadyEnabled
+	}
+
+	if _, err := u.checkMFACode(ctx, m, code); err != nil {
+		return err
+	}
+
+	u.recordMFAEvent(ctx, dbUser.ID, EventMFAEnabled)
+	logs.Infof(ctx, "mfa enabled | user_id=%d", dbUser.ID)
+	return nil
+}
+
+// DisableMFA removes the authenticator of a customer after checking a current code
+func (u *User) DisableMFA(ctx context.Context, userID string, code string) error {
+	if auth.RequiresMFA(u.userRoles(ctx, userID)) {
+		return ErrMFAMandatory
+	}
+
+	dbUser, err := u.loadUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	m, err := u.DB.GetUserMFA(ctx, dbUser.ID)
+	if err != nil || !m.Enabled {
+		return ErrMFANotEnrolled
+	}
+
+	if _, err := u.checkMFACode(ctx, m, code); err != nil {
+		return err
+	}
+	if err := u.DB.DeleteMFA(ctx, dbUser.ID); err != nil {
+		logs.Errorf(ctx, "FAILED TO DISABLE MFA: user=%d, err=%v", dbUser.ID, err)
+		return errors.New("failed to disable mfa")
+	}
+
+	u.recordMFAEvent(ctx, dbUser.ID, EventMFADisabled)
+	logs.Infof(ctx, "mfa disabled | user_id=%d", dbUser.ID)
+	return nil
+}
+
+// checkMFACode accepts a TOTP code (each time step once) or, once MFA is
+// enabled, an unused recovery code. usedRecovery tells which one matched.
+func (u *User) checkMFACode(ctx context.Context, m *db.UserMFA, code string) (usedRecovery bool, err error) {
+	if step, ok := otp.ValidateTOTP(m.TOTPSecret, code, time.Now()); ok {
+		if err := u.DB.UseTOTPStep(ctx, m.UserID, step); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return false, ErrInvalidMFACode // replayed code
+			}
+			return false, errors.New("failed to verify code")
+		}
+		return false, nil
+	}
+
+	if !m.Enabled {
+		return false, ErrInvalidMFACode
+	}
+	if err := u.DB.ConsumeRecoveryCode(ctx, m.UserID, auth.HashToken(normalizeRecoveryCode(code))); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, ErrInvalidMFACode
+		}
+		return false, errors.New("failed to verify code")
+	}
+	return true, nil
+}
+
+func (u *User) recordMFAEvent(ctx context.Context, userID int64, eventType string) {
+	userAgent, ip := utils.ClientInfoFromContext(ctx)
+	_ = u.DB.RecordSecurityEvent(ctx, &db.SecurityEvent{
+		UserID:    userID,
+		EventType: eventType,
+		IPAddress: ip,
+		UserAgent: userAgent,
+		Details:   map[string]any{"request_id": u.RequestID},
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+// generateRecoveryCodes returns codes formatted xxxx-xxxx and their hashes
+func generateRecoveryCodes() (codes []string, hashes []string, err error) {
+	enc := base32.StdEncoding.WithPadding(base32.NoPadding)
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		raw := strings.ToLower(enc.EncodeToString(b))
+		codes = append(codes, raw[:4]+"-"+raw[4:])
+		hashes = append(hashes, auth.HashToken(raw))
+	}
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
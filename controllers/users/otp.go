@@ -0,0 +1,268 @@
+package users
+
+import (
+	auth "Adornme/Auth"
+	db "Adornme/databases"
+	"Adornme/internal/otp"
+	phonenum "Adornme/internal/phone"
+	"Adornme/models"
+	"Adornme/utils"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrInvalidIdentifier is returned when the identifier is neither an email nor a valid phone number.
+var ErrInvalidIdentifier = errors.New("invalid identifier")
+
+// ErrSMSProviderMismatch is returned for a delivery callback addressed to a provider that is not configured.
+var ErrSMSProviderMismatch = errors.New("sms provider is not active")
+
+var (
+	otpOnce sync.Once
+	otpSvc  *otp.Service
+)
+
+// smsProvider is the SMS adapter selected by SMS_PROVIDER, set up by Init
+var smsProvider otp.SMSProvider
+
+// loadSMSProvider refuses to start on an unknown or misconfigured provider
+// rather than quietly sending nothing
+func loadSMSProvider() otp.SMSProvider {
+	p, err := otp.NewSMSProvider(cfg)
+	if err != nil {
+		logs.Fatalf(context.Background(), "SMS provider setup failed: %v", err)
+	}
+	return p
+}
+
+// otpService builds the OTP service on the shared Redis connection
+func otpService() (*otp.Service, error) {
+	otpOnce.Do(func() {
+		rp, ok := db.Do["redis"].(*db.RedisProvider)
+		if !ok || rp == nil || rp.Client == nil {
+			return
+		}
+		sms := otp.NewSMSSender(smsProvider, cfg.SMSSenderID, cfg.SMSDLTTemplateID)
+		otpSvc = otp.NewService(otp.NewStoreFromClient(rp.Client), &otp.EmailSender{}, sms)
+	})
+	if otpSvc == nil {
+		return nil, errors.New("otp service unavailable: redis not configured")
+	}
+	return otpSvc, nil
+}
+
+// HandleSMSDeliveryStatus authenticates and records a delivery report from the SMS gateway
+func HandleSMSDeliveryStatus(ctx context.Context, providerName string, r *http.Request) error {
+	provider := smsProvider
+	if provider.Name() != providerName {
+		return ErrSMSProviderMismatch
+	}
+
+	statuses, err := provider.ParseDeliveryStatus(r)
+	if err != nil {
+		logs.Warningf(ctx, "SMS CALLBACK REJECTED: provider=%s, err=%v", providerName, err)
+		return err
+	}
+
+	for _, st := range statuses {
+		if st.Status == otp.SMSStatusFailed || st.Status == otp.SMSStatusUndelivered {
+			logs.Warningf(ctx, "sms not delivered | provider=%s message_id=%s status=%s error=%s", st.Provider, st.MessageID, st.Status, st.Error)
+			continue
+		}
+		logs.Infof(ctx, "sms delivery status | provider=%s message_id=%s status=%s", st.Provider, st.MessageID, st.Status)
+	}
+	return nil
+}
+
+// parseIdentifier normalizes an email or mobile number (to E.164) and reports which one it is
+func parseIdentifier(identifier string) (target string, otpType string, err error) {
+	identifier = strings.TrimSpace(identifier)
+
+	// 🔍 Email case
+	if utils.IsEmail(identifier) {
+		return utils.NormalizeEmail(identifier), "email", nil
+	}
+
+	// 📱 Phone case
+	phone, err := normalizePhone(identifier)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %v", ErrInvalidIdentifier, err)
+	}
+	return phone, "phone", nil
+}
+
+// normalizePhone parses a mobile number into E.164; numbers typed without a
+// +country code are read in the configured default region
+func normalizePhone(input string) (string, error) {
+	return phonenum.Normalize(input, cfg.PhoneDefaultRegion)
+}
+
+// phoneCountry returns the ISO region of a stored E.164 number
+func phoneCountry(e164 string) string {
+	n, err := phonenum.Parse(e164, cfg.PhoneDefaultRegion)
+	if err != nil {
+		return ""
+	}
+	return n.Country.ISO
+}
+
+// SendOTP sends a login code to an email or phone.
+// Phones always get a code (first login creates the account); emails only
+// when an account exists, without revealing whether it does.
+func (u *User) SendOTP(ctx context.Context, identifier string) error {
+	if err := u.throttleClient(ctx, "otp_send"); err != nil {
+		return err
+	}
+
+	target, otpType, err := parseIdentifier(identifier)
+	if err != nil {
+		return err
+	}
+
+	if otpType == "email" {
+		if _, err := u.DB.GetUserByEmail(ctx, target); err != nil {
+			logs.Info(ctx, "otp requested for unknown email (safe ignore)")
+			return nil
+		}
+	}
+
+	svc, err := otpService()
+	if err != nil {
+		logs.Errorf(ctx, "SEND OTP FAILED: %v", err)
+		return err
+	}
+
+	if err := svc.SendOTP(ctx, target, otpType); err != nil {
+		if errors.Is(err, otp.ErrCooldown) {
+			logs.Infof(ctx, "otp cooldown active | type=%s retry_in=%s", otpType, svc.CooldownRemaining(ctx, target, otpType))
+			return err
+		}
+		logs.Errorf(ctx, "SEND OTP FAILED: type=%s, err=%v", otpType, err)
+		return errors.New("failed to send otp")
+	}
+
+	logs.Infof(ctx, "otp sent | type=%s", otpType)
+	return nil
+}
+
+// VerifyOTP checks a login code and starts a session, creating the account
+// on a first-time phone login. Accounts needing MFA get a challenge instead.
+func (u *User) VerifyOTP(ctx context.Context, identifier string, code string) (*models.VerifyOTPResponse, *models.MFAChallenge, error) {
+	target, otpType, err := parseIdentifier(identifier)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	svc, err := otpService()
+	if err != nil {
+		logs.Errorf(ctx, "VERIFY OTP FAILED: %v", err)
+		return nil, nil, err
+	}
+
+	// 1️⃣ Check the code
+	if err := svc.VerifyOTP(ctx, target, otpType, strings.TrimSpace(code)); err != nil {
+		logs.Warningf(ctx, "otp verification failed | type=%s err=%v", otpType, err)
+		return nil, nil, err
+	}
+
+	// 2️⃣ Resolve the account
+	var dbUser *db.User
+	newUser := false
+	if otpType == "email" {
+		dbUser, err = u.DB.GetUserByEmail(ctx, target)
+	} else {
+		dbUser, err = u.DB.GetUserByPhone(ctx, target)
+		if errors.Is(err, pgx.ErrNoRows) {
+			dbUser, err = u.createPhoneUser(ctx, target)
+			newUser = err == nil
+		}
+	}
+	if err != nil {
+		logs.Errorf(ctx, "OTP LOGIN USER LOOKUP FAILED: type=%s, err=%v", otpType, err)
+		return nil, nil, errors.New("failed to login")
+	}
+
+	if err := checkAccountActive(dbUser); err != nil {
+		return nil, nil, err
+	}
+
+	// A correct code proves possession of the contact
+	u.markContactVerified(ctx, dbUser, otpType)
+
+	userID := fmt.Sprintf("%d", dbUser.ID)
+	roles := u.userRoles(ctx, userID)
+
+	// 3️⃣ A one-time code is still one factor; staff and opted-in users go on to MFA
+	challenge, err := u.mfaChallenge(ctx, dbUser, roles)
+	if err != nil || challenge != nil {
+		return nil, challenge, err
+	}
+
+	// 4️⃣ Issue tokens for a new device session
+	accessToken, refreshToken, err := u.startSession(ctx, userID, roles, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logs.Infof(ctx, "otp login success | user_id=%s new_user=%t", userID, newUser)
+
+	user := &models.User{
+		ID:            &dbUser.ID,
+		Name:          &dbUser.Name,
+		Phone:         dbUser.Phone,
+		PhoneCountry:  phoneCountry(dbUser.Phone),
+		EmailVerified: dbUser.EmailVerified,
+		PhoneVerified: dbUser.PhoneVerified,
+	}
+	if dbUser.Email != "" {
+		e := strfmt.Email(dbUser.Email)
+		user.Email = &e
+	}
+
+	return &models.VerifyOTPResponse{
+		Message:      "Login successful",
+		Token:        accessToken,
+		RefreshToken: refreshToken,
+		NewUser:      newUser,
+		User:         user,
+	}, nil, nil
+}
+
+// createPhoneUser registers a passwordless customer for a first-time phone login
+func (u *User) createPhoneUser(ctx context.Context, phone string) (*db.User, error) {
+	id, err := u.DB.CreatePhoneUser(ctx, phone)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := fmt.Sprintf("%d", id)
+	if err := u.DB.AssignRole(ctx, userID, auth.RoleCustomer); err != nil {
+		logs.Errorf(ctx, "failed to assign default role for user %d: %v", id, err)
+	}
+
+	logs.Infof(ctx, "user created on first otp login | user_id=%d", id)
+	return &db.User{ID: id, Phone: phone, PhoneVerified: true}, nil
+}
+
+// markContactVerified flags the email or phone used for an OTP login as verified
+func (u *User) markContactVerified(ctx context.Context, dbUser *db.User, otpType string) {
+	var err error
+	switch {
+	case otpType == "email" && !dbUser.EmailVerified:
+		err = u.DB.SetEmailVerified(ctx, dbUser.ID, dbUser.Email)
+		dbUser.EmailVerified = err == nil
+	case otpType == "phone" && !dbUser.PhoneVerified:
+		err = u.DB.SetPhoneVerified(ctx, dbUser.ID, dbUser.Phone)
+		dbUser.PhoneVerified = err == nil
+	}
+	if err != nil {
+		logs.Errorf(ctx, "failed to mark %s verified for user %d: %v", otpType, dbUser.ID, err)
+	}
+}
@@ -0,0 +1,413 @@
+package users
+
+import (
+	auth "Adornme/Auth"
+	db "Adornme/databases"
+	"Adornme/internal/webauthn"
+	"Adornme/models"
+	"Adornme/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jackc/pgx/v5"
+)
+
+// Passkey errors
+var (
+	ErrInvalidPasskey      = errors.New("passkey could not be verified")
+	ErrPasskeyNotFound     = errors.New("passkey not found")
+	ErrPasskeyExists       = errors.New("this passkey is already registered")
+	ErrPasskeysUnavailable = errors.New("passkeys are temporarily unavailable")
+)
+
+// Security event types (passkeys)
+const (
+	EventPasskeyRegistered = "passkey_registered"
+	EventPasskeyRemoved    = "passkey_removed"
+	EventPasskeyCloned     = "passkey_counter_regressed"
+)
+
+// Ceremony purposes kept with each challenge
+const (
+	purposeRegister = "register"
+	purposeLogin    = "login" // passkey is the only factor
+	purposeMFA      = "mfa"   // passkey is the second factor
+)
+
+const defaultPasskeyName = "Passkey"
+
+// ceremonyState is stored in Redis under the challenge until it is answered
+type ceremonyState struct {
+	Purpose string `json:"purpose"`
+	UserID  int64  `json:"user_id,omitempty"` // 0 for a login that may use any discoverable passkey
+}
+
+func relyingParty() *webauthn.RelyingParty {
+	return &webauthn.RelyingParty{
+		ID:      cfg.WebAuthnRPID,
+		Name:    cfg.WebAuthnRPName,
+		Origins: cfg.WebAuthnOrigins,
+		Timeout: time.Duration(cfg.WebAuthnTimeoutSeconds) * time.Second,
+	}
+}
+
+// userHandle is the opaque id the authenticator stores with a discoverable credential
+func userHandle(userID int64) []byte { return []byte(fmt.Sprintf("%d", userID)) }
+
+// BeginPasskeyRegistration returns creation options for a signed-in user's new passkey
+func (u *User) BeginPasskeyRegistration(ctx context.Context, userID string) (*models.PasskeyOptions, error) {
+	dbUser, err := u.loadActiveUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := u.passkeyCredentials(ctx, dbUser.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, err := u.startCeremony(ctx, ceremonyState{Purpose: purposeRegister, UserID: dbUser.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	name := dbUser.Email
+	if name == "" {
+		name = dbUser.Phone
+	}
+	opts := relyingParty().CreationOptions(challenge, webauthn.User{
+		ID:          userHandle(dbUser.ID),
+		Name:        name,
+		DisplayName: dbUser.Name,
+	}, existing)
+
+	logs.Infof(ctx, "passkey registration started | user_id=%d", dbUser.ID)
+	return &models.PasskeyOptions{PublicKey: opts}, nil
+}
+
+// RegisterPasskey verifies the authenticator's answer to BeginPasskeyRegistration and stores the credential
+func (u *User) RegisterPasskey(ctx context.Context, userID string, req *models.PasskeyRegistrationRequest) (*models.Passkey, error) {
+	dbUser, err := u.loadActiveUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🔹 1. Decode the browser's JSON
+	r := req.Credential.Response
+	clientData, err1 := webauthn.DecodeBase64URL(*r.ClientDataJSON)
+	attObj, err2 := webauthn.DecodeBase64URL(*r.AttestationObject)
+	credID, err3 := webauthn.DecodeBase64URL(*req.Credential.ID)
+	if err := errors.Join(err1, err2, err3); err != nil {
+		return nil, ErrInvalidPasskey
+	}
+
+	// 🔹 2. Match it to the ceremony this user started
+	challenge, state, err := u.takeCeremony(ctx, clientData)
+	if err != nil {
+		return nil, err
+	}
+	if state.Purpose != purposeRegister || state.UserID != dbUser.ID {
+		return nil, ErrInvalidPasskey
+	}
+
+	// 🔹 3. Verify the attestation
+	cred, err := relyingParty().VerifyAttestation(challenge, &webauthn.Attestation{
+		CredentialID:      credID,
+		ClientDataJSON:    clientData,
+		AttestationObject: attObj,
+		Transports:        r.Transports,
+	}, false)
+	if err != nil {
+		logs.Warningf(ctx, "passkey attestation rejected | user_id=%d err=%v", dbUser.ID, err)
+		return nil, ErrInvalidPasskey
+	}
+
+	// 🔹 4. Store it
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = defaultPasskeyName
+	}
+	transports := cred.Transports
+	if transports == nil {
+		transports = []string{}
+	}
+	k := &db.Passkey{
+		UserID:         dbUser.ID,
+		CredentialID:   cred.ID,
+		PublicKey:      cred.PublicKey,
+		Algorithm:      cred.Algorithm,
+		SignCount:      int64(cred.SignCount),
+		AAGUID:         cred.AAGUID,
+		Transports:     transports,
+		Name:           name,
+		BackupEligible: cred.BackupEligible,
+		BackedUp:       cred.BackedUp,
+	}
+	if err := u.DB.CreatePasskey(ctx, k); err != nil {
+		if errors.Is(err, db.ErrCredentialExists) {
+			return nil, ErrPasskeyExists
+		}
+		return nil, errors.New("failed to register passkey")
+	}
+
+	u.recordProfileEvent(ctx, dbUser.ID, EventPasskeyRegistered, map[string]any{"passkey_id": k.ID, "name": name})
+	logs.Infof(ctx, "passkey registered | user_id=%d passkey_id=%d", dbUser.ID, k.ID)
+	return passkeyModel(k), nil
+}
+
+// ListPasskeys returns the user's passkeys, oldest first
+func (u *User) ListPasskeys(ctx context.Context, userID string) ([]*models.Passkey, error) {
+	dbUser, err := u.loadActiveUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := u.DB.ListPasskeys(ctx, dbUser.ID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to list passkeys: %v", err)
+		return nil, errors.New("failed to list passkeys")
+	}
+	out := make([]*models.Passkey, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, passkeyModel(k))
+	}
+	return out, nil
+}
+
+// DeletePasskey removes one of the user's passkeys
+func (u *User) DeletePasskey(ctx context.Context, userID string, id int64) error {
+	dbUser, err := u.loadActiveUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := u.DB.DeletePasskey(ctx, dbUser.ID, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrPasskeyNotFound
+		}
+		return errors.New("failed to remove passkey")
+	}
+	u.recordProfileEvent(ctx, dbUser.ID, EventPasskeyRemoved, map[string]any{"passkey_id": id})
+	logs.Infof(ctx, "passkey removed | user_id=%d passkey_id=%d", dbUser.ID, id)
+	return nil
+}
+
+// BeginPasskeyLogin returns request options for a login. With an mfaToken the
+// passkey completes a pending MFA challenge; with an email only that account's
+// passkeys are offered; without either, or for an unknown email, any
+// discoverable passkey may answer.
+func (u *User) BeginPasskeyLogin(ctx context.Context, email string, mfaToken string) (*models.PasskeyOptions, error) {
+	if err := u.throttleClient(ctx, "passkey"); err != nil {
+		return nil, err
+	}
+
+	state := ceremonyState{Purpose: purposeLogin}
+	userVerification := "required"
+	var allow []webauthn.Credential
+
+	switch {
+	case mfaToken != "":
+		claims, err := auth.ParseMFAToken(mfaToken)
+		if err != nil {
+			logs.Warningf(ctx, "INVALID MFA TOKEN: %v", err)
+			return nil, ErrInvalidMFAToken
+		}
+		dbUser, err := u.loadActiveUser(ctx, claims.UserID)
+		if err != nil {
+			return nil, ErrInvalidMFAToken
+		}
+		if allow, err = u.passkeyCredentials(ctx, dbUser.ID); err != nil {
+			return nil, err
+		}
+		if len(allow) == 0 {
+			return nil, ErrPasskeyNotFound
+		}
+		state = ceremonyState{Purpose: purposeMFA, UserID: dbUser.ID}
+		userVerification = "discouraged"
+
+	case email != "":
+		if dbUser, err := u.DB.GetUserByEmail(ctx, utils.NormalizeEmail(email)); err == nil && checkAccountActive(dbUser) == nil {
+			if allow, err = u.passkeyCredentials(ctx, dbUser.ID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	challenge, err := u.startCeremony(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	return &models.PasskeyOptions{PublicKey: relyingParty().RequestOptions(challenge, allow, userVerification)}, nil
+}
+
+// LoginWithPasskey verifies the answer to BeginPasskeyLogin. On its own the
+// passkey must have verified the user (PIN or biometric), which counts as two
+// factors, so no MFA challenge follows.
+func (u *User) LoginWithPasskey(ctx context.Context, req *models.PasskeyLoginRequest) (*models.AuthResponse, error) {
+	r := req.Credential.Response
+	clientData, err1 := webauthn.DecodeBase64URL(*r.ClientDataJSON)
+	authData, err2 := webauthn.DecodeBase64URL(*r.AuthenticatorData)
+	sig, err3 := webauthn.DecodeBase64URL(*r.Signature)
+	credID, err4 := webauthn.DecodeBase64URL(*req.Credential.ID)
+	handle, err5 := webauthn.DecodeBase64URL(r.UserHandle)
+	if err := errors.Join(err1, err2, err3, err4, err5); err != nil {
+		return nil, ErrInvalidPasskey
+	}
+
+	// 1️⃣ The ceremony must be the one the caller started
+	challenge, state, err := u.takeCeremony(ctx, clientData)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case req.MfaToken != "":
+		claims, err := auth.ParseMFAToken(req.MfaToken)
+		if err != nil || state.Purpose != purposeMFA || fmt.Sprintf("%d", state.UserID) != claims.UserID {
+			return nil, ErrInvalidMFAToken
+		}
+	case state.Purpose != purposeLogin:
+		return nil, ErrInvalidPasskey
+	}
+
+	// 2️⃣ Find the credential and its owner
+	k, err := u.DB.GetPasskeyByCredentialID(ctx, credID)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			logs.Errorf(ctx, "PASSKEY LOOKUP FAILED: %v", err)
+		}
+		return nil, ErrInvalidPasskey
+	}
+	if state.UserID != 0 && k.UserID != state.UserID {
+		return nil, ErrInvalidPasskey
+	}
+	if len(handle) > 0 && string(handle) != string(userHandle(k.UserID)) {
+		return nil, ErrInvalidPasskey
+	}
+
+	// 3️⃣ Check the signature
+	result, err := relyingParty().VerifyAssertion(challenge, &webauthn.Assertion{
+		CredentialID:      credID,
+		ClientDataJSON:    clientData,
+		AuthenticatorData: authData,
+		Signature:         sig,
+		UserHandle:        handle,
+	}, passkeyCredential(k), state.Purpose == purposeLogin)
+	if err != nil {
+		if errors.Is(err, webauthn.ErrClonedAuthenticator) {
+			u.recordMFAEvent(ctx, k.UserID, EventPasskeyCloned)
+		}
+		logs.Warningf(ctx, "passkey assertion rejected | user_id=%d passkey_id=%d err=%v", k.UserID, k.ID, err)
+		return nil, ErrInvalidPasskey
+	}
+	if err := u.DB.UpdatePasskeyUsage(ctx, k.ID, int64(result.SignCount), result.BackedUp); err != nil {
+		logs.Errorf(ctx, "failed to update passkey usage: %v", err)
+	}
+
+	// 4️⃣ Resolve the account and start the session
+	userID := fmt.Sprintf("%d", k.UserID)
+	dbUser, err := u.loadActiveUser(ctx, userID)
+	if err != nil {
+		return nil, ErrInvalidPasskey
+	}
+	if err := checkAccountActive(dbUser); err != nil {
+		return nil, err
+	}
+	u.clearLoginFailures(ctx, dbUser.Email)
+
+	// A user-verified passkey login counts as two factors, as does a passkey second factor
+	accessToken, refreshToken, err := u.startSession(ctx, userID, u.userRoles(ctx, userID), true)
+	if err != nil {
+		return nil, err
+	}
+
+	logs.Infof(ctx, "passkey login success | user_id=%s passkey_id=%d second_factor=%t", userID, k.ID, state.Purpose == purposeMFA)
+	return authResponse(dbUser, accessToken, refreshToken), nil
+}
+
+// passkeyCredentials returns the user's passkeys in the form the ceremonies use
+func (u *User) passkeyCredentials(ctx context.Context, userID int64) ([]webauthn.Credential, error) {
+	keys, err := u.DB.ListPasskeys(ctx, userID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to list passkeys: %v", err)
+		return nil, errors.New("failed to load passkeys")
+	}
+	out := make([]webauthn.Credential, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, *passkeyCredential(k))
+	}
+	return out, nil
+}
+
+// startCeremony issues a challenge and keeps state under it for the ceremony timeout
+func (u *User) startCeremony(ctx context.Context, state ceremonyState) ([]byte, error) {
+	rp := redisStore()
+	if rp == nil {
+		return nil, ErrPasskeysUnavailable
+	}
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return nil, errors.New("failed to generate challenge")
+	}
+	raw, _ := json.Marshal(state)
+	ttl := time.Duration(cfg.WebAuthnTimeoutSeconds) * time.Second
+	if err := rp.SaveChallenge(ctx, webauthn.EncodeBase64URL(challenge), string(raw), ttl); err != nil {
+		logs.Errorf(ctx, "failed to save webauthn challenge: %v", err)
+		return nil, ErrPasskeysUnavailable
+	}
+	return challenge, nil
+}
+
+// takeCeremony finds and consumes the ceremony a response answers
+func (u *User) takeCeremony(ctx context.Context, clientDataJSON []byte) ([]byte, *ceremonyState, error) {
+	rp := redisStore()
+	if rp == nil {
+		return nil, nil, ErrPasskeysUnavailable
+	}
+	encoded, err := webauthn.ChallengeFromClientData(clientDataJSON)
+	if err != nil {
+		return nil, nil, ErrInvalidPasskey
+	}
+	raw, err := rp.TakeChallenge(ctx, encoded)
+	if err != nil {
+		logs.Warningf(ctx, "unknown or expired webauthn challenge: %v", err)
+		return nil, nil, ErrInvalidPasskey
+	}
+	var state ceremonyState
+	if err := json.Unmarshal([]byte(raw), &state); err != nil {
+		return nil, nil, ErrInvalidPasskey
+	}
+	challenge, err := webauthn.DecodeBase64URL(encoded)
+	if err != nil {
+		return nil, nil, ErrInvalidPasskey
+	}
+	return challenge, &state, nil
+}
+
+func passkeyCredential(k *db.Passkey) *webauthn.Credential {
+	return &webauthn.Credential{
+		ID:             k.CredentialID,
+		PublicKey:      k.PublicKey,
+		Algorithm:      k.Algorithm,
+		SignCount:      uint32(k.SignCount),
+		AAGUID:         k.AAGUID,
+		Transports:     k.Transports,
+		BackupEligible: k.BackupEligible,
+		BackedUp:       k.BackedUp,
+	}
+}
+
+func passkeyModel(k *db.Passkey) *models.Passkey {
+	out := &models.Passkey{
+		ID:         &k.ID,
+		Name:       &k.Name,
+		Transports: k.Transports,
+		BackedUp:   k.BackedUp,
+		CreatedAt:  strfmt.DateTime(k.CreatedAt),
+	}
+	if k.LastUsedAt != nil {
+		out.LastUsedAt = strfmt.DateTime(*k.LastUsedAt)
+	}
+	return out
+}
@@ -0,0 +1,531 @@
+package users
+
+import (
+	db "Adornme/databases"
+	"Adornme/models"
+	"Adornme/utils"
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Privacy (export / erasure) errors
+var (
+	ErrPrivacyJobNotFound    = errors.New("privacy job not found")
+	ErrInvalidErasureRequest = errors.New("invalid erasure request")
+	ErrStoreUnavailable      = errors.New("data store unavailable")
+)
+
+// Security event types (privacy)
+const (
+	EventDataExportRequested = "data_export_requested"
+	EventErasureRequested    = "erasure_requested"
+)
+
+const (
+	privacyJobExport  = "export"
+	privacyJobErasure = "erasure"
+
+	// A queued or running job that made no progress for this long died with its pod
+	privacyJobStaleAfter = 30 * time.Minute
+	privacyJobTimeout    = 20 * time.Minute
+)
+
+// privacyStep is one store visited by an export or erasure job
+type privacyStep struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+func ordersStore() *db.PostgresProvider {
+	pg, ok := db.Do["postgres"].(*db.PostgresClients)
+	if !ok || pg == nil {
+		return nil
+	}
+	return pg.OrdersDB
+}
+
+func minioStore() *db.MinioProvider {
+	mp, ok := db.Do["minio"].(*db.MinioProvider)
+	if !ok || mp == nil || mp.Client == nil {
+		return nil
+	}
+	return mp
+}
+
+// uploadsPrefix is where a user's own files live in the uploads bucket
+func uploadsPrefix(userID int64) string { return fmt.Sprintf("users/%d/", userID) }
+
+// exportsPrefix is where a user's export archives live in the export bucket
+func exportsPrefix(userID int64) string { return fmt.Sprintf("exports/%d/", userID) }
+
+// RequestDataExport queues a job that gathers everything held about the user
+// into a ZIP archive in MinIO. A job already under way is returned instead.
+func (u *User) RequestDataExport(ctx context.Context, userID string) (*models.PrivacyJob, error) {
+	logs.Infof(ctx, "RequestDataExport called with requestID: %s, userID: %s", u.RequestID, userID)
+
+	dbUser, err := u.loadActiveUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	job, started, err := u.startPrivacyJob(ctx, dbUser.ID, privacyJobExport)
+	if err != nil || !started {
+		return u.privacyJobModel(ctx, job), err
+	}
+
+	u.recordProfileEvent(ctx, dbUser.ID, EventDataExportRequested, map[string]any{"job_id": job.ID})
+	go u.runPrivacyJob(ctx, job, u.exportSteps(dbUser, job))
+
+	return u.privacyJobModel(ctx, job), nil
+}
+
+// RequestErasure queues a job that erases the user's personal data from every
+// store. Orders stay for the books but only point at the anonymised account.
+func (u *User) RequestErasure(ctx context.Context, userID string, currentPassword string) (*models.PrivacyJob, error) {
+	logs.Infof(ctx, "RequestErasure called with requestID: %s, userID: %s", u.RequestID, userID)
+
+	dbUser, err := u.loadActiveUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Phone-only accounts have no password; their bearer token is the proof
+	if dbUser.Password != "" {
+		if currentPassword == "" {
+			return nil, fmt.Errorf("%w: currentPassword is required", ErrInvalidErasureRequest)
+		}
+		if err := bcrypt.CompareHashAndPassword([]byte(dbUser.Password), []byte(currentPassword)); err != nil {
+			return nil, ErrWrongPassword
+		}
+	}
+
+	job, started, err := u.startPrivacyJob(ctx, dbUser.ID, privacyJobErasure)
+	if err != nil || !started {
+		return u.privacyJobModel(ctx, job), err
+	}
+
+	u.recordProfileEvent(ctx, dbUser.ID, EventErasureRequested, map[string]any{"job_id": job.ID})
+	logs.Warning(ctx, "erasure requested", "user_id", userID, "job_id", job.ID)
+	go u.runPrivacyJob(ctx, job, u.erasureSteps(dbUser))
+
+	return u.privacyJobModel(ctx, job), nil
+}
+
+// GetPrivacyJob returns the progress of one of the user's own jobs
+func (u *User) GetPrivacyJob(ctx context.Context, userID string, jobID string) (*models.PrivacyJob, error) {
+	if _, err := uuid.Parse(jobID); err != nil {
+		return nil, ErrPrivacyJobNotFound
+	}
+	job, err := u.DB.GetPrivacyJob(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrPrivacyJobNotFound
+		}
+		return nil, errors.New("failed to load job")
+	}
+	if strconv.FormatInt(job.UserID, 10) != userID {
+		return nil, ErrPrivacyJobNotFound
+	}
+	return u.privacyJobModel(ctx, job), nil
+}
+
+// startPrivacyJob returns the live job of this kind, or queues a new one
+func (u *User) startPrivacyJob(ctx context.Context, userID int64, kind string) (job *db.PrivacyJob, started bool, err error) {
+	job, err = u.DB.GetActivePrivacyJob(ctx, userID, kind, time.Now().Add(-privacyJobStaleAfter))
+	if err == nil {
+		return job, false, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, fmt.Errorf("failed to start %s", kind)
+	}
+
+	job = &db.PrivacyJob{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		Kind:      kind,
+		Status:    models.PrivacyJobStatusQueued,
+		CreatedAt: time.Now().UTC(),
+	}
+	job.UpdatedAt = job.CreatedAt
+	if err := u.DB.CreatePrivacyJob(ctx, job); err != nil {
+		return nil, false, fmt.Errorf("failed to start %s", kind)
+	}
+	return job, true, nil
+}
+
+// runPrivacyJob works through the steps in the background, reporting progress
+// after each one. The first failing step fails the job; it can be requested again.
+func (u *User) runPrivacyJob(ctx context.Context, job *db.PrivacyJob, steps []privacyStep) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), privacyJobTimeout)
+	defer cancel()
+
+	for i, step := range steps {
+		if err := u.DB.UpdatePrivacyJobProgress(ctx, job.ID, i*100/len(steps), step.name); err != nil {
+			logs.Errorf(ctx, "FAILED TO UPDATE PRIVACY JOB: job=%s, err=%v", job.ID, err)
+		}
+		if err := step.run(ctx); err != nil {
+			logs.Errorf(ctx, "PRIVACY JOB FAILED: job=%s kind=%s step=%s err=%v", job.ID, job.Kind, step.name, err)
+			if err := u.DB.FailPrivacyJob(ctx, job.ID, fmt.Sprintf("failed while processing %s, please try again", step.name)); err != nil {
+				logs.Errorf(ctx, "FAILED TO UPDATE PRIVACY JOB: job=%s, err=%v", job.ID, err)
+			}
+			return
+		}
+	}
+
+	if err := u.DB.CompletePrivacyJob(ctx, job.ID, job.ObjectKey, job.ExpiresAt); err != nil {
+		logs.Errorf(ctx, "FAILED TO COMPLETE PRIVACY JOB: job=%s, err=%v", job.ID, err)
+		return
+	}
+	logs.Infof(ctx, "privacy job completed | job=%s kind=%s user_id=%d", job.ID, job.Kind, job.UserID)
+}
+
+// exportSteps gathers the user's data store by store, then zips it into MinIO
+func (u *User) exportSteps(dbUser *db.User, job *db.PrivacyJob) []privacyStep {
+	uid := dbUser.ID
+	userID := strconv.FormatInt(uid, 10)
+	archive := &exportArchive{uploadsPrefix: uploadsPrefix(uid)}
+
+	return []privacyStep{
+		{"account", func(ctx context.Context) error {
+			mfa, err := u.DB.GetUserMFA(ctx, uid)
+			if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+				return err
+			}
+			account := map[string]any{
+				"id":             uid,
+				"name":           dbUser.Name,
+				"email":          dbUser.Email,
+				"phone":          dbUser.Phone,
+				"email_verified": dbUser.EmailVerified,
+				"phone_verified": dbUser.PhoneVerified,
+				"has_password":   dbUser.Password != "",
+				"mfa_enabled":    mfa != nil && mfa.Enabled,
+				"roles":          u.userRoles(ctx, userID),
+				"created_at":     dbUser.CreatedAt,
+			}
+			if changes, err := u.DB.GetContactChanges(ctx, uid); err == nil && len(changes) > 0 {
+				pending := map[string]string{}
+				for _, c := range changes {
+					pending[c.Kind] = c.NewValue
+				}
+				account["pending_changes"] = pending
+			}
+			archive.add("account.json", account)
+
+			sessions, err := u.DB.ListSessionHistory(ctx, uid)
+			if err != nil {
+				return err
+			}
+			out := make([]map[string]any, 0, len(sessions))
+			for _, s := range sessions {
+				out = append(out, map[string]any{
+					"id":           s.ID,
+					"user_agent":   s.UserAgent,
+					"ip_address":   s.IPAddress,
+					"created_at":   s.CreatedAt,
+					"last_used_at": s.LastUsedAt,
+					"revoked_at":   s.RevokedAt,
+				})
+			}
+			archive.add("sessions.json", out)
+
+			events, err := u.DB.ListSecurityEvents(ctx, uid)
+			if err != nil {
+				return err
+			}
+			evOut := make([]map[string]any, 0, len(events))
+			for _, e := range events {
+				evOut = append(evOut, map[string]any{
+					"event":      e.EventType,
+					"ip_address": e.IPAddress,
+					"user_agent": e.UserAgent,
+					"details":    e.Details,
+					"created_at": e.CreatedAt,
+				})
+			}
+			archive.add("security_events.json", evOut)
+			return nil
+		}},
+		{"orders", func(ctx context.Context) error {
+			od := ordersStore()
+			if od == nil {
+				return fmt.Errorf("%w: orders", ErrStoreUnavailable)
+			}
+			orders, err := od.ListOrdersByUser(ctx, uid)
+			if err != nil {
+				return err
+			}
+			out := make([]map[string]any, 0, len(orders))
+			for _, o := range orders {
+				out = append(out, map[string]any{
+					"id":         o.ID,
+					"total":      o.Total,
+					"status":     o.Status,
+					"created_at": o.CreatedAt,
+					"updated_at": o.UpdatedAt,
+				})
+			}
+			archive.add("orders.json", out)
+
+			cart, err := od.ListCartItems(ctx, uid)
+			if err != nil {
+				return err
+			}
+			cartOut := make([]map[string]any, 0, len(cart))
+			for _, c := range cart {
+				cartOut = append(cartOut, map[string]any{
+					"product_id": c.ProductID,
+					"sku_id":     c.SkuID,
+					"quantity":   c.Quantity,
+					"added_at":   c.CreatedAt,
+				})
+			}
+			archive.add("cart.json", cartOut)
+			return nil
+		}},
+		{"activity", func(ctx context.Context) error {
+			mp := mongoStore()
+			if mp == nil {
+				return fmt.Errorf("%w: mongo", ErrStoreUnavailable)
+			}
+			activity, err := mp.FindUserActivity(ctx, uid)
+			if err != nil {
+				return err
+			}
+			out := make([]map[string]any, 0, len(activity))
+			for _, a := range activity {
+				out = append(out, map[string]any{"action": a.Action, "metadata": a.Metadata, "created_at": a.CreatedAt})
+			}
+			archive.add("activity.json", out)
+
+			audit, err := mp.FindEventLogs(ctx, auditServiceName, uid)
+			if err != nil {
+				return err
+			}
+			auditOut := make([]map[string]any, 0, len(audit))
+			for _, e := range audit {
+				auditOut = append(auditOut, map[string]any{"action": e.Action, "details": e.Payload, "created_at": e.CreatedAt})
+			}
+			archive.add("audit_log.json", auditOut)
+			return nil
+		}},
+		{"uploads", func(ctx context.Context) error {
+			mp := minioStore()
+			if mp == nil {
+				return fmt.Errorf("%w: minio", ErrStoreUnavailable)
+			}
+			keys, err := mp.ListKeys(ctx, cfg.UploadsBucket, archive.uploadsPrefix)
+			if err != nil {
+				return err
+			}
+			archive.uploads = keys
+			return nil
+		}},
+		{"archive", func(ctx context.Context) error {
+			mp := minioStore()
+			if mp == nil {
+				return fmt.Errorf("%w: minio", ErrStoreUnavailable)
+			}
+			key := exportsPrefix(uid) + job.ID + ".zip"
+			if err := archive.store(ctx, mp, key); err != nil {
+				return err
+			}
+
+			ttl := time.Duration(cfg.PrivacyExportLinkHours) * time.Hour
+			expiresAt := time.Now().Add(ttl).UTC()
+			job.ObjectKey, job.ExpiresAt = key, &expiresAt
+
+			if dbUser.Email != "" {
+				link, err := mp.PresignedURL(ctx, cfg.PrivacyExportBucket, key, ttl)
+				if err != nil {
+					return err
+				}
+				email, name := dbUser.Email, dbUser.Name
+				go func() {
+					if err := utils.SendDataExportEmail(email, name, link, cfg.PrivacyExportLinkHours); err != nil {
+						logs.Error(ctx, "failed to send data export email", "user_id", uid, "error", err.Error())
+					}
+				}()
+			}
+			return nil
+		}},
+	}
+}
+
+// erasureSteps removes or anonymises the user's data store by store. The
+// account row goes last so the job stays visible to its owner until the end.
+func (u *User) erasureSteps(dbUser *db.User) []privacyStep {
+	uid := dbUser.ID
+	userID := strconv.FormatInt(uid, 10)
+
+	return []privacyStep{
+		{"files", func(ctx context.Context) error {
+			mp := minioStore()
+			if mp == nil {
+				return fmt.Errorf("%w: minio", ErrStoreUnavailable)
+			}
+			if _, err := mp.RemovePrefix(ctx, cfg.UploadsBucket, uploadsPrefix(uid)); err != nil {
+				return err
+			}
+			// Earlier exports are copies of the same personal data
+			_, err := mp.RemovePrefix(ctx, cfg.PrivacyExportBucket, exportsPrefix(uid))
+			return err
+		}},
+		{"activity", func(ctx context.Context) error {
+			mp := mongoStore()
+			if mp == nil {
+				return fmt.Errorf("%w: mongo", ErrStoreUnavailable)
+			}
+			if _, err := mp.DeleteUserActivity(ctx, uid); err != nil {
+				return err
+			}
+			// The audit trail stays, without the user's network details
+			return mp.ScrubEventLogs(ctx, auditServiceName, uid, "ip_address", "user_agent")
+		}},
+		{"cart", func(ctx context.Context) error {
+			// Orders are financial records and are kept; they hold no personal
+			// data beyond the user ID, which stops resolving to a person below
+			od := ordersStore()
+			if od == nil {
+				return fmt.Errorf("%w: orders", ErrStoreUnavailable)
+			}
+			return od.DeleteCartItems(ctx, uid)
+		}},
+		{"account", func(ctx context.Context) error {
+			if err := u.DB.ScrubSecurityEvents(ctx, uid); err != nil {
+				return err
+			}
+			if err := u.DB.AnonymizeUser(ctx, uid); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+				return err
+			}
+			revokeAccessTokens(ctx, userID, "")
+			u.recordProfileEvent(ctx, uid, EventAccountDeleted, nil)
+
+			if dbUser.Email != "" {
+				u.clearLoginFailures(ctx, dbUser.Email)
+				email, name := dbUser.Email, dbUser.Name
+				go func() {
+					if err := utils.SendErasureConfirmation(email, name); err != nil {
+						logs.Error(ctx, "failed to send erasure confirmation", "user_id", uid, "error", err.Error())
+					}
+				}()
+			}
+			logs.Warning(ctx, "account erased on request", "user_id", userID)
+			return nil
+		}},
+	}
+}
+
+// exportArchive collects the files of a data export before they are zipped
+type exportArchive struct {
+	files         []exportFile
+	uploads       []string // keys in the uploads bucket
+	uploadsPrefix string
+}
+
+type exportFile struct {
+	name string
+	data any
+}
+
+func (a *exportArchive) add(name string, data any) {
+	a.files = append(a.files, exportFile{name: name, data: data})
+}
+
+// store zips the JSON files and uploads into a temp file and puts it in the export bucket
+func (a *exportArchive) store(ctx context.Context, mp *db.MinioProvider, key string) error {
+	tmp, err := os.CreateTemp("", "export-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	zw := zip.NewWriter(tmp)
+	for _, f := range a.files {
+		w, err := zw.Create(f.name)
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(f.data); err != nil {
+			return err
+		}
+	}
+	for _, k := range a.uploads {
+		w, err := zw.Create("uploads/" + strings.TrimPrefix(k, a.uploadsPrefix))
+		if err != nil {
+			return err
+		}
+		r, err := mp.GetObject(ctx, cfg.UploadsBucket, k)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(w, r)
+		r.Close()
+		if err != nil {
+			return err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := mp.EnsureBucket(ctx, cfg.PrivacyExportBucket); err != nil {
+		return err
+	}
+	return mp.PutObject(ctx, cfg.PrivacyExportBucket, key, tmp, size, "application/zip")
+}
+
+// privacyJobModel maps a job to the API model, signing the download link while it is valid
+func (u *User) privacyJobModel(ctx context.Context, job *db.PrivacyJob) *models.PrivacyJob {
+	if job == nil {
+		return nil
+	}
+	id, kind, status, progress := job.ID, job.Kind, job.Status, int32(job.Progress)
+	out := &models.PrivacyJob{
+		ID:        &id,
+		Kind:      &kind,
+		Status:    &status,
+		Progress:  &progress,
+		Step:      job.Step,
+		Error:     job.Error,
+		CreatedAt: strfmt.DateTime(job.CreatedAt),
+		UpdatedAt: strfmt.DateTime(job.UpdatedAt),
+	}
+	if job.CompletedAt != nil {
+		out.CompletedAt = strfmt.DateTime(*job.CompletedAt)
+	}
+	if job.ExpiresAt != nil {
+		out.ExpiresAt = strfmt.DateTime(*job.ExpiresAt)
+		if ttl := time.Until(*job.ExpiresAt); job.ObjectKey != "" && ttl > 0 {
+			if mp := minioStore(); mp != nil {
+				if link, err := mp.PresignedURL(ctx, cfg.PrivacyExportBucket, job.ObjectKey, ttl); err == nil {
+					out.DownloadURL = link
+				}
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,332 @@
+package users
+
+import (
+	auth "Adornme/Auth"
+	db "Adornme/databases"
+	"Adornme/internal/otp"
+	"Adornme/models"
+	"Adornme/utils"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Profile update errors
+var (
+	ErrInvalidProfileUpdate = errors.New("invalid profile update")
+	ErrWrongPassword        = errors.New("current password is incorrect")
+	ErrNoPendingChange      = errors.New("no pending change to confirm")
+)
+
+// Security event types (profile)
+const (
+	EventPasswordChanged = "password_changed"
+	EventEmailChanged    = "email_changed"
+	EventPhoneChanged    = "phone_changed"
+)
+
+const (
+	contactKindEmail = "email"
+	contactKindPhone = "phone"
+	contactChangeTTL = 24 * time.Hour
+)
+
+// UpdateProfile applies a partial update of the caller's own profile.
+// The name changes at once; a new email or phone is held as a pending change
+// until confirmed; a new password needs the current one and signs every other
+// session out.
+func (u *User) UpdateProfile(ctx context.Context, userID string, sessionID string, req *models.UserUpdateRequest) (*models.User, error) {
+	logs.Infof(ctx, "UpdateProfile called with requestID: %s, userID: %s", u.RequestID, userID)
+
+	dbUser, err := u.loadActiveUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🔹 1. Validate everything before changing anything
+	name := strings.TrimSpace(req.Name)
+
+	email := ""
+	if req.Email != "" {
+		email = utils.NormalizeEmail(req.Email.String())
+		if email == dbUser.Email {
+			email = ""
+		} else if other, err := u.DB.GetUserByEmail(ctx, email); err == nil && other.ID != dbUser.ID {
+			return nil, ErrContactInUse
+		}
+	}
+
+	phone := ""
+	if req.Phone != "" {
+		var err error
+		if phone, err = normalizePhone(req.Phone); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidProfileUpdate, err)
+		}
+		if phone == dbUser.Phone {
+			phone = ""
+		} else if other, err := u.DB.GetUserByPhone(ctx, phone); err == nil && other.ID != dbUser.ID {
+			return nil, ErrContactInUse
+		}
+	}
+
+	if req.NewPassword != "" {
+		// Phone-only accounts have no password yet and may set one
+		if dbUser.Password != "" {
+			if req.CurrentPassword == "" {
+				return nil, fmt.Errorf("%w: currentPassword is required to change the password", ErrInvalidProfileUpdate)
+			}
+			if err := bcrypt.CompareHashAndPassword([]byte(dbUser.Password), []byte(req.CurrentPassword)); err != nil {
+				return nil, ErrWrongPassword
+			}
+		}
+		if err := auth.ValidatePassword(req.NewPassword, dbUser.Email, dbUser.Name, email, name); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrWeakPassword, err)
+		}
+	}
+
+	// 🔹 2. Name
+	if name != "" && name != dbUser.Name {
+		if err := u.DB.UpdateUserName(ctx, dbUser.ID, name); err != nil {
+			return nil, errors.New("failed to update profile")
+		}
+		dbUser.Name = name
+	}
+
+	// 🔹 3. Contacts: pending until confirmed
+	if email != "" {
+		if err := u.startEmailChange(ctx, dbUser, email); err != nil {
+			return nil, err
+		}
+	}
+	if phone != "" {
+		if err := u.startPhoneChange(ctx, dbUser, phone); err != nil {
+			return nil, err
+		}
+	}
+
+	// 🔹 4. Password
+	if req.NewPassword != "" {
+		if err := u.changePassword(ctx, dbUser, sessionID, req.NewPassword); err != nil {
+			return nil, err
+		}
+	}
+
+	logs.Infof(ctx, "profile updated | user_id=%s", userID)
+	return u.profileModel(ctx, dbUser), nil
+}
+
+// ConfirmEmailChange switches the account to the new email once the link sent to it is opened
+func (u *User) ConfirmEmailChange(ctx context.Context, token string) error {
+	c, err := u.DB.ApplyEmailChange(ctx, auth.HashToken(strings.TrimSpace(token)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidVerificationToken
+		}
+		if errors.Is(err, db.ErrContactTaken) {
+			return ErrContactInUse
+		}
+		logs.Errorf(ctx, "EMAIL CHANGE FAILED: %v", err)
+		return errors.New("failed to change email")
+	}
+
+	u.recordProfileEvent(ctx, c.UserID, EventEmailChanged, nil)
+	logs.Infof(ctx, "email changed | user_id=%d", c.UserID)
+	return nil
+}
+
+// ConfirmPhoneChange switches the account to the pending phone with the code sent to it
+func (u *User) ConfirmPhoneChange(ctx context.Context, userID string, code string) (*models.User, error) {
+	dbUser, err := u.loadActiveUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	pending := u.pendingChange(ctx, dbUser.ID, contactKindPhone)
+	if pending == nil {
+		return nil, ErrNoPendingChange
+	}
+
+	svc, err := otpService()
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.VerifyCode(ctx, pending.NewValue, "phone", otp.PurposeChange, strings.TrimSpace(code)); err != nil {
+		return nil, err
+	}
+
+	c, err := u.DB.ApplyPhoneChange(ctx, dbUser.ID, pending.NewValue)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNoPendingChange
+		}
+		if errors.Is(err, db.ErrContactTaken) {
+			return nil, ErrContactInUse
+		}
+		return nil, errors.New("failed to change phone")
+	}
+
+	u.recordProfileEvent(ctx, dbUser.ID, EventPhoneChanged, map[string]any{"from": dbUser.Phone})
+	logs.Infof(ctx, "phone changed | user_id=%d", dbUser.ID)
+
+	dbUser.Phone, dbUser.PhoneVerified = c.NewValue, true
+	return u.profileModel(ctx, dbUser), nil
+}
+
+// startEmailChange parks the new email and mails a confirmation link to it;
+// the current address gets a heads-up.
+func (u *User) startEmailChange(ctx context.Context, dbUser *db.User, email string) error {
+	rawToken := uuid.New().String()
+	err := u.DB.SaveContactChange(ctx, &db.ContactChange{
+		UserID:    dbUser.ID,
+		Kind:      contactKindEmail,
+		NewValue:  email,
+		TokenHash: auth.HashToken(rawToken),
+		Expiry:    time.Now().Add(contactChangeTTL),
+	})
+	if err != nil {
+		return errors.New("failed to start email change")
+	}
+
+	link := fmt.Sprintf("http://localhost:3000/confirm-email-change?token=%s", rawToken)
+	oldEmail, name := dbUser.Email, dbUser.Name
+	go func() {
+		if err := utils.SendEmailChangeEmail(email, name, link); err != nil {
+			logs.Error(ctx, "failed to send email change link", "user_id", dbUser.ID, "error", err.Error())
+			return
+		}
+		if oldEmail != "" {
+			notice := fmt.Sprintf("Someone asked to change the email of your account to %s.", email)
+			if err := utils.SendSecurityNotice(oldEmail, name, notice); err != nil {
+				logs.Error(ctx, "failed to send email change notice", "user_id", dbUser.ID, "error", err.Error())
+			}
+		}
+	}()
+
+	logs.Infof(ctx, "email change pending | user_id=%d", dbUser.ID)
+	return nil
+}
+
+// startPhoneChange parks the new phone and texts a code to it
+func (u *User) startPhoneChange(ctx context.Context, dbUser *db.User, phone string) error {
+	err := u.DB.SaveContactChange(ctx, &db.ContactChange{
+		UserID:   dbUser.ID,
+		Kind:     contactKindPhone,
+		NewValue: phone,
+		Expiry:   time.Now().Add(contactChangeTTL),
+	})
+	if err != nil {
+		return errors.New("failed to start phone change")
+	}
+
+	svc, err := otpService()
+	if err != nil {
+		return err
+	}
+	if err := svc.SendCode(ctx, phone, "phone", otp.PurposeChange); err != nil {
+		return err
+	}
+
+	logs.Infof(ctx, "phone change pending | user_id=%d", dbUser.ID)
+	return nil
+}
+
+// changePassword stores the new hash and signs out every session but the current one
+func (u *User) changePassword(ctx context.Context, dbUser *db.User, sessionID string, newPassword string) error {
+	hashed, err := auth.HashPassword(newPassword)
+	if err != nil {
+		return errors.New("failed to hash password")
+	}
+	if err := u.DB.UpdatePassword(ctx, dbUser.ID, hashed); err != nil {
+		return errors.New("failed to change password")
+	}
+
+	// Denylist the live access tokens of the other sessions before revoking them
+	userID := fmt.Sprintf("%d", dbUser.ID)
+	if sessions, err := u.DB.ListActiveSessions(ctx, userID); err == nil {
+		for _, s := range sessions {
+			if s.ID != sessionID {
+				revokeAccessTokens(ctx, userID, s.ID)
+			}
+		}
+	}
+	if err := u.DB.RevokeAllSessions(ctx, userID, sessionID); err != nil {
+		logs.Errorf(ctx, "FAILED TO REVOKE OTHER SESSIONS: user=%d, err=%v", dbUser.ID, err)
+	}
+
+	u.recordProfileEvent(ctx, dbUser.ID, EventPasswordChanged, nil)
+	if dbUser.Email != "" {
+		email, name := dbUser.Email, dbUser.Name
+		go func() {
+			if err := utils.SendSecurityNotice(email, name, "The password of your account was changed and your other devices were signed out."); err != nil {
+				logs.Error(ctx, "failed to send password change notice", "user_id", dbUser.ID, "error", err.Error())
+			}
+		}()
+	}
+
+	logs.Infof(ctx, "password changed | user_id=%d", dbUser.ID)
+	return nil
+}
+
+func (u *User) pendingChange(ctx context.Context, userID int64, kind string) *db.ContactChange {
+	changes, err := u.DB.GetContactChanges(ctx, userID)
+	if err != nil {
+		return nil
+	}
+	for i := range changes {
+		if changes[i].Kind == kind {
+			return &changes[i]
+		}
+	}
+	return nil
+}
+
+// profileModel maps the user to the API model, including pending contact changes
+func (u *User) profileModel(ctx context.Context, dbUser *db.User) *models.User {
+	id, name := dbUser.ID, dbUser.Name
+	out := &models.User{
+		ID:            &id,
+		Name:          &name,
+		Phone:         dbUser.Phone,
+		PhoneCountry:  phoneCountry(dbUser.Phone),
+		EmailVerified: dbUser.EmailVerified,
+		PhoneVerified: dbUser.PhoneVerified,
+	}
+	if dbUser.Email != "" {
+		e := strfmt.Email(dbUser.Email)
+		out.Email = &e
+	}
+
+	changes, _ := u.DB.GetContactChanges(ctx, dbUser.ID)
+	for _, c := range changes {
+		switch c.Kind {
+		case contactKindEmail:
+			out.PendingEmail = strfmt.Email(c.NewValue)
+		case contactKindPhone:
+			out.PendingPhone = c.NewValue
+		}
+	}
+	return out
+}
+
+func (u *User) recordProfileEvent(ctx context.Context, userID int64, eventType string, details map[string]any) {
+	if details == nil {
+		details = map[string]any{}
+	}
+	details["request_id"] = u.RequestID
+
+	userAgent, ip := utils.ClientInfoFromContext(ctx)
+	_ = u.DB.RecordSecurityEvent(ctx, &db.SecurityEvent{
+		UserID:    userID,
+		EventType: eventType,
+		IPAddress: ip,
+		UserAgent: userAgent,
+		Details:   details,
+		CreatedAt: time.Now().UTC(),
+	})
+}
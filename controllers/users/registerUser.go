@@ -1,51 +1,81 @@
 package users
 
 import (
-	Auth "Adornme/Auth"
 	auth "Adornme/Auth"
 	db "Adornme/databases"
 	"Adornme/logging"
 	"Adornme/models"
 	"Adornme/utils"
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
 	"errors"
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"golang.org/x/crypto/bcrypt"
 )
 
 var logs = logging.Component("users")
 
-func (u *User) RegisterUser(ctx context.Context, params *models.RegisterRequest) (*models.AuthResponse, *models.ErrorResponse) {
+// ErrInvalidResetToken is returned when a reset token is unknown, expired or already used.
+var ErrInvalidResetToken = errors.New("invalid or expired reset token")
+
+// ErrWeakPassword wraps password policy violations.
+var ErrWeakPassword = errors.New("password does not meet policy")
+
+// ErrInvalidRegistration wraps missing or malformed sign-up fields.
+var ErrInvalidRegistration = errors.New("invalid registration")
+
+// RegisterUser creates an account. Email sign-ups need a password; phone
+// sign-ups may instead prove the number with a code from SendOTP and get a
+// passwordless account that logs in by OTP.
+func (u *User) RegisterUser(ctx context.Context, params *models.RegisterRequest) (*models.AuthResponse, error) {
 	logs.Infof(ctx, "Register User called with requestID: %s", u.RequestID)
 
-	// Extract and trim request fields
-	email := ""
+	// Extract and normalise request fields
 	name := ""
-	phone := ""
-
-	if params.Email != nil {
-		email = strings.TrimSpace(params.Email.String())
-	}
 	if params.Name != nil {
 		name = strings.TrimSpace(*params.Name)
 	}
+	email := utils.NormalizeEmail(params.Email.String())
+	phone := ""
 	if params.Phone != "" {
-		phone = strings.TrimSpace(params.Phone)
+		var err error
+		if phone, err = normalizePhone(params.Phone); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRegistration, err)
+		}
+	}
+	code := strings.TrimSpace(params.Otp)
+
+	switch {
+	case name == "":
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidRegistration)
+	case params.Password == "" && code == "":
+		return nil, fmt.Errorf("%w: password, or phone and otp, is required", ErrInvalidRegistration)
+	case params.Password != "" && email == "":
+		return nil, fmt.Errorf("%w: email is required with a password", ErrInvalidRegistration)
+	case code != "" && phone == "":
+		return nil, fmt.Errorf("%w: phone is required with an otp", ErrInvalidRegistration)
+	}
+	if params.Password != "" {
+		if err := auth.ValidatePassword(params.Password, email, name); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrWeakPassword, err)
+		}
 	}
 
-	// Hash password
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(*params.Password), bcrypt.DefaultCost)
-	if err != nil {
-		msg := "failed to hash password"
-		return nil, &models.ErrorResponse{Error: &msg}
+	// Refuse contacts that are taken before spending the code
+	if email != "" {
+		if _, err := u.DB.GetUserByEmail(ctx, email); err == nil {
+			return nil, ErrContactInUse
+		}
+	}
+	if phone != "" {
+		if _, err := u.DB.GetUserByPhone(ctx, phone); err == nil {
+			return nil, ErrContactInUse
+		}
 	}
 
 	// Build DB user
@@ -55,50 +85,63 @@ func (u *User) RegisterUser(ctx context.Context, params *models.RegisterRequest)
 		Email:     email,
 		Name:      name,
 		Phone:     phone,
-		Password:  string(hashedPassword),
+	}
+
+	// Phone sign-up: the code proves the number
+	if code != "" {
+		svc, err := otpService()
+		if err != nil {
+			logs.Errorf(ctx, "REGISTER OTP CHECK FAILED: %v", err)
+			return nil, err
+		}
+		if err := svc.VerifyOTP(ctx, phone, "phone", code); err != nil {
+			logs.Warningf(ctx, "registration otp rejected | err=%v", err)
+			return nil, err
+		}
+		dbUser.PhoneVerified = true
+	}
+
+	// Hash password (phone sign-ups may have none)
+	if params.Password != "" {
+		hashedPassword, err := auth.HashPassword(params.Password)
+		if err != nil {
+			return nil, errors.New("failed to hash password")
+		}
+		dbUser.Password = hashedPassword
 	}
 
 	// Create user in DB
 	id, err := u.DB.CreateUser(ctx, dbUser)
 	if err != nil {
-		msg := err.Error()
+		if errors.Is(err, db.ErrContactTaken) {
+			return nil, ErrContactInUse
+		}
 		logs.Errorf(ctx, "failed to create user: %v", err)
-		return nil, &models.ErrorResponse{Error: &msg}
+		return nil, errors.New("failed to create user")
 	}
 
 	userID := fmt.Sprintf("%d", id) // convert to string for JWT
 
-	// Generate JWT tokens
-	accessToken, err := Auth.GenerateToken(userID) // 24h expiry
-	if err != nil {
-		msg := "failed to generate access token"
-		return nil, &models.ErrorResponse{Error: &msg}
+	// Every new account starts as a customer
+	if err := u.DB.AssignRole(ctx, userID, auth.RoleCustomer); err != nil {
+		logs.Errorf(ctx, "failed to assign default role for user %d: %v", id, err)
 	}
+	roles := []string{auth.RoleCustomer}
+
+	// Send verification link / code for the new contacts
+	dbUser.ID = int64(id)
+	u.startContactVerification(ctx, dbUser)
 
-	refreshToken, err := Auth.GenerateRefreshToken(userID) // 7-day expiry
+	// Generate JWT tokens for a new device session
+	accessToken, refreshToken, err := u.startSession(ctx, userID, roles, false)
 	if err != nil {
-		msg := "failed to generate refresh token"
-		return nil, &models.ErrorResponse{Error: &msg}
+		return nil, err
 	}
 
-	dbUser.RefreshToken = &refreshToken
-	if err := u.DB.UpdateUserTokens(ctx, id, refreshToken, accessToken); err != nil {
-		logs.Errorf(ctx, "failed to save tokens for user %d: %v", id, err)
-	}
+	logs.Infof(ctx, "user registered | user_id=%s passwordless=%t", userID, dbUser.Password == "")
 
 	// Build API response
-	Id := int64(id)
-	emailStr := strfmt.Email(email)
-	return &models.AuthResponse{
-		User: &models.User{
-			ID:    &Id,
-			Name:  &name,
-			Phone: phone,
-			Email: &emailStr,
-		},
-		Token:        &accessToken,
-		RefreshToken: refreshToken,
-	}, nil
+	return authResponse(dbUser, accessToken, refreshToken), nil
 }
 
 // GetUser retrieves a user by ID
@@ -112,23 +155,13 @@ func (u *User) GetUser(ctx context.Context, userID int64) (*models.User, *models
 		return nil, &models.ErrorResponse{Error: &msg}
 	}
 
-	var email *strfmt.Email
-	if dbUser.Email != "" {
-		e := strfmt.Email(dbUser.Email)
-		email = &e
-	}
 	if dbUser == nil {
 		msg := "user not found"
 		return nil, &models.ErrorResponse{Error: &msg}
 	}
 
-	// Map DB user → API model
-	return &models.User{
-		ID:    &dbUser.ID,
-		Name:  &dbUser.Name,
-		Email: email,
-		Phone: dbUser.Phone,
-	}, nil
+	// Map DB user → API model (with any pending email / phone change)
+	return u.profileModel(ctx, dbUser), nil
 }
 
 func (u *User) GetUserByEmail(ctx context.Context, Email strfmt.Email) (*db.User, *models.ErrorResponse) {
@@ -144,7 +177,7 @@ func (u *User) GetUserByEmail(ctx context.Context, Email strfmt.Email) (*db.User
 }
 
 func (u *User) RefreshToken(ctx context.Context, token string) (*models.AuthResponse, *models.ErrorResponse) {
-	logs.Infof(ctx, "RefreshToken called with requestID: %s:---->%s", u.RequestID, token)
+	logs.Infof(ctx, "RefreshToken called with requestID: %s", u.RequestID)
 
 	// 1️⃣ Validate input
 	token = strings.TrimSpace(token)
@@ -153,45 +186,30 @@ func (u *User) RefreshToken(ctx context.Context, token string) (*models.AuthResp
 		return nil, &models.ErrorResponse{Error: &msg}
 	}
 
-	// 2️⃣ Validate JWT
-	userId, err := auth.ValidateRefreshToken(token)
+	// 2️⃣ Validate JWT + session (reuse of a rotated token revokes the family)
+	session, claims, err := checkRefreshToken(ctx, &u.DB, u.RequestID, token)
 	if err != nil {
-		logs.Errorf(ctx, "INVALID REFRESH TOKEN: %v", err)
-
-		msg := "invalid or expired token"
+		msg := err.Error()
 		return nil, &models.ErrorResponse{Error: &msg}
 	}
+	userId := claims.UserID
 
 	logs.Infof(ctx, "RefreshToken validated for userID: %s", userId)
 
-	// 3️⃣ Convert userID → int
-	userIDI, err := strconv.Atoi(userId)
-	if err != nil {
-		logs.Errorf(ctx, "INVALID USER ID FORMAT: %v", err)
-
-		msg := "invalid user id"
-		return nil, &models.ErrorResponse{Error: &msg}
-	}
-
-	// 4️⃣ Fetch token from DB
-	storedToken, err := u.DB.GetRefreshToken(ctx, userId)
-	if err != nil {
-		logs.Errorf(ctx, "FAILED TO FETCH REFRESH TOKEN: userID=%d, err=%v", userIDI, err)
-
-		msg := "token not found"
-		return nil, &models.ErrorResponse{Error: &msg}
-	}
-
-	// 5️⃣ Compare tokens
-	if storedToken != token {
-		logs.Errorf(ctx, "TOKEN MISMATCH: userID=%d", userIDI)
-
-		msg := "invalid refresh token"
+	// 6️⃣ Generate new tokens (roles re-read so grants/revocations apply).
+	// Staff roles need a session signed in with a second factor: one opened
+	// before a promotion must not be refreshed into staff access.
+	roles := u.userRoles(ctx, userId)
+	if auth.RequiresMFA(roles) && !session.MFA {
+		logs.Warningf(ctx, "refresh refused, session without mfa | user_id=%s session_id=%s", userId, session.ID)
+		if err := u.DB.RevokeSession(ctx, userId, session.ID); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			logs.Errorf(ctx, "FAILED TO REVOKE SESSION: userID=%s, sessionID=%s, err=%v", userId, session.ID, err)
+		}
+		msg := ErrMFAMandatory.Error()
 		return nil, &models.ErrorResponse{Error: &msg}
 	}
 
-	// 6️⃣ Generate new tokens
-	newAccessToken, err := auth.GenerateToken(userId)
+	newAccessToken, newAccessJTI, err := auth.GenerateToken(userId, session.ID, roles)
 	if err != nil {
 		logs.Errorf(ctx, "FAILED TO GENERATE ACCESS TOKEN: userID=%s, err=%v", userId, err)
 
@@ -199,7 +217,7 @@ func (u *User) RefreshToken(ctx context.Context, token string) (*models.AuthResp
 		return nil, &models.ErrorResponse{Error: &msg}
 	}
 
-	newRefreshToken, err := auth.GenerateRefreshToken(userId)
+	newRefreshToken, newJTI, err := auth.GenerateRefreshToken(userId, session.ID, session.FamilyID)
 	if err != nil {
 		logs.Errorf(ctx, "FAILED TO GENERATE REFRESH TOKEN: userID=%s, err=%v", userId, err)
 
@@ -207,14 +225,21 @@ func (u *User) RefreshToken(ctx context.Context, token string) (*models.AuthResp
 		return nil, &models.ErrorResponse{Error: &msg}
 	}
 
-	// 7️⃣ Update DB (rotation)
-	if err := u.DB.UpdateUserTokens(ctx, userIDI, newRefreshToken, newAccessToken); err != nil {
-		logs.Errorf(ctx, "FAILED TO UPDATE REFRESH TOKEN: userID=%d, err=%v", userIDI, err)
+	// 7️⃣ Update session (rotation)
+	_, ip := utils.ClientInfoFromContext(ctx)
+	if ip == "" {
+		ip = session.IPAddress
+	}
+	expiry := time.Now().UTC().Add(auth.RefreshTokenTTL())
+	if err := u.DB.RotateSession(ctx, session.ID, claims.ID, newJTI, auth.HashToken(newRefreshToken), ip, expiry); err != nil {
+		logs.Errorf(ctx, "FAILED TO UPDATE REFRESH TOKEN: userID=%s, err=%v", userId, err)
 
 		msg := "failed to update refresh token"
 		return nil, &models.ErrorResponse{Error: &msg}
 	}
 
+	trackAccessToken(ctx, userId, session.ID, newAccessJTI)
+
 	logs.Infof(ctx, "RefreshToken success for userID: %s", userId)
 
 	// 8️⃣ Response (match your login/register format)
@@ -224,81 +249,113 @@ func (u *User) RefreshToken(ctx context.Context, token string) (*models.AuthResp
 	}, nil
 }
 
-func (u *User) Login(ctx context.Context, email *strfmt.Email, password string) (*models.AuthResponse, error) {
+// Login checks the password and starts a session, or returns an MFA challenge
+// when the account needs a second factor (see VerifyMFA).
+func (u *User) Login(ctx context.Context, email *strfmt.Email, password string) (*models.AuthResponse, *models.MFAChallenge, error) {
+
+	// 0. Refuse early while the account or client is locked out
+	if err := u.checkLoginAllowed(ctx, string(*email)); err != nil {
+		return nil, nil, err
+	}
 
 	// 1. Fetch user
 	dbUser, err := u.DB.GetUserByEmail(ctx, string(*email))
 	if err != nil {
-		return nil, errors.New("invalid email or password")
+		u.recordLoginFailure(ctx, string(*email), nil)
+		return nil, nil, errors.New("invalid email or password")
 	}
 
 	// 2. Compare password
 	if err := bcrypt.CompareHashAndPassword([]byte(dbUser.Password), []byte(password)); err != nil {
-		return nil, errors.New("invalid email or password")
+		u.recordLoginFailure(ctx, string(*email), dbUser)
+		return nil, nil, errors.New("invalid email or password")
 	}
+	u.clearLoginFailures(ctx, string(*email))
+	u.upgradePasswordHash(ctx, dbUser, password)
+
+	// Disabled by an admin (only revealed once the password matched)
+	if err := checkAccountActive(dbUser); err != nil {
+		return nil, nil, err
+	}
+
 	userID := fmt.Sprintf("%d", dbUser.ID)
-	// 3. Generate tokens
-	accessToken, err := auth.GenerateToken(userID)
-	if err != nil {
-		return nil, errors.New("failed to generate access token")
+	roles := u.userRoles(ctx, userID)
+
+	// 3. Staff and opted-in users finish at /auth/mfa/verify
+	challenge, err := u.mfaChallenge(ctx, dbUser, roles)
+	if err != nil || challenge != nil {
+		return nil, challenge, err
 	}
 
-	refreshToken, err := auth.GenerateRefreshToken(userID)
+	// 4. Generate tokens + 5. store them as a new device session (IMPORTANT)
+	accessToken, refreshToken, err := u.startSession(ctx, userID, roles, false)
 	if err != nil {
-		return nil, errors.New("failed to generate refresh token")
+		return nil, nil, err
 	}
 
-	// 4. Save refresh token (IMPORTANT)
-	err = u.DB.UpdateUserTokens(ctx, int(dbUser.ID), refreshToken, accessToken)
+	// 6. Build response
+	return authResponse(dbUser, accessToken, refreshToken), nil, nil
+}
+
+// upgradePasswordHash rehashes a just-verified password whose stored hash is
+// weaker than the current policy. A failure only means another try next login.
+func (u *User) upgradePasswordHash(ctx context.Context, dbUser *db.User, password string) {
+	if !auth.NeedsRehash(dbUser.Password) {
+		return
+	}
+	hashed, err := auth.HashPassword(password)
 	if err != nil {
-		return nil, errors.New("failed to store refresh token")
+		logs.Errorf(ctx, "FAILED TO REHASH PASSWORD: user=%d, err=%v", dbUser.ID, err)
+		return
 	}
+	if err := u.DB.UpdatePassword(ctx, dbUser.ID, hashed); err != nil {
+		return
+	}
+	dbUser.Password = hashed
+	logs.Infof(ctx, "password hash upgraded | user_id=%d", dbUser.ID)
+}
 
-	// 5. Build response
-	emailStr := strfmt.Email(dbUser.Email)
+// authResponse builds the login response for a freshly started session
+func authResponse(dbUser *db.User, accessToken, refreshToken string) *models.AuthResponse {
+	user := &models.User{
+		ID:            &dbUser.ID,
+		Name:          &dbUser.Name,
+		Phone:         dbUser.Phone,
+		PhoneCountry:  phoneCountry(dbUser.Phone),
+		EmailVerified: dbUser.EmailVerified,
+		PhoneVerified: dbUser.PhoneVerified,
+	}
+	if dbUser.Email != "" {
+		e := strfmt.Email(dbUser.Email)
+		user.Email = &e
+	}
 
 	return &models.AuthResponse{
-		User: &models.User{
-			ID:    &dbUser.ID,
-			Name:  &dbUser.Name,
-			Email: &emailStr,
-			Phone: dbUser.Phone,
-		},
+		User:         user,
 		Token:        &accessToken,
 		RefreshToken: refreshToken,
-	}, nil
+	}
 }
 
 func (u *User) Logout(ctx context.Context, refreshToken string) error {
 
-	// 🔹 1. Validate refresh token (JWT)
-	userID, err := auth.ValidateRefreshToken(refreshToken)
+	// 🔹 1. Validate refresh token (JWT) + 2. its session + 3. match token (CRITICAL SECURITY CHECK)
+	session, claims, err := checkRefreshToken(ctx, &u.DB, u.RequestID, refreshToken)
 	if err != nil {
-		logs.Errorf(ctx, "logout failed: invalid refresh token | err=%v", err)
-		return errors.New("invalid or expired refresh token")
+		logs.Errorf(ctx, "logout failed: %v", err)
+		return err
 	}
+	userID := claims.UserID
 
 	logs.Infof(ctx, "logout initiated for user_id=%s", userID)
 
-	// 🔹 2. Get stored token from DB
-	storedToken, err := u.DB.GetRefreshToken(ctx, userID)
-	if err != nil {
-		logs.Errorf(ctx, "logout failed: session not found | user_id=%s err=%v", userID, err)
-		return errors.New("session not found")
-	}
-
-	// 🔹 3. Match token (CRITICAL SECURITY CHECK)
-	if storedToken != refreshToken {
-		logs.Warning(ctx, "logout failed: token mismatch | user_id=%s", userID)
-		return errors.New("invalid session or token mismatch")
-	}
-
-	// 🔹 4. Delete / invalidate refresh token
-	err = u.DB.DeleteRefreshToken(ctx, userID)
+	// 🔹 4. Revoke only this session (other devices stay signed in)
+	err = u.DB.RevokeSession(ctx, userID, session.ID)
 	if err != nil {
-		logs.Errorf(ctx, "logout failed: DB error while deleting token | user_id=%s err=%v", userID, err)
+		logs.Errorf(ctx, "logout failed: DB error while revoking session | user_id=%s err=%v", userID, err)
 		return errors.New("failed to logout")
 	}
+	revokeAccessTokens(ctx, userID, session.ID)
 
 	logs.Infof(ctx, "logout successful | user_id=%s", userID)
 
@@ -320,12 +377,17 @@ func (u *User) ForgetPassword(ctx context.Context, email string) error {
 
 	logs.Info(ctx, "user found", "user_id", user.ID)
 
+	// 🔹 Verified-contact rule: never send a reset link to an unconfirmed address
+	if cfg.RequireVerifiedForPasswordReset && !user.EmailVerified {
+		logs.Info(ctx, "email not verified, reset link not sent (safe ignore)", "user_id", user.ID)
+		return nil
+	}
+
 	// 🔹 2. Generate raw token
 	rawToken := uuid.New().String()
 
 	// 🔹 3. Hash token
-	hash := sha256.Sum256([]byte(rawToken))
-	hashedToken := hex.EncodeToString(hash[:])
+	hashedToken := auth.HashToken(rawToken)
 
 	// 🔹 4. Expiry (15 min)
 	expiry := time.Now().Add(15 * time.Minute)
@@ -371,46 +433,70 @@ func (u *User) ForgetPassword(ctx context.Context, email string) error {
 	return nil
 }
 
-func (u *User) IdentifyUser(ctx context.Context, identifier string) error {
-	identifier = strings.TrimSpace(identifier)
+// ResetPassword sets a new password using a token issued by ForgetPassword.
+// The token is single use; on success every session of the user is signed out.
+func (u *User) ResetPassword(ctx context.Context, token string, newPassword string) error {
 
-	// 🔍 Email case
-	if utils.IsEmail(identifier) {
-		identifier = utils.NormalizeEmail(identifier)
-		return nil
+	logs.Info(ctx, "ResetPassword service started")
+
+	// 🔹 1. Password policy (the token names the user whose details it may not contain)
+	tokenHash := auth.HashToken(strings.TrimSpace(token))
+	dbUser, err := u.DB.GetUserByResetToken(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logs.Info(ctx, "reset token invalid, expired or already used")
+			return ErrInvalidResetToken
+		}
+		logs.Error(ctx, "failed to look up reset token", "error", err.Error())
+		return errors.New("failed to reset password")
+	}
+	if err := auth.ValidatePassword(newPassword, dbUser.Email, dbUser.Name); err != nil {
+		return fmt.Errorf("%w: %v", ErrWeakPassword, err)
 	}
 
-	// 📱 Phone case
-	if utils.IsPhone(identifier) {
-		identifier = utils.NormalizeEmail(identifier)
+	// 🔹 2. Hash new password
+	hashedPassword, err := auth.HashPassword(newPassword)
+	if err != nil {
+		logs.Error(ctx, "failed to hash password", "error", err.Error())
+		return errors.New("failed to reset password")
+	}
 
-		if !utils.IsValidIndianPhone(identifier) {
-			return fmt.Errorf("invalid phone number")
+	// 🔹 3. Consume token + update password + revoke sessions (one transaction)
+	userID, err := u.DB.ResetPassword(ctx, tokenHash, hashedPassword)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logs.Info(ctx, "reset token invalid, expired or already used")
+			return ErrInvalidResetToken
 		}
-		return nil
+		logs.Error(ctx, "failed to reset password", "error", err.Error())
+		return errors.New("failed to reset password")
 	}
 
-	return fmt.Errorf("invalid identifier")
-}
+	// 🔹 4. Access tokens issued before the reset stop working now, not at expiry
+	revokeAccessTokens(ctx, fmt.Sprintf("%d", userID), "")
 
-func (u *User) SendOTP(ctx context.Context, identifier string) error {
-	identifier = strings.TrimSpace(identifier)
+	// 🔹 5. Audit
+	userAgent, ip := utils.ClientInfoFromContext(ctx)
+	_ = u.DB.RecordSecurityEvent(ctx, &db.SecurityEvent{
+		UserID:    userID,
+		EventType: EventPasswordReset,
+		IPAddress: ip,
+		UserAgent: userAgent,
+		Details:   map[string]any{"request_id": u.RequestID},
+		CreatedAt: time.Now().UTC(),
+	})
 
-	// 🔍 Email case
-	if utils.IsEmail(identifier) {
-		identifier = utils.NormalizeEmail(identifier)
-		return nil
-	}
+	logs.Info(ctx, "password reset, all sessions revoked", "user_id", userID)
 
-	// 📱 Phone case
-	if utils.IsPhone(identifier) {
-		identifier = utils.NormalizeEmail(identifier)
+	return nil
+}
 
-		if !utils.IsValidIndianPhone(identifier) {
-			return fmt.Errorf("invalid phone number")
-		}
-		return nil
+func (u *User) IdentifyUser(ctx context.Context, identifier string) error {
+	if err := u.throttleClient(ctx, "identify"); err != nil {
+		return err
 	}
 
-	return fmt.Errorf("invalid identifier")
+	// 🔍 Email, or 📱 phone in any format we can bring to E.164
+	_, _, err := parseIdentifier(identifier)
+	return err
 }
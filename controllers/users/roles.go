@@ -0,0 +1,19 @@
+package users
+
+import (
+	auth "Adornme/Auth"
+	"context"
+)
+
+// userRoles loads the roles granted to a user, falling back to customer
+// when none are stored (accounts created before roles existed).
+func (u *User) userRoles(ctx context.Context, userID string) []string {
+	roles, err := u.DB.GetUserRoles(ctx, userID)
+	if err != nil || len(roles) == 0 {
+		if err != nil {
+			logs.Errorf(ctx, "failed to load roles for user %s: %v", userID, err)
+		}
+		return []string{auth.RoleCustomer}
+	}
+	return roles
+}
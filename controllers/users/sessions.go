@@ -0,0 +1,200 @@
+package users
+
+import (
+	auth "Adornme/Auth"
+	db "Adornme/databases"
+	"Adornme/models"
+	"Adornme/utils"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrSessionNotFound is returned when a session does not exist, is not owned
+// by the caller or was already revoked.
+var ErrSessionNotFound = errors.New("session not found")
+
+// ErrUserNotFound is returned when a user ID does not match any account.
+var ErrUserNotFound = errors.New("user not found")
+
+// ErrRefreshTokenReused is returned when an already rotated refresh token is presented.
+// The whole token family is revoked when this happens.
+var ErrRefreshTokenReused = errors.New("refresh token reuse detected")
+
+// Security event types
+const (
+	EventRefreshTokenReuse = "refresh_token_reuse"
+	EventPasswordReset     = "password_reset"
+)
+
+// startSession opens a new device session for the user and issues its token pair.
+// mfa records that the sign-in passed a second factor, which staff need to refresh.
+func (u *User) startSession(ctx context.Context, userID string, roles []string, mfa bool) (accessToken string, refreshToken string, err error) {
+	uid, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid user id: %w", err)
+	}
+
+	sessionID := uuid.New().String()
+	familyID := uuid.New().String()
+
+	refreshToken, jti, err := auth.GenerateRefreshToken(userID, sessionID, familyID)
+	if err != nil {
+		return "", "", errors.New("failed to generate refresh token")
+	}
+
+	accessToken, accessJTI, err := auth.GenerateToken(userID, sessionID, roles)
+	if err != nil {
+		return "", "", errors.New("failed to generate access token")
+	}
+
+	userAgent, ip := utils.ClientInfoFromContext(ctx)
+	now := time.Now().UTC()
+
+	err = u.DB.CreateSession(ctx, &db.Session{
+		ID:               sessionID,
+		UserID:           uid,
+		FamilyID:         familyID,
+		RefreshJTI:       jti,
+		RefreshTokenHash: auth.HashToken(refreshToken),
+		UserAgent:        userAgent,
+		IPAddress:        ip,
+		CreatedAt:        now,
+		ExpiresAt:        now.Add(auth.RefreshTokenTTL()),
+		MFA:              mfa,
+	})
+	if err != nil {
+		logs.Errorf(ctx, "failed to create session for user %s: %v", userID, err)
+		return "", "", errors.New("failed to store session")
+	}
+
+	trackAccessToken(ctx, userID, sessionID, accessJTI)
+
+	logs.Infof(ctx, "session started | user_id=%s session_id=%s", userID, sessionID)
+	return accessToken, refreshToken, nil
+}
+
+// sessionStore is the part of the database refresh-token checks use;
+// *db.PostgresProvider in production
+type sessionStore interface {
+	GetSession(ctx context.Context, id string) (*db.Session, error)
+	RevokeSessionFamily(ctx context.Context, familyID string) error
+	RecordSecurityEvent(ctx context.Context, e *db.SecurityEvent) error
+}
+
+// checkRefreshToken verifies a refresh token against its session.
+// A validly signed token whose JTI is no longer the session's current one was
+// already rotated, so someone is replaying it: the whole family is revoked and
+// a security event is written.
+func checkRefreshToken(ctx context.Context, store sessionStore, requestID string, token string) (*db.Session, *auth.AuthClaims, error) {
+	claims, err := auth.ParseRefreshToken(token)
+	if err != nil {
+		logs.Errorf(ctx, "INVALID REFRESH TOKEN: %v", err)
+		return nil, nil, errors.New("invalid or expired token")
+	}
+
+	session, err := store.GetSession(ctx, claims.SessionID)
+	if err != nil {
+		logs.Errorf(ctx, "FAILED TO FETCH SESSION: userID=%s, sessionID=%s, err=%v", claims.UserID, claims.SessionID, err)
+		return nil, nil, ErrSessionNotFound
+	}
+
+	if fmt.Sprintf("%d", session.UserID) != claims.UserID || session.FamilyID != claims.FamilyID {
+		logs.Errorf(ctx, "SESSION MISMATCH: userID=%s, sessionID=%s", claims.UserID, session.ID)
+		return nil, nil, errors.New("invalid refresh token")
+	}
+
+	if session.RevokedAt != nil || time.Now().After(session.ExpiresAt) {
+		logs.Errorf(ctx, "SESSION NOT ACTIVE: userID=%s, sessionID=%s", claims.UserID, session.ID)
+		return nil, nil, errors.New("session expired or revoked")
+	}
+
+	if claims.ID != session.RefreshJTI {
+		handleRefreshTokenReuse(ctx, store, requestID, session, claims)
+		return nil, nil, ErrRefreshTokenReused
+	}
+
+	if session.RefreshTokenHash != auth.HashToken(token) {
+		logs.Errorf(ctx, "TOKEN MISMATCH: userID=%s, sessionID=%s", claims.UserID, session.ID)
+		return nil, nil, errors.New("invalid refresh token")
+	}
+
+	return session, claims, nil
+}
+
+// handleRefreshTokenReuse revokes the token family and records the incident
+func handleRefreshTokenReuse(ctx context.Context, store sessionStore, requestID string, session *db.Session, claims *auth.AuthClaims) {
+	logs.Warningf(ctx, "REFRESH TOKEN REUSE: userID=%s, familyID=%s, jti=%s — revoking family", claims.UserID, session.FamilyID, claims.ID)
+
+	if err := store.RevokeSessionFamily(ctx, session.FamilyID); err != nil {
+		logs.Errorf(ctx, "FAILED TO REVOKE TOKEN FAMILY: familyID=%s, err=%v", session.FamilyID, err)
+	}
+	revokeAccessTokens(ctx, claims.UserID, session.ID)
+
+	userAgent, ip := utils.ClientInfoFromContext(ctx)
+	_ = store.RecordSecurityEvent(ctx, &db.SecurityEvent{
+		UserID:    session.UserID,
+		EventType: EventRefreshTokenReuse,
+		SessionID: session.ID,
+		IPAddress: ip,
+		UserAgent: userAgent,
+		Details: map[string]any{
+			"family_id":   session.FamilyID,
+			"reused_jti":  claims.ID,
+			"current_jti": session.RefreshJTI,
+			"request_id":  requestID,
+		},
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+// ListSessions returns the active sessions of a user, flagging the current one
+func (u *User) ListSessions(ctx context.Context, userID string, currentSessionID string) ([]*models.Session, *models.ErrorResponse) {
+	logs.Infof(ctx, "ListSessions called with requestID: %s, userID: %s", u.RequestID, userID)
+
+	sessions, err := u.DB.ListActiveSessions(ctx, userID)
+	if err != nil {
+		msg := "failed to list sessions"
+		return nil, &models.ErrorResponse{Error: &msg}
+	}
+
+	out := make([]*models.Session, 0, len(sessions))
+	for _, s := range sessions {
+		id := s.ID
+		out = append(out, &models.Session{
+			ID:         &id,
+			UserAgent:  s.UserAgent,
+			IPAddress:  s.IPAddress,
+			CreatedAt:  strfmt.DateTime(s.CreatedAt),
+			LastUsedAt: strfmt.DateTime(s.LastUsedAt),
+			Current:    s.ID == currentSessionID,
+		})
+	}
+	return out, nil
+}
+
+// RevokeSession signs a single device out
+func (u *User) RevokeSession(ctx context.Context, userID string, sessionID string) error {
+	logs.Infof(ctx, "RevokeSession called | user_id=%s session_id=%s", userID, sessionID)
+
+	if _, err := uuid.Parse(sessionID); err != nil {
+		return ErrSessionNotFound
+	}
+
+	if err := u.DB.RevokeSession(ctx, userID, sessionID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrSessionNotFound
+		}
+		return errors.New("failed to revoke session")
+	}
+	revokeAccessTokens(ctx, userID, sessionID)
+
+	logs.Infof(ctx, "session revoked | user_id=%s session_id=%s", userID, sessionID)
+	return nil
+}
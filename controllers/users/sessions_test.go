@@ -0,0 +1,210 @@
+package users
+
+import (
+	auth "Adornme/Auth"
+	db "Adornme/databases"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// memSessions is an in-memory sessionStore
+type memSessions struct {
+	sessions map[string]*db.Session
+	events   []*db.SecurityEvent
+}
+
+func newMemSessions() *memSessions {
+	return &memSessions{sessions: map[string]*db.Session{}}
+}
+
+func (m *memSessions) GetSession(ctx context.Context, id string) (*db.Session, error) {
+	s, ok := m.sessions[id]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	cp := *s
+	return &cp, nil
+}
+
+func (m *memSessions) RevokeSessionFamily(ctx context.Context, familyID string) error {
+	now := time.Now()
+	for _, s := range m.sessions {
+		if s.FamilyID == familyID && s.RevokedAt == nil {
+			s.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+func (m *memSessions) RecordSecurityEvent(ctx context.Context, e *db.SecurityEvent) error {
+	m.events = append(m.events, e)
+	return nil
+}
+
+// open starts a session for user 7 in family and returns its refresh token
+func (m *memSessions) open(t *testing.T, familyID string) (*db.Session, string) {
+	t.Helper()
+	s := &db.Session{ID: uuid.New().String(), UserID: 7, FamilyID: familyID, ExpiresAt: time.Now().Add(time.Hour)}
+	m.sessions[s.ID] = s
+	return s, m.rotate(t, s)
+}
+
+// rotate issues the next refresh token of s, as RefreshToken does
+func (m *memSessions) rotate(t *testing.T, s *db.Session) string {
+	t.Helper()
+	token, jti, err := auth.GenerateRefreshToken("7", s.ID, s.FamilyID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.RefreshJTI = jti
+	s.RefreshTokenHash = auth.HashToken(token)
+	return token
+}
+
+func TestCheckRefreshToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T, m *memSessions) string
+		wantErr bool
+	}{
+		{
+			name: "current token",
+			setup: func(t *testing.T, m *memSessions) string {
+				_, token := m.open(t, uuid.New().String())
+				return token
+			},
+		},
+		{
+			name: "revoked session",
+			setup: func(t *testing.T, m *memSessions) string {
+				s, token := m.open(t, uuid.New().String())
+				now := time.Now()
+				s.RevokedAt = &now
+				return token
+			},
+			wantErr: true,
+		},
+		{
+			name: "expired session",
+			setup: func(t *testing.T, m *memSessions) string {
+				s, token := m.open(t, uuid.New().String())
+				s.ExpiresAt = time.Now().Add(-time.Second)
+				return token
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown session",
+			setup: func(t *testing.T, m *memSessions) string {
+				s, token := m.open(t, uuid.New().String())
+				delete(m.sessions, s.ID)
+				return token
+			},
+			wantErr: true,
+		},
+		{
+			name: "other family",
+			setup: func(t *testing.T, m *memSessions) string {
+				s, token := m.open(t, uuid.New().String())
+				s.FamilyID = uuid.New().String()
+				return token
+			},
+			wantErr: true,
+		},
+		{
+			name: "other user",
+			setup: func(t *testing.T, m *memSessions) string {
+				s, token := m.open(t, uuid.New().String())
+				s.UserID = 8
+				return token
+			},
+			wantErr: true,
+		},
+		{
+			name: "hash mismatch",
+			setup: func(t *testing.T, m *memSessions) string {
+				s, token := m.open(t, uuid.New().String())
+				s.RefreshTokenHash = auth.HashToken("something else")
+				return token
+			},
+			wantErr: true,
+		},
+		{
+			name: "access token",
+			setup: func(t *testing.T, m *memSessions) string {
+				s, _ := m.open(t, uuid.New().String())
+				token, _, err := auth.GenerateToken("7", s.ID, []string{auth.RoleCustomer})
+				if err != nil {
+					t.Fatal(err)
+				}
+				return token
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMemSessions()
+			token := tt.setup(t, m)
+			session, claims, err := checkRefreshToken(context.Background(), m, "req-1", token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkRefreshToken() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if errors.Is(err, ErrRefreshTokenReused) {
+				t.Fatal("reuse reported for a token that was never rotated")
+			}
+			if !tt.wantErr && (session == nil || claims.UserID != "7" || claims.SessionID != session.ID) {
+				t.Fatalf("checkRefreshToken() = %+v, %+v", session, claims)
+			}
+		})
+	}
+}
+
+func TestRefreshTokenReuseRevokesFamily(t *testing.T) {
+	ctx := context.Background()
+	m := newMemSessions()
+	family := uuid.New().String()
+
+	session, first := m.open(t, family)
+	sibling, siblingToken := m.open(t, family)
+	other, otherToken := m.open(t, uuid.New().String())
+	current := m.rotate(t, session)
+
+	// the rotated-out token comes back
+	if _, _, err := checkRefreshToken(ctx, m, "req-1", first); !errors.Is(err, ErrRefreshTokenReused) {
+		t.Fatalf("replayed token: error = %v, want %v", err, ErrRefreshTokenReused)
+	}
+
+	for _, s := range []*db.Session{session, sibling} {
+		if m.sessions[s.ID].RevokedAt == nil {
+			t.Errorf("session %s of the family still active", s.ID)
+		}
+	}
+	if m.sessions[other.ID].RevokedAt != nil {
+		t.Error("session of another family revoked")
+	}
+
+	// the legitimate holder is signed out too
+	for name, token := range map[string]string{"current": current, "sibling": siblingToken} {
+		if _, _, err := checkRefreshToken(ctx, m, "req-2", token); err == nil {
+			t.Errorf("%s token of the revoked family accepted", name)
+		}
+	}
+	if _, _, err := checkRefreshToken(ctx, m, "req-3", otherToken); err != nil {
+		t.Errorf("token of another family: %v", err)
+	}
+
+	if len(m.events) != 1 {
+		t.Fatalf("recorded %d security events, want 1", len(m.events))
+	}
+	e := m.events[0]
+	if e.EventType != EventRefreshTokenReuse || e.UserID != 7 || e.SessionID != session.ID ||
+		e.Details["family_id"] != family || e.Details["request_id"] != "req-1" {
+		t.Fatalf("security event = %+v", e)
+	}
+}
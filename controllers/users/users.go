@@ -1,6 +1,7 @@
 package users
 
 import (
+	"Adornme/config"
 	db "Adornme/databases"
 	"Adornme/models"
 	model "Adornme/models"
@@ -9,6 +10,15 @@ import (
 	"github.com/go-openapi/strfmt"
 )
 
+var cfg = config.LoadConfig()
+
+// Init runs the startup checks of the user flows. The server calls it once,
+// after db.Connect, so the stores it checks are registered.
+func Init() {
+	checkDenylistStore()
+	smsProvider = loadSMSProvider()
+}
+
 // User struct holds request-related metadata for tracking
 type User struct {
 	RequestID   string
@@ -20,15 +30,55 @@ type User struct {
 
 // Users interface defines user-related operations
 type Users interface {
-	RegisterUser(ctx context.Context, params *models.RegisterRequest) (*model.AuthResponse, *model.ErrorResponse)
+	RegisterUser(ctx context.Context, params *models.RegisterRequest) (*model.AuthResponse, error)
 	GetUser(ctx context.Context, userID int64) (*models.User, *models.ErrorResponse)
 	GetUserByEmail(ctx context.Context, Email strfmt.Email) (*db.User, *models.ErrorResponse)
 	RefreshToken(ctx context.Context, refreshToken string) (*model.AuthResponse, *model.ErrorResponse)
-	Login(ctx context.Context, email *strfmt.Email, password string) (*models.AuthResponse, error)
+	Login(ctx context.Context, email *strfmt.Email, password string) (*models.AuthResponse, *models.MFAChallenge, error)
 	Logout(ctx context.Context, refreshToken string) error
 	ForgetPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token string, newPassword string) error
 	IdentifyUser(ctx context.Context, identifier string) error
 	SendOTP(ctx context.Context, identifier string) error
+	VerifyOTP(ctx context.Context, identifier string, code string) (*models.VerifyOTPResponse, *models.MFAChallenge, error)
+	ListSessions(ctx context.Context, userID string, currentSessionID string) ([]*models.Session, *models.ErrorResponse)
+	RevokeSession(ctx context.Context, userID string, sessionID string) error
+	SendEmailVerification(ctx context.Context, userID string) error
+	VerifyEmail(ctx context.Context, token string) error
+	SendPhoneVerification(ctx context.Context, userID string) error
+	VerifyPhone(ctx context.Context, userID string, code string) error
+	CheckCheckoutAllowed(ctx context.Context, userID string) error
+	UnlockAccount(ctx context.Context, userID string) error
+	EnrollMFA(ctx context.Context, mfaToken string) (*models.MFAEnrollment, error)
+	StartMFAEnrollment(ctx context.Context, userID string) (*models.MFAEnrollment, error)
+	VerifyMFA(ctx context.Context, mfaToken string, code string) (*models.AuthResponse, error)
+	ConfirmMFA(ctx context.Context, userID string, code string) error
+	DisableMFA(ctx context.Context, userID string, code string) error
+	CreateAPIKey(ctx context.Context, createdBy string, req *models.APIKeyCreateRequest) (*models.APIKeyCreated, error)
+	ListAPIKeys(ctx context.Context) ([]*models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, revokedBy string, id int64) error
+	AuthenticateAPIKey(ctx context.Context, key string) (*models.Principal, error)
+	ImpersonateUser(ctx context.Context, agentID string, targetID string, reason string) (*models.ImpersonationResponse, error)
+	ListUsers(ctx context.Context, filter db.UserFilter, page, limit int64) (*models.UserListResponse, error)
+	AdminGetUser(ctx context.Context, userID string) (*models.User, error)
+	AdminUpdateUser(ctx context.Context, actorID string, userID string, req *models.AdminUserUpdateRequest) (*models.User, error)
+	DisableUser(ctx context.Context, actorID string, userID string) error
+	EnableUser(ctx context.Context, actorID string, userID string) error
+	AdminDeleteUser(ctx context.Context, actorID string, userID string) error
+	UpdateProfile(ctx context.Context, userID string, sessionID string, req *models.UserUpdateRequest) (*models.User, error)
+	ConfirmEmailChange(ctx context.Context, token string) error
+	ConfirmPhoneChange(ctx context.Context, userID string, code string) (*models.User, error)
+	RequestDataExport(ctx context.Context, userID string) (*models.PrivacyJob, error)
+	RequestErasure(ctx context.Context, userID string, currentPassword string) (*models.PrivacyJob, error)
+	GetPrivacyJob(ctx context.Context, userID string, jobID string) (*models.PrivacyJob, error)
+	SendMagicLink(ctx context.Context, email string, deviceID string) error
+	VerifyMagicLink(ctx context.Context, token string, deviceID string) (*models.AuthResponse, *models.MFAChallenge, error)
+	BeginPasskeyRegistration(ctx context.Context, userID string) (*models.PasskeyOptions, error)
+	RegisterPasskey(ctx context.Context, userID string, req *models.PasskeyRegistrationRequest) (*models.Passkey, error)
+	ListPasskeys(ctx context.Context, userID string) ([]*models.Passkey, error)
+	DeletePasskey(ctx context.Context, userID string, id int64) error
+	BeginPasskeyLogin(ctx context.Context, email string, mfaToken string) (*models.PasskeyOptions, error)
+	LoginWithPasskey(ctx context.Context, req *models.PasskeyLoginRequest) (*models.AuthResponse, error)
 }
 
 // NewUser initializes a User instance with request metadata
@@ -0,0 +1,167 @@
+package users
+
+import (
+	auth "Adornme/Auth"
+	db "Adornme/databases"
+	"Adornme/internal/otp"
+	"Adornme/utils"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Verification errors
+var (
+	ErrInvalidVerificationToken = errors.New("invalid or expired verification token")
+	ErrNothingToVerify          = errors.New("no unverified contact of that kind on the account")
+	ErrContactNotVerified       = errors.New("verify your email or phone before checking out")
+)
+
+const emailVerificationTTL = 24 * time.Hour
+
+// startContactVerification sends a link to an unverified email and a code to an unverified phone.
+// Used after registration and whenever a contact changes; failures are logged, not returned.
+func (u *User) startContactVerification(ctx context.Context, dbUser *db.User) {
+	if dbUser.Email != "" && !dbUser.EmailVerified {
+		if err := u.sendEmailVerification(ctx, dbUser); err != nil {
+			logs.Errorf(ctx, "failed to start email verification for user %d: %v", dbUser.ID, err)
+		}
+	}
+	if dbUser.Phone != "" && !dbUser.PhoneVerified {
+		if err := u.sendPhoneVerification(ctx, dbUser); err != nil && !errors.Is(err, otp.ErrCooldown) {
+			logs.Errorf(ctx, "failed to start phone verification for user %d: %v", dbUser.ID, err)
+		}
+	}
+}
+
+// SendEmailVerification (re)sends the verification link for the user's email
+func (u *User) SendEmailVerification(ctx context.Context, userID string) error {
+	dbUser, err := u.loadUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if dbUser.Email == "" || dbUser.EmailVerified {
+		return ErrNothingToVerify
+	}
+	return u.sendEmailVerification(ctx, dbUser)
+}
+
+func (u *User) sendEmailVerification(ctx context.Context, dbUser *db.User) error {
+	// 🔹 1. Token (stored hashed, like reset tokens)
+	rawToken := uuid.New().String()
+	expiry := time.Now().Add(emailVerificationTTL)
+
+	if err := u.DB.SaveEmailVerification(ctx, dbUser.ID, dbUser.Email, auth.HashToken(rawToken), expiry); err != nil {
+		return errors.New("failed to create verification token")
+	}
+
+	// 🔹 2. Link
+	link := fmt.Sprintf("http://localhost:3000/verify-email?token=%s", rawToken)
+
+	// 🔹 3. Send email (async)
+	email, name := dbUser.Email, dbUser.Name
+	go func() {
+		if err := utils.SendVerificationEmail(email, name, link); err != nil {
+			logs.Error(ctx, "failed to send verification email", "user_id", dbUser.ID, "error", err.Error())
+			return
+		}
+		logs.Info(ctx, "verification email sent", "user_id", dbUser.ID)
+	}()
+
+	return nil
+}
+
+// VerifyEmail confirms an email address with the token from the link
+func (u *User) VerifyEmail(ctx context.Context, token string) error {
+	userID, err := u.DB.ConsumeEmailVerification(ctx, auth.HashToken(strings.TrimSpace(token)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidVerificationToken
+		}
+		logs.Errorf(ctx, "EMAIL VERIFICATION FAILED: %v", err)
+		return errors.New("failed to verify email")
+	}
+
+	logs.Infof(ctx, "email verified | user_id=%d", userID)
+	return nil
+}
+
+// SendPhoneVerification (re)sends the verification code to the user's phone
+func (u *User) SendPhoneVerification(ctx context.Context, userID string) error {
+	dbUser, err := u.loadUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if dbUser.Phone == "" || dbUser.PhoneVerified {
+		return ErrNothingToVerify
+	}
+	return u.sendPhoneVerification(ctx, dbUser)
+}
+
+func (u *User) sendPhoneVerification(ctx context.Context, dbUser *db.User) error {
+	svc, err := otpService()
+	if err != nil {
+		return err
+	}
+	return svc.SendCode(ctx, dbUser.Phone, "phone", otp.PurposeVerify)
+}
+
+// VerifyPhone confirms the user's phone with the code sent by SMS
+func (u *User) VerifyPhone(ctx context.Context, userID string, code string) error {
+	dbUser, err := u.loadUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if dbUser.Phone == "" || dbUser.PhoneVerified {
+		return ErrNothingToVerify
+	}
+
+	svc, err := otpService()
+	if err != nil {
+		return err
+	}
+	if err := svc.VerifyCode(ctx, dbUser.Phone, "phone", otp.PurposeVerify, strings.TrimSpace(code)); err != nil {
+		return err
+	}
+
+	if err := u.DB.SetPhoneVerified(ctx, dbUser.ID, dbUser.Phone); err != nil {
+		return errors.New("failed to verify phone")
+	}
+
+	logs.Infof(ctx, "phone verified | user_id=%d", dbUser.ID)
+	return nil
+}
+
+// CheckCheckoutAllowed applies the verified-contact rule for checkout
+func (u *User) CheckCheckoutAllowed(ctx context.Context, userID string) error {
+	if !cfg.RequireVerifiedForCheckout {
+		return nil
+	}
+
+	dbUser, err := u.loadUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !dbUser.EmailVerified && !dbUser.PhoneVerified {
+		return ErrContactNotVerified
+	}
+	return nil
+}
+
+func (u *User) loadUser(ctx context.Context, userID string) (*db.User, error) {
+	id, err := strconv.Atoi(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id: %w", err)
+	}
+	dbUser, err := u.DB.GetUser(ctx, id)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	return dbUser, nil
+}
@@ -12,10 +12,11 @@ import (
 )
 
 var (
-	Do   map[string]DatabaseProvider // registry of all DBs
-	once sync.Once
-	Ctx  context.Context
-	logs = log.Component("database")
+	Do          map[string]DatabaseProvider // registry of all DBs
+	once        sync.Once
+	connectOnce sync.Once
+	Ctx         context.Context
+	logs        = log.Component("database")
 )
 
 type DatabaseProvider interface {
@@ -34,6 +35,13 @@ func init() {
 
 		// attach instanceID to request context
 		Ctx = log.WithRequestID(context.Background(), instanceID)
+	})
+}
+
+// Connect opens every database in config/db-config.json and registers it in Do.
+// The server calls it once at startup; importing this package connects nothing.
+func Connect() {
+	connectOnce.Do(func() {
 		if err := setupDatabases("config/db-config.json"); err != nil {
 			logs.Fatalf(Ctx, "DB initialization failed: %v", err)
 		}
@@ -45,20 +45,72 @@ func (m *Migrator) migrateUsers(ctx context.Context) error {
 		email_verified BOOLEAN DEFAULT FALSE,
 		phone_verified BOOLEAN DEFAULT FALSE,
 		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
-		updated_at TIMESTAMP,
-		refresh_token TEXT,
-		access_token TEXT
-	);`)
+		updated_at TIMESTAMP
+	);
+
+	-- tokens moved to the sessions table (one row per device)
+	ALTER TABLE users DROP COLUMN IF EXISTS refresh_token;
+	ALTER TABLE users DROP COLUMN IF EXISTS access_token;
+
+	-- admin disable / anonymising delete (rows are kept for order history)
+	ALTER TABLE users ADD COLUMN IF NOT EXISTS disabled_at TIMESTAMP;
+	ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+	-- phones are stored in E.164; older rows hold bare 10-digit Indian mobiles
+	UPDATE users u SET phone = '+91' || u.phone
+	WHERE u.phone ~ '^[6-9][0-9]{9}$'
+	  AND NOT EXISTS (SELECT 1 FROM users o WHERE o.phone = '+91' || u.phone);
+	`)
 	if err != nil {
 		return err
 	}
 	if err := m.migratePasswordResets(ctx); err != nil {
 		return err
 	}
+	if err := m.migrateMagicLinks(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateEmailVerifications(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateContactChanges(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateUserRoles(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateSessions(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateMFA(ctx); err != nil {
+		return err
+	}
+	if err := m.migratePasskeys(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateAPIKeys(ctx); err != nil {
+		return err
+	}
+	if err := m.migratePrivacyJobs(ctx); err != nil {
+		return err
+	}
 
 	return err
 }
 
+func (m *Migrator) migrateUserRoles(ctx context.Context) error {
+	_, err := m.Pool.Exec(ctx, `
+	CREATE TABLE IF NOT EXISTS user_roles (
+		user_id INT NOT NULL,
+		role TEXT NOT NULL,
+		created_at TIMESTAMP DEFAULT NOW(),
+		PRIMARY KEY (user_id, role),
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+	);
+	`)
+	return err
+}
+
 func (m *Migrator) migratePasswordResets(ctx context.Context) error {
 	_, err := m.Pool.Exec(ctx, `
 	CREATE TABLE IF NOT EXISTS password_resets (
@@ -76,6 +128,217 @@ func (m *Migrator) migratePasswordResets(ctx context.Context) error {
 	return err
 }
 
+// magic_links holds emailed sign-in links; device_hash binds each to the device that asked,
+// device_misses counts attempts from other devices
+func (m *Migrator) migrateMagicLinks(ctx context.Context) error {
+	_, err := m.Pool.Exec(ctx, `
+	CREATE TABLE IF NOT EXISTS magic_links (
+		id SERIAL PRIMARY KEY,
+		user_id INT NOT NULL,
+		token_hash TEXT NOT NULL,
+		device_hash TEXT NOT NULL,
+		expiry TIMESTAMP NOT NULL,
+		created_at TIMESTAMP DEFAULT NOW(),
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+	);
+
+	ALTER TABLE magic_links ADD COLUMN IF NOT EXISTS device_misses INT NOT NULL DEFAULT 0;
+
+	CREATE INDEX IF NOT EXISTS idx_magic_links_token
+	ON magic_links(token_hash);
+	`)
+	return err
+}
+
+func (m *Migrator) migrateEmailVerifications(ctx context.Context) error {
+	_, err := m.Pool.Exec(ctx, `
+	CREATE TABLE IF NOT EXISTS email_verifications (
+		id SERIAL PRIMARY KEY,
+		user_id INT NOT NULL,
+		email TEXT NOT NULL,
+		token_hash TEXT NOT NULL,
+		expiry TIMESTAMP NOT NULL,
+		created_at TIMESTAMP DEFAULT NOW(),
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_email_verifications_token
+	ON email_verifications(token_hash);
+	`)
+	return err
+}
+
+// contact_changes holds a new email or phone until the user confirms it
+func (m *Migrator) migrateContactChanges(ctx context.Context) error {
+	_, err := m.Pool.Exec(ctx, `
+	CREATE TABLE IF NOT EXISTS contact_changes (
+		user_id INT NOT NULL,
+		kind TEXT NOT NULL,
+		new_value TEXT NOT NULL,
+		token_hash TEXT,
+		expiry TIMESTAMP NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		PRIMARY KEY (user_id, kind),
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_contact_changes_token
+	ON contact_changes(token_hash);
+	`)
+	return err
+}
+
+func (m *Migrator) migrateSessions(ctx context.Context) error {
+	_, err := m.Pool.Exec(ctx, `
+	CREATE TABLE IF NOT EXISTS sessions (
+		id UUID PRIMARY KEY,
+		user_id INT NOT NULL,
+		family_id UUID NOT NULL,
+		refresh_jti TEXT NOT NULL,
+		refresh_token_hash TEXT NOT NULL,
+		user_agent TEXT,
+		ip_address TEXT,
+		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		last_used_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		expires_at TIMESTAMP NOT NULL,
+		revoked_at TIMESTAMP,
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_sessions_user
+	ON sessions(user_id);
+
+	CREATE INDEX IF NOT EXISTS idx_sessions_family
+	ON sessions(family_id);
+	`)
+	if err != nil {
+		return err
+	}
+	if err := m.migrateSecurityEvents(ctx); err != nil {
+		return err
+	}
+
+	return err
+}
+
+func (m *Migrator) migrateSecurityEvents(ctx context.Context) error {
+	_, err := m.Pool.Exec(ctx, `
+	CREATE TABLE IF NOT EXISTS security_events (
+		id SERIAL PRIMARY KEY,
+		user_id INT,
+		event_type TEXT NOT NULL,
+		session_id TEXT,
+		ip_address TEXT,
+		user_agent TEXT,
+		details JSONB,
+		created_at TIMESTAMP NOT NULL DEFAULT NOW()
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_security_events_user
+	ON security_events(user_id, created_at);
+	`)
+	return err
+}
+
+func (m *Migrator) migrateMFA(ctx context.Context) error {
+	_, err := m.Pool.Exec(ctx, `
+	CREATE TABLE IF NOT EXISTS user_mfa (
+		user_id INT PRIMARY KEY,
+		totp_secret TEXT NOT NULL,
+		enabled BOOLEAN NOT NULL DEFAULT FALSE,
+		last_used_step BIGINT NOT NULL DEFAULT 0,
+		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		enabled_at TIMESTAMP,
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+	);
+
+	CREATE TABLE IF NOT EXISTS mfa_recovery_codes (
+		id SERIAL PRIMARY KEY,
+		user_id INT NOT NULL,
+		code_hash TEXT NOT NULL,
+		used_at TIMESTAMP,
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_mfa_recovery_codes_user
+	ON mfa_recovery_codes(user_id);
+
+	-- whether the session was signed in with a second factor; staff may only refresh those
+	ALTER TABLE sessions ADD COLUMN IF NOT EXISTS mfa BOOLEAN NOT NULL DEFAULT FALSE;
+	`)
+	return err
+}
+
+// webauthn_credentials holds passkeys; public_key is the COSE key sent at registration
+func (m *Migrator) migratePasskeys(ctx context.Context) error {
+	_, err := m.Pool.Exec(ctx, `
+	CREATE TABLE IF NOT EXISTS webauthn_credentials (
+		id SERIAL PRIMARY KEY,
+		user_id INT NOT NULL,
+		credential_id BYTEA NOT NULL UNIQUE,
+		public_key BYTEA NOT NULL,
+		algorithm INT NOT NULL,
+		sign_count BIGINT NOT NULL DEFAULT 0,
+		aaguid BYTEA,
+		transports TEXT[] NOT NULL DEFAULT '{}',
+		name TEXT NOT NULL,
+		backup_eligible BOOLEAN NOT NULL DEFAULT FALSE,
+		backed_up BOOLEAN NOT NULL DEFAULT FALSE,
+		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		last_used_at TIMESTAMP,
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_webauthn_credentials_user
+	ON webauthn_credentials(user_id);
+	`)
+	return err
+}
+
+func (m *Migrator) migrateAPIKeys(ctx context.Context) error {
+	_, err := m.Pool.Exec(ctx, `
+	CREATE TABLE IF NOT EXISTS api_keys (
+		id SERIAL PRIMARY KEY,
+		name TEXT NOT NULL,
+		prefix TEXT NOT NULL UNIQUE,
+		key_hash TEXT NOT NULL,
+		scopes TEXT[] NOT NULL DEFAULT '{}',
+		created_by INT,
+		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		expires_at TIMESTAMP,
+		last_used_at TIMESTAMP,
+		revoked_at TIMESTAMP,
+		FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE SET NULL
+	);
+	`)
+	return err
+}
+
+// privacy_jobs tracks data export and erasure jobs; object_key is the export archive in MinIO
+func (m *Migrator) migratePrivacyJobs(ctx context.Context) error {
+	_, err := m.Pool.Exec(ctx, `
+	CREATE TABLE IF NOT EXISTS privacy_jobs (
+		id UUID PRIMARY KEY,
+		user_id INT NOT NULL,
+		kind TEXT NOT NULL,
+		status TEXT NOT NULL DEFAULT 'queued',
+		progress INT NOT NULL DEFAULT 0,
+		step TEXT,
+		object_key TEXT,
+		error TEXT,
+		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		completed_at TIMESTAMP,
+		expires_at TIMESTAMP,
+		FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_privacy_jobs_user
+	ON privacy_jobs(user_id, kind);
+	`)
+	return err
+}
+
 // ------------------ Products ------------------
 func (m *Migrator) migrateProducts(ctx context.Context) error {
 	_, err := m.Pool.Exec(ctx, `
@@ -87,7 +350,133 @@ func (m *Migrator) migrateProducts(ctx context.Context) error {
 		inventory INT NOT NULL DEFAULT 0,
 		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
 		updated_at TIMESTAMP
-	);`)
+	);
+
+	-- storefront listing: category filter, gallery, popularity sort
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS category_id INT;
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS images TEXT[] NOT NULL DEFAULT '{}';
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS popularity INT NOT NULL DEFAULT 0;
+
+	-- admin CRUD: price currency, optimistic locking, soft delete (orders keep resolving archived rows)
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'INR';
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP;
+
+	-- variants: the option axes ([{"name": "Purity", "values": ["18K", "22K"]}]) SKUs pick from
+	ALTER TABLE products ADD COLUMN IF NOT EXISTS options JSONB NOT NULL DEFAULT '[]';
+
+	CREATE INDEX IF NOT EXISTS idx_products_category ON products(category_id);
+	CREATE INDEX IF NOT EXISTS idx_products_price ON products(price);
+	CREATE INDEX IF NOT EXISTS idx_products_created ON products(created_at DESC);
+	CREATE INDEX IF NOT EXISTS idx_products_popularity ON products(popularity DESC);
+	`)
+	if err != nil {
+		return err
+	}
+	if err := m.migrateCategories(ctx); err != nil {
+		return err
+	}
+	if err := m.migrateVariants(ctx); err != nil {
+		return err
+	}
+	return m.migrateAttributes(ctx)
+}
+
+// categories form a tree through parent_id; a product's category_id points at any node.
+// The foreign key is added here, once categories exists; dangling ids are cleared first.
+func (m *Migrator) migrateCategories(ctx context.Context) error {
+	_, err := m.Pool.Exec(ctx, `
+	CREATE TABLE IF NOT EXISTS categories (
+		id SERIAL PRIMARY KEY,
+		name TEXT NOT NULL,
+		slug TEXT NOT NULL UNIQUE,
+		parent_id INT REFERENCES categories(id),
+		position INT NOT NULL DEFAULT 0,
+		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		updated_at TIMESTAMP,
+		CHECK (parent_id <> id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_categories_parent
+	ON categories(parent_id);
+
+	DO $$
+	BEGIN
+		IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'products_category_id_fkey') THEN
+			UPDATE products SET category_id = NULL
+			WHERE category_id IS NOT NULL AND category_id NOT IN (SELECT id FROM categories);
+			ALTER TABLE products ADD CONSTRAINT products_category_id_fkey
+				FOREIGN KEY (category_id) REFERENCES categories(id);
+		END IF;
+	END $$;
+	`)
+	return err
+}
+
+// product_variants are the SKUs of a product, one per combination of option
+// values ({"Purity": "18K", "Size": "7"}). Archived rows stay for carts and orders.
+func (m *Migrator) migrateVariants(ctx context.Context) error {
+	_, err := m.Pool.Exec(ctx, `
+	CREATE TABLE IF NOT EXISTS product_variants (
+		id SERIAL PRIMARY KEY,
+		product_id INT NOT NULL REFERENCES products(id),
+		sku TEXT NOT NULL UNIQUE,
+		options JSONB NOT NULL DEFAULT '{}',
+		price NUMERIC(10,2) NOT NULL,
+		weight_grams NUMERIC(10,3) NOT NULL DEFAULT 0,
+		stock INT NOT NULL DEFAULT 0 CHECK (stock >= 0),
+		barcode TEXT UNIQUE,
+		archived_at TIMESTAMP,
+		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		updated_at TIMESTAMP
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_product_variants_product
+	ON product_variants(product_id);
+
+	-- one live variant per combination
+	CREATE UNIQUE INDEX IF NOT EXISTS uniq_product_variants_options
+	ON product_variants(product_id, options) WHERE archived_at IS NULL;
+	`)
+	return err
+}
+
+// attribute_definitions type the attributes of a category and its subcategories;
+// product_attributes hold each product's values, value_num set for number attributes
+func (m *Migrator) migrateAttributes(ctx context.Context) error {
+	_, err := m.Pool.Exec(ctx, `
+	CREATE TABLE IF NOT EXISTS attribute_definitions (
+		id SERIAL PRIMARY KEY,
+		category_id INT NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+		code TEXT NOT NULL,
+		label TEXT NOT NULL,
+		type TEXT NOT NULL CHECK (type IN ('enum', 'number', 'boolean')),
+		allowed_values TEXT[] NOT NULL DEFAULT '{}',
+		unit TEXT NOT NULL DEFAULT '',
+		min_value NUMERIC,
+		max_value NUMERIC,
+		required BOOLEAN NOT NULL DEFAULT FALSE,
+		position INT NOT NULL DEFAULT 0,
+		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+		updated_at TIMESTAMP,
+		UNIQUE (category_id, code)
+	);
+
+	CREATE TABLE IF NOT EXISTS product_attributes (
+		id SERIAL PRIMARY KEY,
+		product_id INT NOT NULL REFERENCES products(id),
+		attribute_name TEXT NOT NULL,
+		attribute_value TEXT NOT NULL,
+		value_num NUMERIC,
+		UNIQUE (product_id, attribute_name)
+	);
+
+	-- attr.<code> filters and facet counts
+	CREATE INDEX IF NOT EXISTS idx_product_attributes_value
+	ON product_attributes(attribute_name, LOWER(attribute_value));
+	CREATE INDEX IF NOT EXISTS idx_product_attributes_num
+	ON product_attributes(attribute_name, value_num);
+	`)
 	return err
 }
 
@@ -101,8 +490,37 @@ func (m *Migrator) migrateOrders(ctx context.Context) error {
 		status TEXT NOT NULL DEFAULT 'pending',
 		created_at TIMESTAMP NOT NULL DEFAULT NOW(),
 		updated_at TIMESTAMP
-	);`)
-	return err
+	);
+
+	CREATE TABLE IF NOT EXISTS order_items (
+		id SERIAL PRIMARY KEY,
+		order_id INT NOT NULL REFERENCES orders(id),
+		product_id INT NOT NULL,
+		quantity INT NOT NULL,
+		price NUMERIC(10,2) NOT NULL
+	);
+
+	-- sku_id is the variant bought and is required on every new line; product_id
+	-- stays for reporting by product. Lines from before variants have no SKU and,
+	-- as SKUs live in productsdb, cannot be backfilled here: NOT VALID exempts them.
+	ALTER TABLE order_items ADD COLUMN IF NOT EXISTS sku_id INT;
+	DO $$
+	BEGIN
+		IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'order_items_sku_required') THEN
+			ALTER TABLE order_items ADD CONSTRAINT order_items_sku_required
+				CHECK (sku_id IS NOT NULL) NOT VALID;
+		END IF;
+	END $$;
+
+	CREATE INDEX IF NOT EXISTS idx_order_items_order
+	ON order_items(order_id);
+	`)
+	if err != nil {
+		return err
+	}
+
+	// carts live beside the orders they turn into
+	return m.migrateEcommerce(ctx)
 }
 
 // ------------------ Inventory ------------------
@@ -113,7 +531,23 @@ func (m *Migrator) migrateInventory(ctx context.Context) error {
 		product_id INT NOT NULL,
 		quantity INT NOT NULL DEFAULT 0,
 		updated_at TIMESTAMP
-	);`)
+	);
+
+	-- One row per SKU, mirroring product_variants.stock, which stays the source
+	-- of truth; the variant writes keep it in step. sku_id is required on every
+	-- new row; rows from before variants are exempted by NOT VALID, as for
+	-- order_items and cart_items.
+	ALTER TABLE inventory ADD COLUMN IF NOT EXISTS sku_id INT;
+	DROP INDEX IF EXISTS idx_inventory_sku;
+	CREATE UNIQUE INDEX IF NOT EXISTS uq_inventory_sku ON inventory(sku_id);
+	DO $$
+	BEGIN
+		IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'inventory_sku_required') THEN
+			ALTER TABLE inventory ADD CONSTRAINT inventory_sku_required
+				CHECK (sku_id IS NOT NULL) NOT VALID;
+		END IF;
+	END $$;
+	`)
 	return err
 }
 
@@ -126,6 +560,19 @@ func (m *Migrator) migrateEcommerce(ctx context.Context) error {
 		product_id INT NOT NULL,
 		quantity INT NOT NULL DEFAULT 1,
 		created_at TIMESTAMP NOT NULL DEFAULT NOW()
-	);`)
+	);
+
+	-- sku_id is the variant added and is required on every new line; product_id
+	-- stays for reporting by product. Lines from before variants are exempted by
+	-- NOT VALID, as for order_items, and are left for the owner to replace.
+	ALTER TABLE cart_items ADD COLUMN IF NOT EXISTS sku_id INT;
+	DO $$
+	BEGIN
+		IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'cart_items_sku_required') THEN
+			ALTER TABLE cart_items ADD CONSTRAINT cart_items_sku_required
+				CHECK (sku_id IS NOT NULL) NOT VALID;
+		END IF;
+	END $$;
+	`)
 	return err
 }
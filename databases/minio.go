@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/minio/minio-go/v7"
@@ -97,3 +98,73 @@ func (m *MinioProvider) HealthDetails(ctx context.Context) (uptime string, laten
 
 	return uptime, latencyMs, nil
 }
+
+// ----------------- Objects -----------------
+
+// EnsureBucket creates bucket when it does not exist yet
+func (m *MinioProvider) EnsureBucket(ctx context.Context, bucket string) error {
+	ok, err := m.Client.BucketExists(ctx, bucket)
+	if err != nil || ok {
+		return err
+	}
+	return m.Client.MakeBucket(ctx, bucket, minio.MakeBucketOptions{})
+}
+
+// PutObject uploads size bytes from r to bucket/key
+func (m *MinioProvider) PutObject(ctx context.Context, bucket, key string, r io.Reader, size int64, contentType string) error {
+	_, err := m.Client.PutObject(ctx, bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+// GetObject opens bucket/key for reading; the caller closes it
+func (m *MinioProvider) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	return m.Client.GetObject(ctx, bucket, key, minio.GetObjectOptions{})
+}
+
+// ListKeys returns the keys under prefix; a missing bucket has none
+func (m *MinioProvider) ListKeys(ctx context.Context, bucket, prefix string) ([]string, error) {
+	ok, err := m.Client.BucketExists(ctx, bucket)
+	if err != nil || !ok {
+		return nil, err
+	}
+	keys := []string{}
+	for obj := range m.Client.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		keys = append(keys, obj.Key)
+	}
+	return keys, nil
+}
+
+// RemovePrefix deletes every object under prefix and reports how many went
+func (m *MinioProvider) RemovePrefix(ctx context.Context, bucket, prefix string) (int, error) {
+	keys, err := m.ListKeys(ctx, bucket, prefix)
+	if err != nil || len(keys) == 0 {
+		return 0, err
+	}
+	objects := make(chan minio.ObjectInfo, len(keys))
+	for _, k := range keys {
+		objects <- minio.ObjectInfo{Key: k}
+	}
+	close(objects)
+	var firstErr error
+	for rerr := range m.Client.RemoveObjects(ctx, bucket, objects, minio.RemoveObjectsOptions{}) {
+		if firstErr == nil {
+			firstErr = fmt.Errorf("failed to remove %s: %w", rerr.ObjectName, rerr.Err)
+		}
+	}
+	if firstErr != nil {
+		return 0, firstErr
+	}
+	return len(keys), nil
+}
+
+// PresignedURL returns a download link for bucket/key valid for ttl
+func (m *MinioProvider) PresignedURL(ctx context.Context, bucket, key string, ttl time.Duration) (string, error) {
+	u, err := m.Client.PresignedGetObject(ctx, bucket, key, ttl, nil)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
@@ -2,21 +2,23 @@ package models
 
 import "time"
 
+// EventLog is an audit entry, stored in the Mongo "event_logs" collection
 type EventLog struct {
-	ID          int64     `db:"id"`
-	ServiceName string    `db:"service_name"`
-	EntityID    int64     `db:"entity_id"`
-	Action      string    `db:"action"`
-	Payload     []byte    `db:"payload"` // JSONB
-	CreatedAt   time.Time `db:"created_at"`
+	ID          int64          `db:"id" bson:"-"`
+	ServiceName string         `db:"service_name" bson:"service_name"`
+	EntityID    int64          `db:"entity_id" bson:"entity_id"`
+	Action      string         `db:"action" bson:"action"`
+	Payload     map[string]any `db:"payload" bson:"payload"` // JSONB
+	CreatedAt   time.Time      `db:"created_at" bson:"created_at"`
 }
 
+// UserActivity is a storefront activity entry, stored in the Mongo "user_activity" collection
 type UserActivity struct {
-	ID        int64     `db:"id"`
-	UserID    int64     `db:"user_id"`
-	Action    string    `db:"action"`
-	Metadata  []byte    `db:"metadata"` // JSONB
-	CreatedAt time.Time `db:"created_at"`
+	ID        int64          `db:"id" bson:"-"`
+	UserID    int64          `db:"user_id" bson:"user_id"`
+	Action    string         `db:"action" bson:"action"`
+	Metadata  map[string]any `db:"metadata" bson:"metadata"` // JSONB
+	CreatedAt time.Time      `db:"created_at" bson:"created_at"`
 }
 
 type SalesAnalytics struct {
@@ -9,10 +9,11 @@ type Warehouse struct {
 }
 
 type Inventory struct {
-	ID          int64 `db:"id"`
-	ProductID   int64 `db:"product_id"`
-	WarehouseID int64 `db:"warehouse_id"`
-	Quantity    int   `db:"quantity"`
+	ID        int64     `db:"id"`
+	ProductID int64     `db:"product_id"`
+	SkuID     *int64    `db:"sku_id"`
+	Quantity  int       `db:"quantity"`
+	UpdatedAt time.Time `db:"updated_at"`
 }
 
 type Supplier struct {
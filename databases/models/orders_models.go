@@ -17,6 +17,7 @@ type OrderItem struct {
 	ID        int64   `db:"id"`
 	OrderID   int64   `db:"order_id"`
 	ProductID int64   `db:"product_id"`
+	SkuID     *int64  `db:"sku_id"`
 	Quantity  int     `db:"quantity"`
 	Price     float64 `db:"price"`
 }
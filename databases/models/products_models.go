@@ -20,6 +20,18 @@ type Product struct {
 	UpdatedAt   time.Time `db:"updated_at"`
 }
 
+type ProductVariant struct {
+	ID          int64             `db:"id"`
+	ProductID   int64             `db:"product_id"`
+	SKU         string            `db:"sku"`
+	Options     map[string]string `db:"options"`
+	Price       float64           `db:"price"`
+	WeightGrams float64           `db:"weight_grams"`
+	Stock       int               `db:"stock"`
+	Barcode     *string           `db:"barcode"`
+	ArchivedAt  *time.Time        `db:"archived_at"`
+}
+
 type ProductImage struct {
 	ID        int64  `db:"id"`
 	ProductID int64  `db:"product_id"`
@@ -27,8 +39,23 @@ type ProductImage struct {
 }
 
 type ProductAttribute struct {
-	ID             int64  `db:"id"`
-	ProductID      int64  `db:"product_id"`
-	AttributeName  string `db:"attribute_name"`
-	AttributeValue string `db:"attribute_value"`
+	ID             int64    `db:"id"`
+	ProductID      int64    `db:"product_id"`
+	AttributeName  string   `db:"attribute_name"`
+	AttributeValue string   `db:"attribute_value"`
+	ValueNum       *float64 `db:"value_num"`
+}
+
+type AttributeDefinition struct {
+	ID            int64    `db:"id"`
+	CategoryID    int64    `db:"category_id"`
+	Code          string   `db:"code"`
+	Label         string   `db:"label"`
+	Type          string   `db:"type"`
+	AllowedValues []string `db:"allowed_values"`
+	Unit          string   `db:"unit"`
+	MinValue      *float64 `db:"min_value"`
+	MaxValue      *float64 `db:"max_value"`
+	Required      bool     `db:"required"`
+	Position      int      `db:"position"`
 }
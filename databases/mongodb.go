@@ -1,12 +1,15 @@
 package database
 
 import (
+	"Adornme/databases/models"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -15,10 +18,12 @@ type mongoConfig struct {
 	Enabled     bool   `json:"enabled"`
 	DSN         string `json:"dsn"`
 	MaxPoolSize uint64 `json:"maxPoolSize"`
+	Database    string `json:"database"`
 }
 
 type MongoProvider struct {
-	Client *mongo.Client
+	Client   *mongo.Client
+	Database string
 }
 
 // ConnectMongo initializes MongoDB connection with retry in background
@@ -31,7 +36,11 @@ func connectMongo(raw json.RawMessage) (*MongoProvider, error) {
 		return nil, nil
 	}
 
-	provider := &MongoProvider{}
+	if cfg.Database == "" {
+		cfg.Database = "ecommerce"
+	}
+
+	provider := &MongoProvider{Database: cfg.Database}
 
 	// start background retry loop
 	go func() {
@@ -102,3 +111,82 @@ func (m *MongoProvider) HealthCheck(ctx context.Context) error {
 func (m *MongoProvider) Close() error {
 	return m.Client.Disconnect(context.Background())
 }
+
+// ----------------- Event Log -----------------
+
+// InsertEventLog appends an audit entry to the event_logs collection
+func (m *MongoProvider) InsertEventLog(ctx context.Context, e *models.EventLog) error {
+	if m == nil || m.Client == nil {
+		return errors.New("mongo client is not connected")
+	}
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now().UTC()
+	}
+	_, err := m.Client.Database(m.Database).Collection("event_logs").InsertOne(ctx, e)
+	return err
+}
+
+// FindEventLogs returns the audit entries a service recorded about an entity, oldest first
+func (m *MongoProvider) FindEventLogs(ctx context.Context, serviceName string, entityID int64) ([]models.EventLog, error) {
+	if m == nil || m.Client == nil {
+		return nil, errors.New("mongo client is not connected")
+	}
+	cur, err := m.Client.Database(m.Database).Collection("event_logs").Find(ctx,
+		bson.M{"service_name": serviceName, "entity_id": entityID},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	entries := []models.EventLog{}
+	if err := cur.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// ScrubEventLogs removes payload fields from a service's audit entries about an entity
+func (m *MongoProvider) ScrubEventLogs(ctx context.Context, serviceName string, entityID int64, fields ...string) error {
+	if m == nil || m.Client == nil {
+		return errors.New("mongo client is not connected")
+	}
+	unset := bson.M{}
+	for _, f := range fields {
+		unset["payload."+f] = ""
+	}
+	_, err := m.Client.Database(m.Database).Collection("event_logs").UpdateMany(ctx,
+		bson.M{"service_name": serviceName, "entity_id": entityID},
+		bson.M{"$unset": unset})
+	return err
+}
+
+// ----------------- User Activity -----------------
+
+// FindUserActivity returns a user's storefront activity, oldest first
+func (m *MongoProvider) FindUserActivity(ctx context.Context, userID int64) ([]models.UserActivity, error) {
+	if m == nil || m.Client == nil {
+		return nil, errors.New("mongo client is not connected")
+	}
+	cur, err := m.Client.Database(m.Database).Collection("user_activity").Find(ctx,
+		bson.M{"user_id": userID},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	activity := []models.UserActivity{}
+	if err := cur.All(ctx, &activity); err != nil {
+		return nil, err
+	}
+	return activity, nil
+}
+
+// DeleteUserActivity removes a user's storefront activity and reports how many entries went
+func (m *MongoProvider) DeleteUserActivity(ctx context.Context, userID int64) (int64, error) {
+	if m == nil || m.Client == nil {
+		return 0, errors.New("mongo client is not connected")
+	}
+	res, err := m.Client.Database(m.Database).Collection("user_activity").DeleteMany(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
@@ -47,6 +47,20 @@ func (c *PostgresClients) Close() error {
 	return nil
 }
 
+// CreateOrder stores an order in ordersdb and then counts its units towards the
+// products' popularity in productsdb. The two databases cannot share a
+// transaction; a failed count is logged and only skews the popularity sort.
+func (c *PostgresClients) CreateOrder(ctx context.Context, order Order, items []OrderItem) (int, error) {
+	orderID, err := c.OrdersDB.CreateOrder(ctx, order, items)
+	if err != nil {
+		return 0, err
+	}
+	if c.ProductsDB != nil {
+		_ = c.ProductsDB.RecordProductSales(ctx, items)
+	}
+	return orderID, nil
+}
+
 // Individual PostgresProvider methods
 func (p *PostgresProvider) HealthCheck(ctx context.Context) error {
 	return p.Pool.Ping(ctx)
@@ -2,35 +2,304 @@ package database
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 // ----------------- User Model -----------------
 type User struct {
-	ID            int64     `db:"id"`
-	Name          string    `db:"name"`
-	Email         string    `db:"email"`    // nullable
-	Phone         string    `db:"phone"`    // nullable
-	Password      string    `db:"password"` // nullable (for phone users)
-	EmailVerified bool      `db:"email_verified"`
-	PhoneVerified bool      `db:"phone_verified"`
-	CreatedAt     time.Time `db:"created_at"`
-	UpdatedAt     time.Time `db:"updated_at"`
-	RefreshToken  *string   `db:"refresh_token"`
+	ID            int64      `db:"id"`
+	Name          string     `db:"name"`
+	Email         string     `db:"email"`    // nullable
+	Phone         string     `db:"phone"`    // nullable
+	Password      string     `db:"password"` // nullable (for phone users)
+	EmailVerified bool       `db:"email_verified"`
+	PhoneVerified bool       `db:"phone_verified"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+	DisabledAt    *time.Time `db:"disabled_at"` // set while an admin has the account disabled
+	DeletedAt     *time.Time `db:"deleted_at"`  // set once the account is anonymised
+	Roles         []string   `db:"roles"`       // filled by ListUsers only
+}
+
+// UserFilter narrows the admin user listing; zero values mean "any"
+type UserFilter struct {
+	Email       string // substring, case-insensitive
+	Phone       string // substring
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Verified    *bool // email or phone verified
+	Disabled    *bool
+	Role        string
+	Limit       int
+	Offset      int
+}
+
+// ----------------- Session Model -----------------
+type Session struct {
+	ID               string     `db:"id"`                 // UUID, also the "sid" token claim
+	UserID           int64      `db:"user_id"`            // Foreign key to users
+	FamilyID         string     `db:"family_id"`          // Refresh token rotation family
+	RefreshJTI       string     `db:"refresh_jti"`        // JTI of the only refresh token still valid
+	RefreshTokenHash string     `db:"refresh_token_hash"` // sha256 of the current refresh token
+	UserAgent        string     `db:"user_agent"`         // Device / browser
+	IPAddress        string     `db:"ip_address"`         // Client IP at last use
+	CreatedAt        time.Time  `db:"created_at"`         // Sign-in time
+	LastUsedAt       time.Time  `db:"last_used_at"`       // Last refresh
+	ExpiresAt        time.Time  `db:"expires_at"`         // Refresh token expiry
+	RevokedAt        *time.Time `db:"revoked_at"`         // Set on logout / revoke
+	MFA              bool       `db:"mfa"`                // Signed in with a second factor
+}
+
+// ----------------- Security Event Model -----------------
+type SecurityEvent struct {
+	ID        int64          `db:"id"`
+	UserID    int64          `db:"user_id"`
+	EventType string         `db:"event_type"` // e.g. refresh_token_reuse
+	SessionID string         `db:"session_id"`
+	IPAddress string         `db:"ip_address"`
+	UserAgent string         `db:"user_agent"`
+	Details   map[string]any `db:"details"` // JSONB
+	CreatedAt time.Time      `db:"created_at"`
+}
+
+// ----------------- MFA Model -----------------
+type UserMFA struct {
+	UserID       int64      `db:"user_id"`
+	TOTPSecret   string     `db:"totp_secret"`    // base32 RFC 6238 secret
+	Enabled      bool       `db:"enabled"`        // false until the first code is confirmed
+	LastUsedStep int64      `db:"last_used_step"` // last accepted time step, blocks code replay
+	CreatedAt    time.Time  `db:"created_at"`
+	EnabledAt    *time.Time `db:"enabled_at"`
+}
+
+// ----------------- API Key Model -----------------
+type APIKey struct {
+	ID         int64      `db:"id"`
+	Name       string     `db:"name"`
+	Prefix     string     `db:"prefix"`   // ak_<8 hex>, public lookup part
+	KeyHash    string     `db:"key_hash"` // sha256 of the full key
+	Scopes     []string   `db:"scopes"`
+	CreatedBy  *int64     `db:"created_by"`
+	CreatedAt  time.Time  `db:"created_at"`
+	ExpiresAt  *time.Time `db:"expires_at"`
+	LastUsedAt *time.Time `db:"last_used_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+}
+
+// ----------------- Passkey Model -----------------
+type Passkey struct {
+	ID             int64      `db:"id"`
+	UserID         int64      `db:"user_id"`
+	CredentialID   []byte     `db:"credential_id"` // WebAuthn credential id, unique per authenticator
+	PublicKey      []byte     `db:"public_key"`    // COSE_Key
+	Algorithm      int64      `db:"algorithm"`     // COSE algorithm, e.g. -7 (ES256)
+	SignCount      int64      `db:"sign_count"`
+	AAGUID         []byte     `db:"aaguid"`
+	Transports     []string   `db:"transports"`
+	Name           string     `db:"name"`
+	BackupEligible bool       `db:"backup_eligible"` // synced passkey
+	BackedUp       bool       `db:"backed_up"`
+	CreatedAt      time.Time  `db:"created_at"`
+	LastUsedAt     *time.Time `db:"last_used_at"`
+}
+
+// ErrCredentialExists is returned when a passkey is registered a second time
+var ErrCredentialExists = errors.New("credential already registered")
+
+// ----------------- Contact Change Model -----------------
+type ContactChange struct {
+	UserID    int64     `db:"user_id"`
+	Kind      string    `db:"kind"`       // email | phone
+	NewValue  string    `db:"new_value"`  // address / number waiting for confirmation
+	TokenHash string    `db:"token_hash"` // email only: sha256 of the link token
+	Expiry    time.Time `db:"expiry"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// ErrContactTaken is returned when an email or phone already belongs to another account
+var ErrContactTaken = errors.New("contact already in use")
+
+// ErrDeviceMismatch is returned when a device-bound token is used from another device
+var ErrDeviceMismatch = errors.New("token is bound to another device")
+
+// ----------------- Privacy Job Model -----------------
+type PrivacyJob struct {
+	ID          string     `db:"id"`         // UUID
+	UserID      int64      `db:"user_id"`    // Foreign key to users
+	Kind        string     `db:"kind"`       // export | erasure
+	Status      string     `db:"status"`     // queued | running | completed | failed
+	Progress    int        `db:"progress"`   // percent done
+	Step        string     `db:"step"`       // store being processed
+	ObjectKey   string     `db:"object_key"` // export only: archive in MinIO
+	Error       string     `db:"error"`      // why the job failed
+	CreatedAt   time.Time  `db:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+	CompletedAt *time.Time `db:"completed_at"`
+	ExpiresAt   *time.Time `db:"expires_at"` // export only: end of the download window
 }
 
 // ----------------- Product Model -----------------
 type Product struct {
-	ID          int       `db:"id"`          // Primary Key
-	Name        string    `db:"name"`        // Product name
-	Description string    `db:"description"` // Product description
-	Price       float64   `db:"price"`       // Product price
-	Inventory   int       `db:"inventory"`   // Stock quantity
-	CreatedAt   time.Time `db:"created_at"`  // Creation timestamp
-	UpdatedAt   time.Time `db:"updated_at"`  // Optional update timestamp
+	ID          int        `db:"id"`          // Primary Key
+	Name        string     `db:"name"`        // Product name
+	Description string     `db:"description"` // Product description
+	Price       float64    `db:"price"`       // Product price
+	Inventory   int        `db:"inventory"`   // Stock quantity
+	CategoryID  *int64     `db:"category_id"` // Category, if assigned
+	Images      []string   `db:"images"`      // Image URLs, first is the cover
+	Popularity  int        `db:"popularity"`  // Units sold; the popularity sort key
+	Currency    string     `db:"currency"`    // ISO 4217 code of Price
+	Version     int64      `db:"version"`     // Bumped on every change, for optimistic locking
+	ArchivedAt  *time.Time `db:"archived_at"` // Soft delete; still resolvable by id
+	CreatedAt   time.Time  `db:"created_at"`  // Creation timestamp
+	UpdatedAt   time.Time  `db:"updated_at"`  // Optional update timestamp
+
+	Options    []ProductOption    `db:"options"` // Axes the variants pick from (JSONB)
+	Variants   []*ProductVariant  `db:"-"`       // Live SKUs; loaded with ListVariants
+	Attributes []ProductAttribute `db:"-"`       // Loaded with ListProductAttributes; written with the product
+}
+
+// ProductOption is one axis a product varies along, e.g. Purity: 14K, 18K, 22K
+type ProductOption struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// ErrStaleProduct is returned when a product changed since the version an update was based on
+var ErrStaleProduct = errors.New("product was modified concurrently")
+
+// Product list sort orders
+const (
+	ProductSortNewest    = "newest"
+	ProductSortPriceAsc  = "price_asc"
+	ProductSortPriceDesc = "price_desc"
+	ProductSortPopular   = "popularity"
+)
+
+// productOrderBy maps a sort order to its ORDER BY clause; id breaks ties so pages are stable
+var productOrderBy = map[string]string{
+	ProductSortNewest:    "created_at DESC, id DESC",
+	ProductSortPriceAsc:  "price ASC, id ASC",
+	ProductSortPriceDesc: "price DESC, id DESC",
+	ProductSortPopular:   "popularity DESC, id DESC",
+}
+
+// ProductFilter narrows the storefront product listing; zero values mean "any"
+type ProductFilter struct {
+	Search     string // substring of name or description, case-insensitive
+	CategoryID *int64
+	MinPrice   *float64
+	MaxPrice   *float64
+	InStock    bool
+	Attributes []AttributeFilter // all must match
+	Sort       string            // one of the ProductSort values; newest when empty
+	Limit      int
+	Offset     int
+}
+
+// AttributeFilter matches products whose attribute Name is one of Values
+// (compared in lower case) or, when Values is empty, a number between Min and Max
+type AttributeFilter struct {
+	Name   string
+	Values []string
+	Min    *float64
+	Max    *float64
 }
 
+// FacetCount is how many products in a filtered listing have Value for attribute Name
+type FacetCount struct {
+	Name  string
+	Value string
+	Count int64
+}
+
+// ----------------- Product Variant Model -----------------
+// ProductVariant is a SKU: one combination of option values. While a product
+// has live variants its price and inventory are their minimum and sum.
+type ProductVariant struct {
+	ID          int64             `db:"id"`           // Primary Key
+	ProductID   int               `db:"product_id"`   // Foreign key to products
+	SKU         string            `db:"sku"`          // Merchant SKU code, unique
+	Options     map[string]string `db:"options"`      // Option name -> value (JSONB)
+	Price       float64           `db:"price"`        // Unit price, in the product currency
+	WeightGrams float64           `db:"weight_grams"` // Metal weight
+	Stock       int               `db:"stock"`        // Units on hand
+	Barcode     *string           `db:"barcode"`      // GTIN, unique when set
+	ArchivedAt  *time.Time        `db:"archived_at"`  // Soft delete; carts and orders still resolve it
+	CreatedAt   time.Time         `db:"created_at"`
+	UpdatedAt   time.Time         `db:"updated_at"`
+}
+
+// Variant errors
+var (
+	ErrSKUTaken      = errors.New("sku already in use")
+	ErrBarcodeTaken  = errors.New("barcode already in use")
+	ErrVariantExists = errors.New("product already has a variant with these options")
+)
+
+// ----------------- Attribute Models -----------------
+// AttributeDefinition types one product attribute of a category; subcategories inherit it
+type AttributeDefinition struct {
+	ID         int64     `db:"id"`             // Primary Key
+	CategoryID int64     `db:"category_id"`    // Category it is defined on
+	Code       string    `db:"code"`           // Key in product attributes, unique per category
+	Label      string    `db:"label"`          // Display name
+	Type       string    `db:"type"`           // One of the Attribute type values
+	Values     []string  `db:"allowed_values"` // Allowed values of an enum attribute
+	Unit       string    `db:"unit"`           // Unit of a number attribute
+	Min        *float64  `db:"min_value"`      // Bounds of a number attribute
+	Max        *float64  `db:"max_value"`
+	Required   bool      `db:"required"` // Products must set it
+	Position   int       `db:"position"` // Display order
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+// Attribute types
+const (
+	AttributeEnum    = "enum"
+	AttributeNumber  = "number"
+	AttributeBoolean = "boolean"
+)
+
+// ProductAttribute is a product's value for one attribute
+type ProductAttribute struct {
+	Name   string   `db:"attribute_name"`  // Attribute code
+	Value  string   `db:"attribute_value"` // Canonical text form
+	Number *float64 `db:"value_num"`       // Set for number attributes, for range filters
+}
+
+// ErrAttributeExists is returned when a category already defines an attribute code
+var ErrAttributeExists = errors.New("category already defines this attribute")
+
+// ----------------- Category Model -----------------
+type Category struct {
+	ID        int64     `db:"id"`        // Primary Key
+	Name      string    `db:"name"`      // Display name
+	Slug      string    `db:"slug"`      // URL key, unique
+	ParentID  *int64    `db:"parent_id"` // nil for a top-level category
+	Position  int       `db:"position"`  // Order among siblings
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// Category errors
+var (
+	ErrSlugTaken     = errors.New("category slug already in use")
+	ErrCategoryCycle = errors.New("category cannot be moved under itself or a descendant")
+	ErrCategoryDepth = errors.New("category tree would be nested too deep")
+	ErrCategoryInUse = errors.New("category has subcategories or products")
+	ErrUnknownParent = errors.New("parent category does not exist")
+)
+
 // ----------------- Order Model -----------------
 type Order struct {
 	ID        int       `db:"id"`         // Primary Key
@@ -46,33 +315,56 @@ type OrderItem struct {
 	ID        int     `db:"id"`         // Primary Key
 	OrderID   int     `db:"order_id"`   // Foreign key to orders
 	ProductID int     `db:"product_id"` // Foreign key to products
+	SkuID     *int64  `db:"sku_id"`     // Variant bought; required on new lines, nil on lines from before variants
 	Quantity  int     `db:"quantity"`   // Quantity of this product
 	Price     float64 `db:"price"`      // Price per unit
 }
 
+// ----------------- Cart Item Model -----------------
+type CartItem struct {
+	ID        int       `db:"id"`         // Primary Key
+	UserID    int64     `db:"user_id"`    // Foreign key to users
+	ProductID int       `db:"product_id"` // Foreign key to products
+	SkuID     *int64    `db:"sku_id"`     // Variant added; required on new lines, nil on lines from before variants
+	Quantity  int       `db:"quantity"`   // Units in the cart
+	CreatedAt time.Time `db:"created_at"` // Added to cart
+}
+
 // ----------------- Inventory Model -----------------
 type Inventory struct {
 	ID        int       `db:"id"`         // Primary Key
 	ProductID int       `db:"product_id"` // Foreign key to products
+	SkuID     *int64    `db:"sku_id"`     // Variant the stock is of; nil only on rows from before variants
 	Quantity  int       `db:"quantity"`   // Current stock
 	UpdatedAt time.Time `db:"updated_at"` // Last stock update
 }
 
 // ----------------- User CRUD -----------------
+// isUniqueViolation reports whether err is a Postgres unique constraint violation
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+// CreateUser inserts a new account; returns ErrContactTaken when the email or
+// phone is already registered.
 func (p *PostgresProvider) CreateUser(ctx context.Context, u *User) (int, error) {
 	logs.Info(ctx, "Created User")
 	var id int
 	err := p.Pool.QueryRow(ctx,
-		`INSERT INTO users (name,email,password,created_at) VALUES ($1,$2,$3,$4) RETURNING id`,
-		u.Name, u.Email, u.Password, u.CreatedAt).Scan(&id)
+		`INSERT INTO users (name,email,phone,password,phone_verified,created_at) VALUES ($1,NULLIF($2,''),NULLIF($3,''),$4,$5,$6) RETURNING id`,
+		u.Name, u.Email, u.Phone, u.Password, u.PhoneVerified, u.CreatedAt).Scan(&id)
+	if isUniqueViolation(err) {
+		return 0, ErrContactTaken
+	}
 	return id, err
 }
 
 func (p *PostgresProvider) GetUser(ctx context.Context, id int) (*User, error) {
 	u := &User{}
 	err := p.Pool.QueryRow(ctx,
-		`SELECT id,name,email,password,created_at FROM users WHERE id=$1`, id).
-		Scan(&u.ID, &u.Name, &u.Email, &u.Password, &u.CreatedAt)
+		`SELECT id,name,COALESCE(email,''),COALESCE(phone,''),password,email_verified,phone_verified,created_at,COALESCE(updated_at,created_at),disabled_at,deleted_at FROM users WHERE id=$1`, id).
+		Scan(&u.ID, &u.Name, &u.Email, &u.Phone, &u.Password, &u.EmailVerified, &u.PhoneVerified, &u.CreatedAt, &u.UpdatedAt, &u.DisabledAt, &u.DeletedAt)
 	if err != nil {
 		logs.Errorf(ctx, "failed to get user with id %d: %v", id, err)
 		return nil, err
@@ -83,8 +375,8 @@ func (p *PostgresProvider) GetUser(ctx context.Context, id int) (*User, error) {
 func (p *PostgresProvider) GetUserByEmail(ctx context.Context, email string) (*User, error) {
 	u := &User{}
 	err := p.Pool.QueryRow(ctx,
-		`SELECT id,name,email,password,created_at FROM users WHERE email=$1`, email).
-		Scan(&u.ID, &u.Name, &u.Email, &u.Password, &u.CreatedAt)
+		`SELECT id,name,email,COALESCE(phone,''),password,email_verified,phone_verified,created_at,disabled_at,deleted_at FROM users WHERE email=$1`, email).
+		Scan(&u.ID, &u.Name, &u.Email, &u.Phone, &u.Password, &u.EmailVerified, &u.PhoneVerified, &u.CreatedAt, &u.DisabledAt, &u.DeletedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -94,161 +386,2029 @@ func (p *PostgresProvider) GetUserByEmail(ctx context.Context, email string) (*U
 func (p *PostgresProvider) GetUserByPhone(ctx context.Context, phone string) (*User, error) {
 	u := &User{}
 	err := p.Pool.QueryRow(ctx,
-		`SELECT id,name,email,password,created_at FROM users WHERE phone=$1`, phone).
-		Scan(&u.ID, &u.Name, &u.Email, &u.Password, &u.CreatedAt)
+		`SELECT id,name,COALESCE(email,''),COALESCE(phone,''),password,email_verified,phone_verified,created_at,disabled_at,deleted_at FROM users WHERE phone=$1`, phone).
+		Scan(&u.ID, &u.Name, &u.Email, &u.Phone, &u.Password, &u.EmailVerified, &u.PhoneVerified, &u.CreatedAt, &u.DisabledAt, &u.DeletedAt)
 	if err != nil {
 		return nil, err
 	}
 	return u, nil
 }
 
-// UpdateUserTokens updates the access and refresh tokens for a user
-// UpdateRefreshToken updates the refresh token for a user
-func (p *PostgresProvider) UpdateUserTokens(ctx context.Context, userID int, refreshToken string, accessToken string) error {
-	_, err := p.Pool.Exec(ctx,
-		`UPDATE users 
-		 SET refresh_token = $1, access_token = $2, updated_at = NOW() 
-		 WHERE id = $3`,
-		refreshToken, accessToken, userID,
-	)
+// CreatePhoneUser creates a passwordless account on first OTP login; the phone is verified by that login
+func (p *PostgresProvider) CreatePhoneUser(ctx context.Context, phone string) (int64, error) {
+	var id int64
+	err := p.Pool.QueryRow(ctx,
+		`INSERT INTO users (name,phone,password,phone_verified,created_at) VALUES ('',$1,'',TRUE,NOW()) RETURNING id`,
+		phone).Scan(&id)
+	if isUniqueViolation(err) {
+		return 0, ErrContactTaken
+	}
 	if err != nil {
-		return fmt.Errorf("failed to update refresh token for user %d: %w", userID, err)
+		logs.Errorf(ctx, "failed to create phone user: %v", err)
 	}
-	return nil
+	return id, err
 }
 
-func (p *PostgresProvider) UpdateUser(ctx context.Context, u User) error {
+// UpdateUserName saves the display name
+func (p *PostgresProvider) UpdateUserName(ctx context.Context, userID int64, name string) error {
 	_, err := p.Pool.Exec(ctx,
-		`UPDATE users SET name=$1,email=$2,password=$3 WHERE id=$4`,
-		u.Name, u.Email, u.Password, u.ID)
+		`UPDATE users SET name = $1, updated_at = NOW() WHERE id = $2`, name, userID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to update name for user %d: %v", userID, err)
+	}
 	return err
 }
 
-func (p *PostgresProvider) DeleteUser(ctx context.Context, id int) error {
-	_, err := p.Pool.Exec(ctx, `DELETE FROM users WHERE id=$1`, id)
+// UpdatePassword saves a new password hash
+func (p *PostgresProvider) UpdatePassword(ctx context.Context, userID int64, passwordHash string) error {
+	_, err := p.Pool.Exec(ctx,
+		`UPDATE users SET password = $1, updated_at = NOW() WHERE id = $2`, passwordHash, userID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to update password for user %d: %v", userID, err)
+	}
 	return err
 }
 
-// ----------------- Product CRUD -----------------
-func (p *PostgresProvider) CreateProduct(ctx context.Context, prod Product) (int, error) {
-	var id int
-	err := p.Pool.QueryRow(ctx,
-		`INSERT INTO products (name,description,price,inventory,created_at) VALUES ($1,$2,$3,$4,$5) RETURNING id`,
-		prod.Name, prod.Description, prod.Price, prod.Inventory, prod.CreatedAt).Scan(&id)
-	return id, err
+// SetEmailVerified marks the email verified, only if it is still the user's email
+func (p *PostgresProvider) SetEmailVerified(ctx context.Context, userID int64, email string) error {
+	tag, err := p.Pool.Exec(ctx,
+		`UPDATE users SET email_verified = TRUE, updated_at = NOW() WHERE id = $1 AND email = $2`, userID, email)
+	if err != nil {
+		logs.Errorf(ctx, "failed to mark email verified for user %d: %v", userID, err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
 }
 
-func (p *PostgresProvider) GetProduct(ctx context.Context, id int) (*Product, error) {
-	prod := &Product{}
-	err := p.Pool.QueryRow(ctx,
-		`SELECT id,name,description,price,inventory,created_at FROM products WHERE id=$1`, id).
-		Scan(&prod.ID, &prod.Name, &prod.Description, &prod.Price, &prod.Inventory, &prod.CreatedAt)
-	return prod, err
+// SetPhoneVerified marks the phone verified, only if it is still the user's phone
+func (p *PostgresProvider) SetPhoneVerified(ctx context.Context, userID int64, phone string) error {
+	tag, err := p.Pool.Exec(ctx,
+		`UPDATE users SET phone_verified = TRUE, updated_at = NOW() WHERE id = $1 AND phone = $2`, userID, phone)
+	if err != nil {
+		logs.Errorf(ctx, "failed to mark phone verified for user %d: %v", userID, err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
 }
 
-func (p *PostgresProvider) UpdateProduct(ctx context.Context, prod Product) error {
-	_, err := p.Pool.Exec(ctx,
-		`UPDATE products SET name=$1,description=$2,price=$3,inventory=$4 WHERE id=$5`,
-		prod.Name, prod.Description, prod.Price, prod.Inventory, prod.ID)
-	return err
+// ListUsers returns one page of users matching f (anonymised accounts excluded) and the total match count
+func (p *PostgresProvider) ListUsers(ctx context.Context, f UserFilter) ([]*User, int64, error) {
+	where := []string{"u.deleted_at IS NULL"}
+	args := []any{}
+	add := func(cond string, v any) {
+		args = append(args, v)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+
+	// strpos rather than LIKE, so % and _ in the search are matched literally
+	if f.Email != "" {
+		add("strpos(lower(u.email), lower($%d)) > 0", f.Email)
+	}
+	if f.Phone != "" {
+		add("strpos(u.phone, $%d) > 0", f.Phone)
+	}
+	if f.CreatedFrom != nil {
+		add("u.created_at >= $%d", *f.CreatedFrom)
+	}
+	if f.CreatedTo != nil {
+		add("u.created_at < $%d", *f.CreatedTo)
+	}
+	if f.Verified != nil {
+		add("(u.email_verified OR u.phone_verified) = $%d", *f.Verified)
+	}
+	if f.Disabled != nil {
+		add("(u.disabled_at IS NOT NULL) = $%d", *f.Disabled)
+	}
+	if f.Role != "" {
+		add("EXISTS (SELECT 1 FROM user_roles r WHERE r.user_id = u.id AND r.role = $%d)", f.Role)
+	}
+
+	args = append(args, f.Limit, f.Offset)
+	query := fmt.Sprintf(`
+		SELECT u.id, u.name, COALESCE(u.email,''), COALESCE(u.phone,''), u.email_verified, u.phone_verified,
+		       u.created_at, COALESCE(u.updated_at, u.created_at), u.disabled_at,
+		       ARRAY(SELECT role FROM user_roles r WHERE r.user_id = u.id ORDER BY role),
+		       COUNT(*) OVER()
+		FROM users u
+		WHERE %s
+		ORDER BY u.id
+		LIMIT $%d OFFSET $%d`, strings.Join(where, " AND "), len(args)-1, len(args))
+
+	rows, err := p.Pool.Query(ctx, query, args...)
+	if err != nil {
+		logs.Errorf(ctx, "failed to list users: %v", err)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var total int64
+	users := []*User{}
+	for rows.Next() {
+		u := &User{}
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email, &u.Phone, &u.EmailVerified, &u.PhoneVerified,
+			&u.CreatedAt, &u.UpdatedAt, &u.DisabledAt, &u.Roles, &total); err != nil {
+			return nil, 0, err
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	// A page past the end has no rows to carry COUNT(*) OVER(), so count separately
+	if len(users) == 0 && f.Offset > 0 {
+		err := p.Pool.QueryRow(ctx,
+			`SELECT COUNT(*) FROM users u WHERE `+strings.Join(where, " AND "), args[:len(args)-2]...).Scan(&total)
+		if err != nil {
+			logs.Errorf(ctx, "failed to count users: %v", err)
+			return nil, 0, err
+		}
+	}
+	return users, total, nil
 }
 
-func (p *PostgresProvider) DeleteProduct(ctx context.Context, id int) error {
-	_, err := p.Pool.Exec(ctx, `DELETE FROM products WHERE id=$1`, id)
-	return err
+// UpdateUserProfile saves name, email and phone as set by an admin; changed contacts lose their verified flag
+func (p *PostgresProvider) UpdateUserProfile(ctx context.Context, u *User) error {
+	tag, err := p.Pool.Exec(ctx,
+		`UPDATE users SET name=$1, email=NULLIF($2,''), phone=NULLIF($3,''),
+		 email_verified = CASE WHEN email IS DISTINCT FROM NULLIF($2,'') THEN FALSE ELSE email_verified END,
+		 phone_verified = CASE WHEN phone IS DISTINCT FROM NULLIF($3,'') THEN FALSE ELSE phone_verified END,
+		 updated_at = NOW()
+		 WHERE id=$4 AND deleted_at IS NULL`,
+		u.Name, u.Email, u.Phone, u.ID)
+	if isUniqueViolation(err) {
+		return ErrContactTaken
+	}
+	if err != nil {
+		logs.Errorf(ctx, "failed to update user %d: %v", u.ID, err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
 }
 
-// ----------------- Order CRUD -----------------
-func (p *PostgresProvider) CreateOrder(ctx context.Context, order Order, items []OrderItem) (int, error) {
+// SetUserRoles replaces the roles of a user
+func (p *PostgresProvider) SetUserRoles(ctx context.Context, userID int64, roles []string) error {
 	tx, err := p.Pool.Begin(ctx)
 	if err != nil {
-		return 0, err
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM user_roles WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO user_roles (user_id, role) VALUES ($1, $2) ON CONFLICT DO NOTHING`, userID, role); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// SetUserDisabled disables or re-enables an account; returns pgx.ErrNoRows if
+// the user does not exist, was anonymised or is already in that state.
+func (p *PostgresProvider) SetUserDisabled(ctx context.Context, userID int64, disabled bool) error {
+	query := `UPDATE users SET disabled_at = NULL, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL AND disabled_at IS NOT NULL`
+	if disabled {
+		query = `UPDATE users SET disabled_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL AND disabled_at IS NULL`
+	}
+	tag, err := p.Pool.Exec(ctx, query, userID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to set disabled=%t for user %d: %v", disabled, userID, err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// AnonymizeUser erases the personal data of an account but keeps its row (and
+// ID), so orders and other history that reference it stay intact. Sessions,
+// roles, MFA, passkeys and pending tokens are removed. Returns pgx.ErrNoRows if the user
+// does not exist or was already anonymised.
+func (p *PostgresProvider) AnonymizeUser(ctx context.Context, userID int64) error {
+	tx, err := p.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx,
+		`UPDATE users SET name = 'Deleted user', email = NULL, phone = NULL, password = '',
+		 email_verified = FALSE, phone_verified = FALSE,
+		 disabled_at = COALESCE(disabled_at, NOW()), deleted_at = NOW(), updated_at = NOW()
+		 WHERE id = $1 AND deleted_at IS NULL`, userID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to anonymise user %d: %v", userID, err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	for _, q := range []string{
+		`UPDATE sessions SET revoked_at = NOW(), user_agent = NULL, ip_address = NULL WHERE user_id = $1`,
+		`DELETE FROM user_roles WHERE user_id = $1`,
+		`DELETE FROM password_resets WHERE user_id = $1`,
+		`DELETE FROM email_verifications WHERE user_id = $1`,
+		`DELETE FROM contact_changes WHERE user_id = $1`,
+		`DELETE FROM mfa_recovery_codes WHERE user_id = $1`,
+		`DELETE FROM user_mfa WHERE user_id = $1`,
+		`DELETE FROM magic_links WHERE user_id = $1`,
+		`DELETE FROM webauthn_credentials WHERE user_id = $1`,
+	} {
+		if _, err := tx.Exec(ctx, q, userID); err != nil {
+			logs.Errorf(ctx, "failed to anonymise user %d: %v", userID, err)
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// ----------------- Product CRUD -----------------
+// CreateProduct inserts prod with its attributes and fills in its id, version and timestamps
+func (p *PostgresProvider) CreateProduct(ctx context.Context, prod *Product) error {
+	tx, err := p.Pool.Begin(ctx)
+	if err != nil {
+		return err
 	}
 	defer tx.Rollback(ctx)
 
-	var orderID int
 	err = tx.QueryRow(ctx,
-		`INSERT INTO orders (user_id,total,status,created_at) VALUES ($1,$2,$3,$4) RETURNING id`,
-		order.UserID, order.Total, order.Status, order.CreatedAt).Scan(&orderID)
+		`INSERT INTO products (name, description, price, currency, inventory, category_id, images, options)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		 RETURNING id, version, created_at, created_at`,
+		prod.Name, prod.Description, prod.Price, prod.Currency, prod.Inventory, prod.CategoryID, prod.Images, prod.Options).
+		Scan(&prod.ID, &prod.Version, &prod.CreatedAt, &prod.UpdatedAt)
+	if err == nil {
+		err = writeProductAttributes(ctx, tx, prod)
+	}
 	if err != nil {
-		return 0, err
+		logs.Errorf(ctx, "failed to create product: %v", err)
+		return err
 	}
+	return tx.Commit(ctx)
+}
 
-	for _, item := range items {
-		_, err := tx.Exec(ctx,
-			`INSERT INTO order_items (order_id,product_id,quantity,price) VALUES ($1,$2,$3,$4)`,
-			orderID, item.ProductID, item.Quantity, item.Price)
-		if err != nil {
-			return 0, err
+// UpdateProduct writes prod and replaces its attributes if the stored row is
+// still at prod.Version, then fills in the new version. Returns ErrStaleProduct
+// if someone else changed it first and pgx.ErrNoRows if it does not exist or is archived.
+func (p *PostgresProvider) UpdateProduct(ctx context.Context, prod *Product) error {
+	tx, err := p.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx,
+		`UPDATE products
+		 SET name = $1, description = $2, price = $3, currency = $4, inventory = $5, category_id = $6, images = $7,
+		     options = $8, version = version + 1, updated_at = NOW()
+		 WHERE id = $9 AND version = $10 AND archived_at IS NULL
+		 RETURNING version, updated_at`,
+		prod.Name, prod.Description, prod.Price, prod.Currency, prod.Inventory, prod.CategoryID, prod.Images,
+		prod.Options, prod.ID, prod.Version).Scan(&prod.Version, &prod.UpdatedAt)
+	if err == nil {
+		if err := writeProductAttributes(ctx, tx, prod); err != nil {
+			logs.Errorf(ctx, "failed to write attributes of product %d: %v", prod.ID, err)
+			return err
 		}
+		return tx.Commit(ctx)
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return err
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return 0, err
+	// Nothing matched: tell a lost race from a missing product
+	var archived bool
+	if err := p.Pool.QueryRow(ctx,
+		`SELECT archived_at IS NOT NULL FROM products WHERE id = $1`, prod.ID).Scan(&archived); err != nil {
+		return err
 	}
-	return orderID, nil
+	if archived {
+		return pgx.ErrNoRows
+	}
+	return ErrStaleProduct
 }
 
-func (p *PostgresProvider) GetOrder(ctx context.Context, orderID int) (*Order, []OrderItem, error) {
-	order := &Order{}
-	err := p.Pool.QueryRow(ctx,
-		`SELECT id,user_id,total,status,created_at FROM orders WHERE id=$1`, orderID).
-		Scan(&order.ID, &order.UserID, &order.Total, &order.Status, &order.CreatedAt)
+// ArchiveProduct soft-deletes a product. Returns pgx.ErrNoRows if it does not exist or is already archived.
+func (p *PostgresProvider) ArchiveProduct(ctx context.Context, id int) error {
+	tag, err := p.Pool.Exec(ctx,
+		`UPDATE products SET archived_at = NOW(), version = version + 1, updated_at = NOW()
+		 WHERE id = $1 AND archived_at IS NULL`, id)
 	if err != nil {
-		return nil, nil, err
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
 	}
+	return nil
+}
 
-	rows, err := p.Pool.Query(ctx,
-		`SELECT id,order_id,product_id,quantity,price FROM order_items WHERE order_id=$1`, orderID)
+const productColumns = `id, name, COALESCE(description,''), price, inventory, category_id, images, popularity,
+	currency, version, archived_at, created_at, COALESCE(updated_at, created_at), options`
+
+// productTargets returns the scan destinations for productColumns, in the same order
+func productTargets(prod *Product) []any {
+	return []any{&prod.ID, &prod.Name, &prod.Description, &prod.Price, &prod.Inventory, &prod.CategoryID,
+		&prod.Images, &prod.Popularity, &prod.Currency, &prod.Version, &prod.ArchivedAt, &prod.CreatedAt, &prod.UpdatedAt,
+		&prod.Options}
+}
+
+func scanProduct(row pgx.Row) (*Product, error) {
+	prod := &Product{}
+	if err := row.Scan(productTargets(prod)...); err != nil {
+		return nil, err
+	}
+	return prod, nil
+}
+
+// GetProduct returns a product by id, archived or not, so past orders can still show it
+func (p *PostgresProvider) GetProduct(ctx context.Context, id int) (*Product, error) {
+	return scanProduct(p.Pool.QueryRow(ctx, `SELECT `+productColumns+` FROM products WHERE id=$1`, id))
+}
+
+// productWhere builds the WHERE conditions of f over products, leaving out
+// the attribute filter on skipAttr (for facet counts)
+func productWhere(f ProductFilter, skipAttr string) ([]string, []any) {
+	where := []string{"archived_at IS NULL"}
+	args := []any{}
+	add := func(cond string, vs ...any) {
+		idx := make([]any, 0, len(vs))
+		for _, v := range vs {
+			args = append(args, v)
+			idx = append(idx, len(args))
+		}
+		where = append(where, fmt.Sprintf(cond, idx...))
+	}
+
+	// strpos rather than ILIKE, so % and _ in the search are matched literally (as in ListUsers)
+	if f.Search != "" {
+		add("(strpos(lower(name), lower($%[1]d)) > 0 OR strpos(lower(description), lower($%[1]d)) > 0)", f.Search)
+	}
+	if f.CategoryID != nil {
+		// the category and everything below it
+		add("category_id IN ("+categorySubtree("$%d")+")", *f.CategoryID)
+	}
+	if f.MinPrice != nil {
+		add("price >= $%d", *f.MinPrice)
+	}
+	if f.MaxPrice != nil {
+		add("price <= $%d", *f.MaxPrice)
+	}
+	if f.InStock {
+		where = append(where, "inventory > 0")
+	}
+	for _, af := range f.Attributes {
+		if af.Name == skipAttr {
+			continue
+		}
+		cond := "pa.attribute_name = $%d"
+		vs := []any{af.Name}
+		if len(af.Values) > 0 {
+			cond += " AND LOWER(pa.attribute_value) = ANY($%d)"
+			vs = append(vs, af.Values)
+		} else {
+			cond += " AND pa.value_num IS NOT NULL"
+			if af.Min != nil {
+				cond += " AND pa.value_num >= $%d"
+				vs = append(vs, *af.Min)
+			}
+			if af.Max != nil {
+				cond += " AND pa.value_num <= $%d"
+				vs = append(vs, *af.Max)
+			}
+		}
+		add("EXISTS (SELECT 1 FROM product_attributes pa WHERE pa.product_id = products.id AND "+cond+")", vs...)
+	}
+	return where, args
+}
+
+// ListProducts returns one page of live (not archived) products matching f and the total match count
+func (p *PostgresProvider) ListProducts(ctx context.Context, f ProductFilter) ([]*Product, int64, error) {
+	where, args := productWhere(f, "")
+	orderBy, ok := productOrderBy[f.Sort]
+	if !ok {
+		orderBy = productOrderBy[ProductSortNewest]
+	}
+
+	args = append(args, f.Limit, f.Offset)
+	query := fmt.Sprintf(`
+		SELECT %s, COUNT(*) OVER()
+		FROM products
+		WHERE %s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d`, productColumns, strings.Join(where, " AND "), orderBy, len(args)-1, len(args))
+
+	rows, err := p.Pool.Query(ctx, query, args...)
 	if err != nil {
-		return nil, nil, err
+		logs.Errorf(ctx, "failed to list products: %v", err)
+		return nil, 0, err
 	}
 	defer rows.Close()
 
-	items := []OrderItem{}
+	var total int64
+	products := []*Product{}
 	for rows.Next() {
-		var item OrderItem
-		if err := rows.Scan(&item.ID, &item.OrderID, &item.ProductID, &item.Quantity, &item.Price); err != nil {
-			return nil, nil, err
+		prod := &Product{}
+		if err := rows.Scan(append(productTargets(prod), &total)...); err != nil {
+			return nil, 0, err
 		}
-		items = append(items, item)
+		products = append(products, prod)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
 	}
 
-	return order, items, nil
+	// A page past the end has no rows to carry COUNT(*) OVER(), so count separately
+	if len(products) == 0 && f.Offset > 0 {
+		where, args := productWhere(f, "")
+		err := p.Pool.QueryRow(ctx,
+			`SELECT COUNT(*) FROM products WHERE `+strings.Join(where, " AND "), args...).Scan(&total)
+		if err != nil {
+			logs.Errorf(ctx, "failed to count products: %v", err)
+			return nil, 0, err
+		}
+	}
+	return products, total, nil
 }
 
-func (p *PostgresProvider) GetRefreshToken(ctx context.Context, userID string) (string, error) {
-	var refreshToken string
+// RecordProductSales adds the units of sold order lines to each product's
+// popularity, the key of the popularity sort
+func (p *PostgresProvider) RecordProductSales(ctx context.Context, items []OrderItem) error {
+	ids := make([]int, 0, len(items))
+	qtys := make([]int, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ProductID)
+		qtys = append(qtys, item.Quantity)
+	}
+	_, err := p.Pool.Exec(ctx,
+		`UPDATE products SET popularity = popularity + s.units
+		 FROM (SELECT product_id, SUM(quantity) AS units
+		       FROM UNNEST($1::int[], $2::int[]) AS t(product_id, quantity)
+		       GROUP BY product_id) s
+		 WHERE products.id = s.product_id`, ids, qtys)
+	if err != nil {
+		logs.Errorf(ctx, "failed to record product sales: %v", err)
+	}
+	return err
+}
 
-	err := p.Pool.QueryRow(ctx,
-		`SELECT refresh_token FROM users WHERE id = $1`,
-		userID,
-	).Scan(&refreshToken)
+// ProductFacets counts the attribute values of the products matching f. Each
+// filtered attribute is counted without its own filter, so the values next to
+// the selected ones keep their counts.
+func (p *PostgresProvider) ProductFacets(ctx context.Context, f ProductFilter) ([]FacetCount, error) {
+	filtered := make([]string, 0, len(f.Attributes))
+	for _, af := range f.Attributes {
+		filtered = append(filtered, af.Name)
+	}
 
-	if err != nil {
-		logs.Errorf(ctx, "failed to get refresh token for user %s: %v", userID, err)
-		return "", err
+	facets := []FacetCount{}
+	count := func(skipAttr string) error {
+		where, args := productWhere(f, skipAttr)
+		if skipAttr != "" {
+			args = append(args, skipAttr)
+			where = append(where, fmt.Sprintf("a.attribute_name = $%d", len(args)))
+		} else {
+			args = append(args, filtered)
+			where = append(where, fmt.Sprintf("a.attribute_name <> ALL($%d)", len(args)))
+		}
+		rows, err := p.Pool.Query(ctx, fmt.Sprintf(`
+			SELECT a.attribute_name, a.attribute_value, COUNT(*)
+			FROM products JOIN product_attributes a ON a.product_id = products.id
+			WHERE %s
+			GROUP BY a.attribute_name, a.attribute_value`, strings.Join(where, " AND ")), args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var fc FacetCount
+			if err := rows.Scan(&fc.Name, &fc.Value, &fc.Count); err != nil {
+				return err
+			}
+			facets = append(facets, fc)
+		}
+		return rows.Err()
 	}
 
-	return refreshToken, nil
+	if err := count(""); err != nil {
+		logs.Errorf(ctx, "failed to count facets: %v", err)
+		return nil, err
+	}
+	for _, name := range filtered {
+		if err := count(name); err != nil {
+			logs.Errorf(ctx, "failed to count facet %s: %v", name, err)
+			return nil, err
+		}
+	}
+	return facets, nil
 }
 
-func (r *PostgresProvider) DeleteRefreshToken(ctx context.Context, userID string) error {
-	query := `UPDATE users SET refresh_token = NULL WHERE id = $1`
+// ----------------- Product Attributes -----------------
 
-	_, err := r.Pool.Exec(ctx, query, userID)
+// ListProductAttributes returns the attribute values of the given products, keyed by product id
+func (p *PostgresProvider) ListProductAttributes(ctx context.Context, productIDs []int) (map[int][]ProductAttribute, error) {
+	rows, err := p.Pool.Query(ctx,
+		`SELECT product_id, attribute_name, attribute_value, value_num::FLOAT8
+		 FROM product_attributes WHERE product_id = ANY($1)
+		 ORDER BY product_id, id`, productIDs)
 	if err != nil {
-		logs.Errorf(ctx, "failed to get refresh token for user %s: %v", userID, err)
-		return err
+		logs.Errorf(ctx, "failed to list product attributes: %v", err)
+		return nil, err
 	}
-	return err
+	defer rows.Close()
+
+	attrs := make(map[int][]ProductAttribute, len(productIDs))
+	for rows.Next() {
+		var id int
+		var a ProductAttribute
+		if err := rows.Scan(&id, &a.Name, &a.Value, &a.Number); err != nil {
+			return nil, err
+		}
+		attrs[id] = append(attrs[id], a)
+	}
+	return attrs, rows.Err()
 }
 
-func (r *PostgresProvider) SaveResetToken(ctx context.Context, userID string, token string, expiry time.Time) error {
+// writeProductAttributes replaces the stored attributes of prod with prod.Attributes
+func writeProductAttributes(ctx context.Context, tx pgx.Tx, prod *Product) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM product_attributes WHERE product_id = $1`, prod.ID); err != nil {
+		return err
+	}
+	for _, a := range prod.Attributes {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO product_attributes (product_id, attribute_name, attribute_value, value_num)
+			 VALUES ($1, $2, $3, $4)`, prod.ID, a.Name, a.Value, a.Number)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const attributeColumns = `id, category_id, code, label, type, allowed_values, unit, min_value::FLOAT8, max_value::FLOAT8,
+	required, position, created_at, COALESCE(updated_at, created_at)`
+
+func scanAttributeDefinition(row pgx.Row) (*AttributeDefinition, error) {
+	d := &AttributeDefinition{}
+	err := row.Scan(&d.ID, &d.CategoryID, &d.Code, &d.Label, &d.Type, &d.Values, &d.Unit, &d.Min, &d.Max,
+		&d.Required, &d.Position, &d.CreatedAt, &d.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// ListAttributeDefinitions returns the attributes products in a category can
+// have: its own and its ancestors', the nearest definition winning per code
+func (p *PostgresProvider) ListAttributeDefinitions(ctx context.Context, categoryID int64) ([]*AttributeDefinition, error) {
+	rows, err := p.Pool.Query(ctx,
+		`WITH RECURSIVE up AS (
+			SELECT id, parent_id, 0 AS depth FROM categories WHERE id = $1
+			UNION ALL
+			SELECT c.id, c.parent_id, up.depth + 1 FROM categories c JOIN up ON c.id = up.parent_id
+			WHERE up.depth < `+strconv.Itoa(MaxCategoryDepth)+`
+		 )
+		 SELECT `+attributeColumns+` FROM (
+			SELECT DISTINCT ON (d.code) d.* FROM attribute_definitions d JOIN up ON d.category_id = up.id
+			ORDER BY d.code, up.depth
+		 ) nearest
+		 ORDER BY position, code`, categoryID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to list attributes of category %d: %v", categoryID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	defs := []*AttributeDefinition{}
+	for rows.Next() {
+		d, err := scanAttributeDefinition(rows)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, d)
+	}
+	return defs, rows.Err()
+}
+
+// GetAttributeDefinition returns an attribute defined on the category itself
+func (p *PostgresProvider) GetAttributeDefinition(ctx context.Context, categoryID int64, code string) (*AttributeDefinition, error) {
+	return scanAttributeDefinition(p.Pool.QueryRow(ctx,
+		`SELECT `+attributeColumns+` FROM attribute_definitions WHERE category_id = $1 AND code = $2`, categoryID, code))
+}
+
+// CreateAttributeDefinition inserts d and fills in its id and timestamps.
+// Returns ErrAttributeExists if the category already defines d.Code.
+func (p *PostgresProvider) CreateAttributeDefinition(ctx context.Context, d *AttributeDefinition) error {
+	err := p.Pool.QueryRow(ctx,
+		`INSERT INTO attribute_definitions (category_id, code, label, type, allowed_values, unit, min_value, max_value, required, position)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		 RETURNING id, created_at, created_at`,
+		d.CategoryID, d.Code, d.Label, d.Type, d.Values, d.Unit, d.Min, d.Max, d.Required, d.Position).
+		Scan(&d.ID, &d.CreatedAt, &d.UpdatedAt)
+	if isUniqueViolation(err) {
+		return ErrAttributeExists
+	}
+	if err != nil {
+		logs.Errorf(ctx, "failed to create attribute %s: %v", d.Code, err)
+	}
+	return err
+}
+
+// UpdateAttributeDefinition writes d; its code and type never change
+func (p *PostgresProvider) UpdateAttributeDefinition(ctx context.Context, d *AttributeDefinition) error {
+	return p.Pool.QueryRow(ctx,
+		`UPDATE attribute_definitions
+		 SET label = $1, allowed_values = $2, unit = $3, min_value = $4, max_value = $5, required = $6, position = $7,
+		     updated_at = NOW()
+		 WHERE id = $8
+		 RETURNING updated_at`,
+		d.Label, d.Values, d.Unit, d.Min, d.Max, d.Required, d.Position, d.ID).Scan(&d.UpdatedAt)
+}
+
+// DeleteAttributeDefinition removes an attribute defined on the category.
+// Returns pgx.ErrNoRows if there is none.
+func (p *PostgresProvider) DeleteAttributeDefinition(ctx context.Context, categoryID int64, code string) error {
+	tag, err := p.Pool.Exec(ctx,
+		`DELETE FROM attribute_definitions WHERE category_id = $1 AND code = $2`, categoryID, code)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// ----------------- Product Variants -----------------
+
+const variantColumns = `id, product_id, sku, options, price, weight_grams, stock, barcode, archived_at,
+	created_at, COALESCE(updated_at, created_at)`
+
+func scanVariant(row pgx.Row) (*ProductVariant, error) {
+	v := &ProductVariant{}
+	err := row.Scan(&v.ID, &v.ProductID, &v.SKU, &v.Options, &v.Price, &v.WeightGrams, &v.Stock, &v.Barcode,
+		&v.ArchivedAt, &v.CreatedAt, &v.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListVariants returns the live variants of the given products, keyed by product id
+func (p *PostgresProvider) ListVariants(ctx context.Context, productIDs []int) (map[int][]*ProductVariant, error) {
+	rows, err := p.Pool.Query(ctx,
+		`SELECT `+variantColumns+` FROM product_variants
+		 WHERE product_id = ANY($1) AND archived_at IS NULL
+		 ORDER BY product_id, id`, productIDs)
+	if err != nil {
+		logs.Errorf(ctx, "failed to list variants: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	variants := make(map[int][]*ProductVariant, len(productIDs))
+	for rows.Next() {
+		v, err := scanVariant(rows)
+		if err != nil {
+			return nil, err
+		}
+		variants[v.ProductID] = append(variants[v.ProductID], v)
+	}
+	return variants, rows.Err()
+}
+
+// GetVariant returns a live variant of a product
+func (p *PostgresProvider) GetVariant(ctx context.Context, productID int, id int64) (*ProductVariant, error) {
+	return scanVariant(p.Pool.QueryRow(ctx,
+		`SELECT `+variantColumns+` FROM product_variants
+		 WHERE id = $1 AND product_id = $2 AND archived_at IS NULL`, id, productID))
+}
+
+// CreateVariant inserts v and fills in its id and timestamps. version is the
+// product version v's options were checked against. Returns pgx.ErrNoRows if
+// the product does not exist or is archived, ErrStaleProduct if it changed.
+func (p *PostgresProvider) CreateVariant(ctx context.Context, v *ProductVariant, version int64) error {
+	return p.writeVariant(ctx, v.ProductID, version, func(tx pgx.Tx) error {
+		return tx.QueryRow(ctx,
+			`INSERT INTO product_variants (product_id, sku, options, price, weight_grams, stock, barcode)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7)
+			 RETURNING id, created_at, created_at`,
+			v.ProductID, v.SKU, v.Options, v.Price, v.WeightGrams, v.Stock, v.Barcode).
+			Scan(&v.ID, &v.CreatedAt, &v.UpdatedAt)
+	})
+}
+
+// UpdateVariant writes v, checked against product version as in CreateVariant.
+// Returns pgx.ErrNoRows if it or its product is gone.
+func (p *PostgresProvider) UpdateVariant(ctx context.Context, v *ProductVariant, version int64) error {
+	return p.writeVariant(ctx, v.ProductID, version, func(tx pgx.Tx) error {
+		return tx.QueryRow(ctx,
+			`UPDATE product_variants
+			 SET sku = $1, options = $2, price = $3, weight_grams = $4, stock = $5, barcode = $6, updated_at = NOW()
+			 WHERE id = $7 AND product_id = $8 AND archived_at IS NULL
+			 RETURNING updated_at`,
+			v.SKU, v.Options, v.Price, v.WeightGrams, v.Stock, v.Barcode, v.ID, v.ProductID).Scan(&v.UpdatedAt)
+	})
+}
+
+// ArchiveVariant soft-deletes a variant. Returns pgx.ErrNoRows if it does not exist or is already archived.
+func (p *PostgresProvider) ArchiveVariant(ctx context.Context, productID int, id int64) error {
+	return p.writeVariant(ctx, productID, 0, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx,
+			`UPDATE product_variants SET archived_at = NOW(), updated_at = NOW()
+			 WHERE id = $1 AND product_id = $2 AND archived_at IS NULL`, id, productID)
+		if err == nil && tag.RowsAffected() == 0 {
+			return pgx.ErrNoRows
+		}
+		return err
+	})
+}
+
+// writeVariant runs write and then, in the same transaction, rolls the live
+// variants up into the product: lowest price, total stock and a new version.
+// The product row is locked first, so a write to an archived product fails
+// with pgx.ErrNoRows, and one whose options were checked against an older
+// version than the locked row's (version 0 skips this) with ErrStaleProduct.
+func (p *PostgresProvider) writeVariant(ctx context.Context, productID int, version int64, write func(tx pgx.Tx) error) error {
+	tx, err := p.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var current int64
+	if err := tx.QueryRow(ctx,
+		`SELECT version FROM products WHERE id = $1 AND archived_at IS NULL FOR UPDATE`, productID).Scan(&current); err != nil {
+		return err
+	}
+	if version != 0 && current != version {
+		return ErrStaleProduct
+	}
+	if err := write(tx); err != nil {
+		return variantError(ctx, err)
+	}
+
+	_, err = tx.Exec(ctx,
+		`UPDATE products SET
+		   price = COALESCE((SELECT MIN(price) FROM product_variants WHERE product_id = $1 AND archived_at IS NULL), price),
+		   inventory = (SELECT COALESCE(SUM(stock), 0) FROM product_variants WHERE product_id = $1 AND archived_at IS NULL),
+		   version = version + 1, updated_at = NOW()
+		 WHERE id = $1`, productID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to roll up variants of product %d: %v", productID, err)
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// variantError maps constraint violations on product_variants to their errors
+func variantError(ctx context.Context, err error) error {
+	var pgErr *pgconn.PgError
+	if !isUniqueViolation(err) || !errors.As(err, &pgErr) {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			logs.Errorf(ctx, "variant write failed: %v", err)
+		}
+		return err
+	}
+	switch pgErr.ConstraintName {
+	case "product_variants_sku_key":
+		return ErrSKUTaken
+	case "product_variants_barcode_key":
+		return ErrBarcodeTaken
+	}
+	return ErrVariantExists
+}
+
+// ----------------- Categories -----------------
+
+const categoryColumns = `id, name, slug, parent_id, position, created_at, COALESCE(updated_at, created_at)`
+
+// MaxCategoryDepth bounds every walk of the category tree, in SQL and in the
+// catalog, so a parent_id loop that slipped in cannot make it recurse forever
+const MaxCategoryDepth = 32
+
+// categorySubtree selects the ids of category root and everything below it
+func categorySubtree(root string) string {
+	return `WITH RECURSIVE sub AS (
+		SELECT id, 0 AS depth FROM categories WHERE id = ` + root + `
+		UNION ALL
+		SELECT c.id, sub.depth + 1 FROM categories c JOIN sub ON c.parent_id = sub.id
+		WHERE sub.depth < ` + strconv.Itoa(MaxCategoryDepth) + `
+	) SELECT id FROM sub`
+}
+
+// checkCategoryPlacement reports whether category id may sit under parent,
+// given the parent of every category (0 for a top-level one). It walks up
+// from parent to the root: meeting id on the way would close a loop, and the
+// path plus the height of the subtree below id must fit in MaxCategoryDepth.
+// A new category has id 0 and no subtree.
+func checkCategoryPlacement(parents map[int64]int64, id, parent int64) error {
+	if _, ok := parents[parent]; !ok {
+		return ErrUnknownParent
+	}
+	depth := 0 // of id once it is placed; top-level categories are at 0
+	for p := parent; p != 0; p = parents[p] {
+		if p == id {
+			return ErrCategoryCycle
+		}
+		if depth++; depth > MaxCategoryDepth {
+			return ErrCategoryDepth
+		}
+	}
+	if id == 0 {
+		return nil
+	}
+
+	children := make(map[int64][]int64, len(parents))
+	for c, p := range parents {
+		children[p] = append(children[p], c)
+	}
+	for level := []int64{id}; len(level) > 0; depth++ {
+		if depth > MaxCategoryDepth {
+			return ErrCategoryDepth
+		}
+		var next []int64
+		for _, c := range level {
+			next = append(next, children[c]...)
+		}
+		level = next
+	}
+	return nil
+}
+
+// placeCategory checks that c may sit under its parent. It takes a table lock
+// that conflicts with itself, so the check sees every write committed before
+// it and two concurrent moves cannot build a loop or a too deep tree between them.
+func placeCategory(ctx context.Context, tx pgx.Tx, c *Category) error {
+	if c.ParentID == nil {
+		return nil
+	}
+	if _, err := tx.Exec(ctx, `LOCK TABLE categories IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+		return err
+	}
+	rows, err := tx.Query(ctx, `SELECT id, COALESCE(parent_id, 0) FROM categories`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	parents := make(map[int64]int64)
+	for rows.Next() {
+		var id, parent int64
+		if err := rows.Scan(&id, &parent); err != nil {
+			return err
+		}
+		parents[id] = parent
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	return checkCategoryPlacement(parents, c.ID, *c.ParentID)
+}
+
+func scanCategory(row pgx.Row) (*Category, error) {
+	c := &Category{}
+	if err := row.Scan(&c.ID, &c.Name, &c.Slug, &c.ParentID, &c.Position, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ListCategories returns every category, siblings in display order
+func (p *PostgresProvider) ListCategories(ctx context.Context) ([]*Category, error) {
+	rows, err := p.Pool.Query(ctx,
+		`SELECT `+categoryColumns+` FROM categories ORDER BY position, name, id`)
+	if err != nil {
+		logs.Errorf(ctx, "failed to list categories: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	categories := []*Category{}
+	for rows.Next() {
+		c, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, c)
+	}
+	return categories, rows.Err()
+}
+
+func (p *PostgresProvider) GetCategory(ctx context.Context, id int64) (*Category, error) {
+	return scanCategory(p.Pool.QueryRow(ctx, `SELECT `+categoryColumns+` FROM categories WHERE id = $1`, id))
+}
+
+// CreateCategory inserts c and fills in its id and timestamps. Returns
+// ErrSlugTaken, ErrUnknownParent or ErrCategoryDepth when it is rejected.
+func (p *PostgresProvider) CreateCategory(ctx context.Context, c *Category) error {
+	tx, err := p.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := placeCategory(ctx, tx, c); err != nil {
+		return err
+	}
+	err = tx.QueryRow(ctx,
+		`INSERT INTO categories (name, slug, parent_id, position)
+		 VALUES ($1, $2, $3, $4)
+		 RETURNING id, created_at, created_at`,
+		c.Name, c.Slug, c.ParentID, c.Position).Scan(&c.ID, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		return categoryError(ctx, err)
+	}
+	return tx.Commit(ctx)
+}
+
+// UpdateCategory writes c. A move is refused with ErrCategoryCycle when the
+// new parent is c itself or below it, and with ErrCategoryDepth when c and
+// its subcategories would end up deeper than MaxCategoryDepth.
+func (p *PostgresProvider) UpdateCategory(ctx context.Context, c *Category) error {
+	tx, err := p.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := placeCategory(ctx, tx, c); err != nil {
+		return err
+	}
+	err = tx.QueryRow(ctx,
+		`UPDATE categories
+		 SET name = $2, slug = $3, parent_id = $4, position = $5, updated_at = NOW()
+		 WHERE id = $1
+		 RETURNING updated_at`,
+		c.ID, c.Name, c.Slug, c.ParentID, c.Position).Scan(&c.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		return categoryError(ctx, err)
+	}
+	return tx.Commit(ctx)
+}
+
+// DeleteCategory removes a category without subcategories or live products.
+// Archived products keep resolving for old orders but lose their category.
+// Returns ErrCategoryInUse if it is not empty, pgx.ErrNoRows if it does not exist.
+func (p *PostgresProvider) DeleteCategory(ctx context.Context, id int64) error {
+	tx, err := p.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// The row lock waits out inserts that reference the category
+	if err := tx.QueryRow(ctx, `SELECT id FROM categories WHERE id = $1 FOR UPDATE`, id).Scan(&id); err != nil {
+		return err
+	}
+	var inUse bool
+	err = tx.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM categories WHERE parent_id = $1)
+		     OR EXISTS (SELECT 1 FROM products WHERE category_id = $1 AND archived_at IS NULL)`,
+		id).Scan(&inUse)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return ErrCategoryInUse
+	}
+
+	if _, err := tx.Exec(ctx,
+		`UPDATE products SET category_id = NULL WHERE category_id = $1 AND archived_at IS NOT NULL`, id); err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `DELETE FROM categories WHERE id = $1`, id)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" { // a product was un-archived meanwhile
+		return ErrCategoryInUse
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// categoryError maps constraint violations on categories to their errors
+func categoryError(ctx context.Context, err error) error {
+	var pgErr *pgconn.PgError
+	switch {
+	case err == nil:
+		return nil
+	case isUniqueViolation(err):
+		return ErrSlugTaken
+	case errors.As(err, &pgErr) && pgErr.Code == "23503": // foreign_key_violation
+		return ErrUnknownParent
+	}
+	logs.Errorf(ctx, "category write failed: %v", err)
+	return err
+}
+
+// ----------------- Order CRUD -----------------
+// CreateOrder stores an order and its lines; PostgresClients.CreateOrder also
+// counts the units towards product popularity
+func (p *PostgresProvider) CreateOrder(ctx context.Context, order Order, items []OrderItem) (int, error) {
+	tx, err := p.Pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var orderID int
+	err = tx.QueryRow(ctx,
+		`INSERT INTO orders (user_id,total,status,created_at) VALUES ($1,$2,$3,$4) RETURNING id`,
+		order.UserID, order.Total, order.Status, order.CreatedAt).Scan(&orderID)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, item := range items {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO order_items (order_id,product_id,sku_id,quantity,price) VALUES ($1,$2,$3,$4,$5)`,
+			orderID, item.ProductID, item.SkuID, item.Quantity, item.Price)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return orderID, nil
+}
+
+func (p *PostgresProvider) GetOrder(ctx context.Context, orderID int) (*Order, []OrderItem, error) {
+	order := &Order{}
+	err := p.Pool.QueryRow(ctx,
+		`SELECT id,user_id,total,status,created_at FROM orders WHERE id=$1`, orderID).
+		Scan(&order.ID, &order.UserID, &order.Total, &order.Status, &order.CreatedAt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := p.Pool.Query(ctx,
+		`SELECT id,order_id,product_id,sku_id,quantity,price FROM order_items WHERE order_id=$1`, orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	items := []OrderItem{}
+	for rows.Next() {
+		var item OrderItem
+		if err := rows.Scan(&item.ID, &item.OrderID, &item.ProductID, &item.SkuID, &item.Quantity, &item.Price); err != nil {
+			return nil, nil, err
+		}
+		items = append(items, item)
+	}
+
+	return order, items, nil
+}
+
+// ListOrdersByUser returns a user's orders, newest first
+func (p *PostgresProvider) ListOrdersByUser(ctx context.Context, userID int64) ([]Order, error) {
+	rows, err := p.Pool.Query(ctx,
+		`SELECT id, user_id, total_amount, status, created_at, COALESCE(updated_at, created_at)
+		 FROM orders WHERE user_id = $1 ORDER BY created_at DESC`, userID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to list orders for user %d: %v", userID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	orders := []Order{}
+	for rows.Next() {
+		var o Order
+		if err := rows.Scan(&o.ID, &o.UserID, &o.Total, &o.Status, &o.CreatedAt, &o.UpdatedAt); err != nil {
+			return nil, err
+		}
+		orders = append(orders, o)
+	}
+	return orders, rows.Err()
+}
+
+// ----------------- Cart -----------------
+func (p *PostgresProvider) ListCartItems(ctx context.Context, userID int64) ([]CartItem, error) {
+	rows, err := p.Pool.Query(ctx,
+		`SELECT id, user_id, product_id, sku_id, quantity, created_at FROM cart_items WHERE user_id = $1 ORDER BY created_at`, userID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to list cart of user %d: %v", userID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []CartItem{}
+	for rows.Next() {
+		var c CartItem
+		if err := rows.Scan(&c.ID, &c.UserID, &c.ProductID, &c.SkuID, &c.Quantity, &c.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, c)
+	}
+	return items, rows.Err()
+}
+
+func (p *PostgresProvider) DeleteCartItems(ctx context.Context, userID int64) error {
+	_, err := p.Pool.Exec(ctx, `DELETE FROM cart_items WHERE user_id = $1`, userID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to empty cart of user %d: %v", userID, err)
+	}
+	return err
+}
+
+// ----------------- Inventory -----------------
+
+// SetSkuStock records the stock of a SKU in inventorydb, one row per sku_id
+func (p *PostgresProvider) SetSkuStock(ctx context.Context, productID int, skuID int64, quantity int) error {
+	_, err := p.Pool.Exec(ctx,
+		`INSERT INTO inventory (product_id, sku_id, quantity, updated_at)
+		 VALUES ($1, $2, $3, NOW())
+		 ON CONFLICT (sku_id) DO UPDATE
+		 SET product_id = EXCLUDED.product_id, quantity = EXCLUDED.quantity, updated_at = NOW()`,
+		productID, skuID, quantity)
+	if err != nil {
+		logs.Errorf(ctx, "failed to set stock of sku %d: %v", skuID, err)
+	}
+	return err
+}
+
+// ----------------- Sessions -----------------
+func (p *PostgresProvider) CreateSession(ctx context.Context, s *Session) error {
+	_, err := p.Pool.Exec(ctx,
+		`INSERT INTO sessions (id, user_id, family_id, refresh_jti, refresh_token_hash, user_agent, ip_address, created_at, last_used_at, expires_at, mfa)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8, $9, $10)`,
+		s.ID, s.UserID, s.FamilyID, s.RefreshJTI, s.RefreshTokenHash, s.UserAgent, s.IPAddress, s.CreatedAt, s.ExpiresAt, s.MFA)
+	if err != nil {
+		return fmt.Errorf("failed to create session for user %d: %w", s.UserID, err)
+	}
+	return nil
+}
+
+func (p *PostgresProvider) GetSession(ctx context.Context, id string) (*Session, error) {
+	s := &Session{}
+	err := p.Pool.QueryRow(ctx,
+		`SELECT id, user_id, family_id, refresh_jti, refresh_token_hash, COALESCE(user_agent, ''), COALESCE(ip_address, ''),
+		        created_at, last_used_at, expires_at, revoked_at, mfa
+		 FROM sessions WHERE id = $1`, id).
+		Scan(&s.ID, &s.UserID, &s.FamilyID, &s.RefreshJTI, &s.RefreshTokenHash, &s.UserAgent, &s.IPAddress,
+			&s.CreatedAt, &s.LastUsedAt, &s.ExpiresAt, &s.RevokedAt, &s.MFA)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ListActiveSessions returns the sessions of a user that are neither revoked nor expired
+func (p *PostgresProvider) ListActiveSessions(ctx context.Context, userID string) ([]Session, error) {
+	rows, err := p.Pool.Query(ctx,
+		`SELECT id, user_id, family_id, refresh_jti, refresh_token_hash, COALESCE(user_agent, ''), COALESCE(ip_address, ''),
+		        created_at, last_used_at, expires_at, revoked_at
+		 FROM sessions
+		 WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+		 ORDER BY last_used_at DESC`, userID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to list sessions for user %s: %v", userID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := []Session{}
+	for rows.Next() {
+		var s Session
+		if err := rows.Scan(&s.ID, &s.UserID, &s.FamilyID, &s.RefreshJTI, &s.RefreshTokenHash, &s.UserAgent, &s.IPAddress,
+			&s.CreatedAt, &s.LastUsedAt, &s.ExpiresAt, &s.RevokedAt); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	return sessions, rows.Err()
+}
+
+// ListSessionHistory returns every session of a user, revoked and expired ones included
+func (p *PostgresProvider) ListSessionHistory(ctx context.Context, userID int64) ([]Session, error) {
+	rows, err := p.Pool.Query(ctx,
+		`SELECT id, user_id, family_id, refresh_jti, refresh_token_hash, COALESCE(user_agent, ''), COALESCE(ip_address, ''),
+		        created_at, last_used_at, expires_at, revoked_at
+		 FROM sessions WHERE user_id = $1 ORDER BY created_at DESC`, userID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to list session history for user %d: %v", userID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := []Session{}
+	for rows.Next() {
+		var s Session
+		if err := rows.Scan(&s.ID, &s.UserID, &s.FamilyID, &s.RefreshJTI, &s.RefreshTokenHash, &s.UserAgent, &s.IPAddress,
+			&s.CreatedAt, &s.LastUsedAt, &s.ExpiresAt, &s.RevokedAt); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	return sessions, rows.Err()
+}
+
+// RotateSession swaps the session's refresh token for a new one and bumps last-used details.
+// The swap only happens if oldJTI is still current, so two concurrent refreshes cannot both win;
+// pgx.ErrNoRows is returned to the loser.
+func (p *PostgresProvider) RotateSession(ctx context.Context, id string, oldJTI string, newJTI string, refreshTokenHash string, ipAddress string, expiresAt time.Time) error {
+	tag, err := p.Pool.Exec(ctx,
+		`UPDATE sessions
+		 SET refresh_jti = $1, refresh_token_hash = $2, ip_address = $3, last_used_at = NOW(), expires_at = $4
+		 WHERE id = $5 AND refresh_jti = $6 AND revoked_at IS NULL`,
+		newJTI, refreshTokenHash, ipAddress, expiresAt, id, oldJTI)
+	if err != nil {
+		return fmt.Errorf("failed to rotate session %s: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// RevokeSessionFamily revokes every session descended from the same refresh token family
+func (p *PostgresProvider) RevokeSessionFamily(ctx context.Context, familyID string) error {
+	_, err := p.Pool.Exec(ctx,
+		`UPDATE sessions SET revoked_at = NOW()
+		 WHERE family_id = $1 AND revoked_at IS NULL`, familyID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to revoke session family %s: %v", familyID, err)
+	}
+	return err
+}
+
+// RevokeSession revokes one session of a user; pgx.ErrNoRows if it is not an active session of that user
+func (p *PostgresProvider) RevokeSession(ctx context.Context, userID string, id string) error {
+	tag, err := p.Pool.Exec(ctx,
+		`UPDATE sessions SET revoked_at = NOW()
+		 WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`, id, userID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to revoke session %s for user %s: %v", id, userID, err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// RevokeAllSessions revokes every active session of a user except keepID (pass "" to revoke all)
+func (p *PostgresProvider) RevokeAllSessions(ctx context.Context, userID string, keepID string) error {
+	_, err := p.Pool.Exec(ctx,
+		`UPDATE sessions SET revoked_at = NOW()
+		 WHERE user_id = $1 AND revoked_at IS NULL AND id::text <> $2`, userID, keepID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to revoke sessions for user %s: %v", userID, err)
+	}
+	return err
+}
+
+// ----------------- Password Resets -----------------
+
+// SaveResetToken stores a new reset token hash; older tokens of the user (and any expired ones) are pruned
+func (r *PostgresProvider) SaveResetToken(ctx context.Context, userID string, token string, expiry time.Time) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx,
+		`DELETE FROM password_resets WHERE user_id = $1 OR expiry < $2`, userID, time.Now())
+	if err != nil {
+		logs.Errorf(ctx, "failed to prune reset tokens for user %s: %v", userID, err)
+		return err
+	}
+
 	query := `
 	INSERT INTO password_resets (user_id, token_hash, expiry)
 	VALUES ($1, $2, $3)
 	`
-	_, err := r.Pool.Exec(ctx, query, userID, token, expiry)
+	if _, err := tx.Exec(ctx, query, userID, token, expiry); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetUserByResetToken returns the user a live reset token belongs to, without consuming it
+func (r *PostgresProvider) GetUserByResetToken(ctx context.Context, tokenHash string) (*User, error) {
+	u := &User{}
+	err := r.Pool.QueryRow(ctx,
+		`SELECT u.id,u.name,COALESCE(u.email,''),COALESCE(u.phone,''),u.password,u.email_verified,u.phone_verified,u.created_at,u.disabled_at,u.deleted_at
+		 FROM password_resets pr JOIN users u ON u.id = pr.user_id
+		 WHERE pr.token_hash = $1 AND pr.expiry > $2`, tokenHash, time.Now()).
+		Scan(&u.ID, &u.Name, &u.Email, &u.Phone, &u.Password, &u.EmailVerified, &u.PhoneVerified, &u.CreatedAt, &u.DisabledAt, &u.DeletedAt)
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// ResetPassword consumes a reset token and sets the new password hash in one transaction.
+// The token is deleted as it is read so it can only be used once; every other reset
+// token of the user is dropped and all sessions are revoked.
+// Returns pgx.ErrNoRows when the token is unknown, expired or already used.
+func (r *PostgresProvider) ResetPassword(ctx context.Context, tokenHash string, passwordHash string) (int64, error) {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var userID int64
+	err = tx.QueryRow(ctx,
+		`DELETE FROM password_resets
+		 WHERE token_hash = $1 AND expiry > $2
+		 RETURNING user_id`, tokenHash, time.Now()).Scan(&userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.Exec(ctx,
+		`UPDATE users SET password = $1, updated_at = NOW() WHERE id = $2`, passwordHash, userID); err != nil {
+		logs.Errorf(ctx, "failed to update password for user %d: %v", userID, err)
+		return 0, err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM password_resets WHERE user_id = $1`, userID); err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.Exec(ctx,
+		`UPDATE sessions SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL`, userID); err != nil {
+		logs.Errorf(ctx, "failed to revoke sessions for user %d: %v", userID, err)
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return userID, nil
+}
+
+// ----------------- Magic Links -----------------
+
+// maxMagicLinkDeviceMisses is how many times a link may be opened on the wrong
+// device (say, the mail app on a phone) before it is burned, so a leaked link
+// cannot be used to guess device ids until it expires
+const maxMagicLinkDeviceMisses = 3
+
+// SaveMagicLink stores a sign-in link token hash bound to a device; older links of the user (and expired ones) are pruned
+func (r *PostgresProvider) SaveMagicLink(ctx context.Context, userID int64, tokenHash string, deviceHash string, expiry time.Time) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx,
+		`DELETE FROM magic_links WHERE user_id = $1 OR expiry < $2`, userID, time.Now())
+	if err != nil {
+		logs.Errorf(ctx, "failed to prune magic links for user %d: %v", userID, err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx,
+		`INSERT INTO magic_links (user_id, token_hash, device_hash, expiry) VALUES ($1, $2, $3, $4)`,
+		userID, tokenHash, deviceHash, expiry); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ConsumeMagicLink deletes a live link and returns its user. A link opened on
+// another device is reported as ErrDeviceMismatch and stays usable on the right
+// device until maxMagicLinkDeviceMisses such attempts, which delete it.
+// Returns pgx.ErrNoRows when the link is unknown, expired or already used.
+func (r *PostgresProvider) ConsumeMagicLink(ctx context.Context, tokenHash string, deviceHash string) (int64, error) {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var id, userID int64
+	var boundTo string
+	err = tx.QueryRow(ctx,
+		`SELECT id, user_id, device_hash FROM magic_links
+		 WHERE token_hash = $1 AND expiry > $2
+		 FOR UPDATE`, tokenHash, time.Now()).Scan(&id, &userID, &boundTo)
+	if err != nil {
+		return 0, err
+	}
+	if boundTo != deviceHash {
+		var misses int
+		if err := tx.QueryRow(ctx,
+			`UPDATE magic_links SET device_misses = device_misses + 1 WHERE id = $1 RETURNING device_misses`,
+			id).Scan(&misses); err != nil {
+			return 0, err
+		}
+		if misses >= maxMagicLinkDeviceMisses {
+			if _, err := tx.Exec(ctx, `DELETE FROM magic_links WHERE id = $1`, id); err != nil {
+				return 0, err
+			}
+			logs.Warningf(ctx, "magic link of user %d burned after %d device mismatches", userID, misses)
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return 0, err
+		}
+		return 0, ErrDeviceMismatch
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM magic_links WHERE user_id = $1`, userID); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return userID, nil
+}
+
+// ----------------- Email Verifications -----------------
+
+// SaveEmailVerification stores a verification token hash for the email; older tokens of the user are pruned
+func (r *PostgresProvider) SaveEmailVerification(ctx context.Context, userID int64, email string, tokenHash string, expiry time.Time) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx,
+		`DELETE FROM email_verifications WHERE user_id = $1 OR expiry < $2`, userID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO email_verifications (user_id, email, token_hash, expiry) VALUES ($1, $2, $3, $4)`,
+		userID, email, tokenHash, expiry)
+	if err != nil {
+		logs.Errorf(ctx, "failed to save email verification for user %d: %v", userID, err)
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ConsumeEmailVerification uses up a token and marks the email verified, provided the
+// user still has the email the token was issued for.
+// Returns pgx.ErrNoRows when the token is unknown, expired, used or stale.
+func (r *PostgresProvider) ConsumeEmailVerification(ctx context.Context, tokenHash string) (int64, error) {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var userID int64
+	var email string
+	err = tx.QueryRow(ctx,
+		`DELETE FROM email_verifications
+		 WHERE token_hash = $1 AND expiry > $2
+		 RETURNING user_id, email`, tokenHash, time.Now()).Scan(&userID, &email)
+	if err != nil {
+		return 0, err
+	}
+
+	tag, err := tx.Exec(ctx,
+		`UPDATE users SET email_verified = TRUE, updated_at = NOW() WHERE id = $1 AND email = $2`, userID, email)
+	if err != nil {
+		logs.Errorf(ctx, "failed to mark email verified for user %d: %v", userID, err)
+		return 0, err
+	}
+	if tag.RowsAffected() == 0 {
+		return 0, pgx.ErrNoRows
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return userID, nil
+}
+
+// ----------------- Contact Changes -----------------
+
+// SaveContactChange records a pending email or phone change, replacing any earlier one of that kind
+func (r *PostgresProvider) SaveContactChange(ctx context.Context, c *ContactChange) error {
+	_, err := r.Pool.Exec(ctx,
+		`INSERT INTO contact_changes (user_id, kind, new_value, token_hash, expiry)
+		 VALUES ($1, $2, $3, NULLIF($4,''), $5)
+		 ON CONFLICT (user_id, kind) DO UPDATE
+		 SET new_value = EXCLUDED.new_value, token_hash = EXCLUDED.token_hash,
+		     expiry = EXCLUDED.expiry, created_at = NOW()`,
+		c.UserID, c.Kind, c.NewValue, c.TokenHash, c.Expiry)
+	if err != nil {
+		logs.Errorf(ctx, "failed to save %s change for user %d: %v", c.Kind, c.UserID, err)
+	}
+	return err
+}
+
+// GetContactChanges returns the unexpired pending changes of a user
+func (r *PostgresProvider) GetContactChanges(ctx context.Context, userID int64) ([]ContactChange, error) {
+	rows, err := r.Pool.Query(ctx,
+		`SELECT user_id, kind, new_value, COALESCE(token_hash,''), expiry, created_at
+		 FROM contact_changes WHERE user_id = $1 AND expiry > NOW()`, userID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to get contact changes for user %d: %v", userID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []ContactChange
+	for rows.Next() {
+		var c ContactChange
+		if err := rows.Scan(&c.UserID, &c.Kind, &c.NewValue, &c.TokenHash, &c.Expiry, &c.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+// ApplyEmailChange uses up an email change token and switches the account to the
+// new, now verified, email. Returns pgx.ErrNoRows for an unknown or expired
+// token and ErrContactTaken if the email was claimed in the meantime.
+func (r *PostgresProvider) ApplyEmailChange(ctx context.Context, tokenHash string) (*ContactChange, error) {
+	return r.applyContactChange(ctx,
+		`DELETE FROM contact_changes
+		 WHERE kind = 'email' AND token_hash = $1 AND expiry > NOW()
+		 RETURNING user_id, kind, new_value`, tokenHash)
+}
+
+// ApplyPhoneChange switches the account to its pending phone once the SMS code
+// was checked. Returns pgx.ErrNoRows when no change is pending and
+// ErrContactTaken if the number was claimed in the meantime.
+func (r *PostgresProvider) ApplyPhoneChange(ctx context.Context, userID int64, phone string) (*ContactChange, error) {
+	return r.applyContactChange(ctx,
+		`DELETE FROM contact_changes
+		 WHERE kind = 'phone' AND user_id = $1 AND new_value = $2 AND expiry > NOW()
+		 RETURNING user_id, kind, new_value`, userID, phone)
+}
+
+func (r *PostgresProvider) applyContactChange(ctx context.Context, consume string, args ...any) (*ContactChange, error) {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	c := &ContactChange{}
+	if err := tx.QueryRow(ctx, consume, args...).Scan(&c.UserID, &c.Kind, &c.NewValue); err != nil {
+		return nil, err
+	}
+
+	update := `UPDATE users SET email = $1, email_verified = TRUE, updated_at = NOW() WHERE id = $2`
+	if c.Kind == "phone" {
+		update = `UPDATE users SET phone = $1, phone_verified = TRUE, updated_at = NOW() WHERE id = $2`
+	}
+	if _, err := tx.Exec(ctx, update, c.NewValue, c.UserID); err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrContactTaken
+		}
+		logs.Errorf(ctx, "failed to apply %s change for user %d: %v", c.Kind, c.UserID, err)
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ----------------- User Roles -----------------
+func (r *PostgresProvider) GetUserRoles(ctx context.Context, userID string) ([]string, error) {
+	rows, err := r.Pool.Query(ctx,
+		`SELECT role FROM user_roles WHERE user_id = $1 ORDER BY role`, userID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to get roles for user %s: %v", userID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := []string{}
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, rows.Err()
+}
+
+func (r *PostgresProvider) AssignRole(ctx context.Context, userID string, role string) error {
+	_, err := r.Pool.Exec(ctx,
+		`INSERT INTO user_roles (user_id, role) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+		userID, role)
+	if err != nil {
+		logs.Errorf(ctx, "failed to assign role %s to user %s: %v", role, userID, err)
+	}
+	return err
+}
+
+func (r *PostgresProvider) RevokeRole(ctx context.Context, userID string, role string) error {
+	_, err := r.Pool.Exec(ctx,
+		`DELETE FROM user_roles WHERE user_id = $1 AND role = $2`, userID, role)
+	if err != nil {
+		logs.Errorf(ctx, "failed to revoke role %s from user %s: %v", role, userID, err)
+	}
+	return err
+}
+
+// ----------------- MFA -----------------
+
+// SaveMFAEnrollment stores a new (not yet enabled) TOTP secret and replaces the
+// recovery codes. Returns pgx.ErrNoRows when MFA is already enabled for the user.
+func (r *PostgresProvider) SaveMFAEnrollment(ctx context.Context, userID int64, secret string, recoveryCodeHashes []string) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx,
+		`INSERT INTO user_mfa (user_id, totp_secret) VALUES ($1, $2)
+		 ON CONFLICT (user_id) DO UPDATE
+		 SET totp_secret = EXCLUDED.totp_secret, last_used_step = 0, created_at = NOW()
+		 WHERE user_mfa.enabled = FALSE`, userID, secret)
+	if err != nil {
+		logs.Errorf(ctx, "failed to save mfa enrollment for user %d: %v", userID, err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM mfa_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+	for _, h := range recoveryCodeHashes {
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO mfa_recovery_codes (user_id, code_hash) VALUES ($1, $2)`, userID, h); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetUserMFA returns the MFA enrolment of a user (pgx.ErrNoRows if none)
+func (r *PostgresProvider) GetUserMFA(ctx context.Context, userID int64) (*UserMFA, error) {
+	m := &UserMFA{}
+	err := r.Pool.QueryRow(ctx,
+		`SELECT user_id, totp_secret, enabled, last_used_step, created_at, enabled_at
+		 FROM user_mfa WHERE user_id = $1`, userID).
+		Scan(&m.UserID, &m.TOTPSecret, &m.Enabled, &m.LastUsedStep, &m.CreatedAt, &m.EnabledAt)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UseTOTPStep records step as used and enables MFA if it was pending.
+// Returns pgx.ErrNoRows when the step (or a later one) was already used.
+func (r *PostgresProvider) UseTOTPStep(ctx context.Context, userID int64, step int64) error {
+	tag, err := r.Pool.Exec(ctx,
+		`UPDATE user_mfa
+		 SET last_used_step = $2, enabled = TRUE, enabled_at = COALESCE(enabled_at, NOW())
+		 WHERE user_id = $1 AND last_used_step < $2`, userID, step)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// ConsumeRecoveryCode burns an unused recovery code of an enabled enrolment.
+// Returns pgx.ErrNoRows when the code is unknown or already used.
+func (r *PostgresProvider) ConsumeRecoveryCode(ctx context.Context, userID int64, codeHash string) error {
+	tag, err := r.Pool.Exec(ctx,
+		`UPDATE mfa_recovery_codes SET used_at = NOW()
+		 WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+		 AND EXISTS (SELECT 1 FROM user_mfa WHERE user_id = $1 AND enabled)`, userID, codeHash)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// DeleteMFA removes the enrolment and recovery codes of a user
+func (r *PostgresProvider) DeleteMFA(ctx context.Context, userID int64) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM mfa_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM user_mfa WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// ----------------- API Keys -----------------
+
+const apiKeyColumns = `id, name, prefix, key_hash, scopes, created_by, created_at, expires_at, last_used_at, revoked_at`
+
+func scanAPIKey(row pgx.Row) (*APIKey, error) {
+	k := &APIKey{}
+	err := row.Scan(&k.ID, &k.Name, &k.Prefix, &k.KeyHash, &k.Scopes, &k.CreatedBy,
+		&k.CreatedAt, &k.ExpiresAt, &k.LastUsedAt, &k.RevokedAt)
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (r *PostgresProvider) CreateAPIKey(ctx context.Context, k *APIKey) error {
+	err := r.Pool.QueryRow(ctx,
+		`INSERT INTO api_keys (name, prefix, key_hash, scopes, created_by, expires_at)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 RETURNING id, created_at`,
+		k.Name, k.Prefix, k.KeyHash, k.Scopes, k.CreatedBy, k.ExpiresAt).Scan(&k.ID, &k.CreatedAt)
+	if err != nil {
+		logs.Errorf(ctx, "failed to create api key %s: %v", k.Prefix, err)
+	}
+	return err
+}
+
+// GetAPIKeyByPrefix returns the key with the given public prefix (revoked or not)
+func (r *PostgresProvider) GetAPIKeyByPrefix(ctx context.Context, prefix string) (*APIKey, error) {
+	return scanAPIKey(r.Pool.QueryRow(ctx,
+		`SELECT `+apiKeyColumns+` FROM api_keys WHERE prefix = $1`, prefix))
+}
+
+func (r *PostgresProvider) ListAPIKeys(ctx context.Context) ([]*APIKey, error) {
+	rows, err := r.Pool.Query(ctx, `SELECT `+apiKeyColumns+` FROM api_keys ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []*APIKey
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	return keys, rows.Err()
+}
+
+// RevokeAPIKey marks a key revoked. Returns pgx.ErrNoRows if it does not exist or is already revoked.
+func (r *PostgresProvider) RevokeAPIKey(ctx context.Context, id int64) error {
+	tag, err := r.Pool.Exec(ctx,
+		`UPDATE api_keys SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL`, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// TouchAPIKey records use of a key, at most once a minute to spare the write
+func (r *PostgresProvider) TouchAPIKey(ctx context.Context, id int64) error {
+	_, err := r.Pool.Exec(ctx,
+		`UPDATE api_keys SET last_used_at = NOW()
+		 WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')`, id)
+	return err
+}
+
+// ----------------- Passkeys -----------------
+
+const passkeyColumns = `id, user_id, credential_id, public_key, algorithm, sign_count, COALESCE(aaguid, ''::bytea), transports, name, backup_eligible, backed_up, created_at, last_used_at`
+
+func scanPasskey(row pgx.Row) (*Passkey, error) {
+	k := &Passkey{}
+	err := row.Scan(&k.ID, &k.UserID, &k.CredentialID, &k.PublicKey, &k.Algorithm, &k.SignCount, &k.AAGUID,
+		&k.Transports, &k.Name, &k.BackupEligible, &k.BackedUp, &k.CreatedAt, &k.LastUsedAt)
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// CreatePasskey stores a verified credential. Returns ErrCredentialExists if the
+// authenticator is already registered (to this or another account).
+func (r *PostgresProvider) CreatePasskey(ctx context.Context, k *Passkey) error {
+	err := r.Pool.QueryRow(ctx,
+		`INSERT INTO webauthn_credentials
+		 (user_id, credential_id, public_key, algorithm, sign_count, aaguid, transports, name, backup_eligible, backed_up)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		 RETURNING id, created_at`,
+		k.UserID, k.CredentialID, k.PublicKey, k.Algorithm, k.SignCount, k.AAGUID, k.Transports, k.Name,
+		k.BackupEligible, k.BackedUp).Scan(&k.ID, &k.CreatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrCredentialExists
+		}
+		logs.Errorf(ctx, "failed to create passkey for user %d: %v", k.UserID, err)
+	}
+	return err
+}
+
+func (r *PostgresProvider) GetPasskeyByCredentialID(ctx context.Context, credentialID []byte) (*Passkey, error) {
+	return scanPasskey(r.Pool.QueryRow(ctx,
+		`SELECT `+passkeyColumns+` FROM webauthn_credentials WHERE credential_id = $1`, credentialID))
+}
+
+func (r *PostgresProvider) ListPasskeys(ctx context.Context, userID int64) ([]*Passkey, error) {
+	rows, err := r.Pool.Query(ctx,
+		`SELECT `+passkeyColumns+` FROM webauthn_credentials WHERE user_id = $1 ORDER BY created_at`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []*Passkey
+	for rows.Next() {
+		k, err := scanPasskey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	return keys, rows.Err()
+}
+
+// UpdatePasskeyUsage stores the counter and backup state reported by a login.
+// The counter only moves forward, so two racing logins cannot roll it back.
+func (r *PostgresProvider) UpdatePasskeyUsage(ctx context.Context, id int64, signCount int64, backedUp bool) error {
+	_, err := r.Pool.Exec(ctx,
+		`UPDATE webauthn_credentials
+		 SET sign_count = GREATEST(sign_count, $2), backed_up = $3, last_used_at = NOW()
+		 WHERE id = $1`, id, signCount, backedUp)
+	return err
+}
+
+// DeletePasskey removes one of the user's passkeys. Returns pgx.ErrNoRows if it is not theirs.
+func (r *PostgresProvider) DeletePasskey(ctx context.Context, userID int64, id int64) error {
+	tag, err := r.Pool.Exec(ctx,
+		`DELETE FROM webauthn_credentials WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// ----------------- Security Events -----------------
+func (p *PostgresProvider) RecordSecurityEvent(ctx context.Context, e *SecurityEvent) error {
+	details, err := json.Marshal(e.Details)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.Pool.Exec(ctx,
+		`INSERT INTO security_events (user_id, event_type, session_id, ip_address, user_agent, details, created_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		e.UserID, e.EventType, e.SessionID, e.IPAddress, e.UserAgent, details, e.CreatedAt)
+	if err != nil {
+		logs.Errorf(ctx, "failed to record security event %s for user %d: %v", e.EventType, e.UserID, err)
+	}
+	return err
+}
+
+// ListSecurityEvents returns a user's security events, oldest first
+func (p *PostgresProvider) ListSecurityEvents(ctx context.Context, userID int64) ([]SecurityEvent, error) {
+	rows, err := p.Pool.Query(ctx,
+		`SELECT id, user_id, event_type, COALESCE(session_id, ''), COALESCE(ip_address, ''), COALESCE(user_agent, ''), details, created_at
+		 FROM security_events WHERE user_id = $1 ORDER BY created_at`, userID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to list security events for user %d: %v", userID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []SecurityEvent{}
+	for rows.Next() {
+		var e SecurityEvent
+		var details []byte
+		if err := rows.Scan(&e.ID, &e.UserID, &e.EventType, &e.SessionID, &e.IPAddress, &e.UserAgent, &details, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		if len(details) > 0 {
+			_ = json.Unmarshal(details, &e.Details)
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+// ScrubSecurityEvents drops the IP, device and details of a user's security
+// events, keeping what happened and when
+func (p *PostgresProvider) ScrubSecurityEvents(ctx context.Context, userID int64) error {
+	_, err := p.Pool.Exec(ctx,
+		`UPDATE security_events SET ip_address = NULL, user_agent = NULL, details = NULL WHERE user_id = $1`, userID)
+	if err != nil {
+		logs.Errorf(ctx, "failed to scrub security events of user %d: %v", userID, err)
+	}
+	return err
+}
+
+// ----------------- Privacy Jobs -----------------
+const privacyJobColumns = `id, user_id, kind, status, progress, COALESCE(step, ''), COALESCE(object_key, ''), COALESCE(error, ''),
+	created_at, updated_at, completed_at, expires_at`
+
+func scanPrivacyJob(row pgx.Row) (*PrivacyJob, error) {
+	j := &PrivacyJob{}
+	err := row.Scan(&j.ID, &j.UserID, &j.Kind, &j.Status, &j.Progress, &j.Step, &j.ObjectKey, &j.Error,
+		&j.CreatedAt, &j.UpdatedAt, &j.CompletedAt, &j.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// CreatePrivacyJob queues a job. Any older queued or running job of the same
+// kind for the user is marked failed: callers only create one when none is alive.
+func (r *PostgresProvider) CreatePrivacyJob(ctx context.Context, j *PrivacyJob) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx,
+		`UPDATE privacy_jobs SET status = 'failed', error = 'interrupted', updated_at = NOW()
+		 WHERE user_id = $1 AND kind = $2 AND status IN ('queued', 'running')`, j.UserID, j.Kind); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx,
+		`INSERT INTO privacy_jobs (id, user_id, kind, status, progress, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, 0, $5, $5)`,
+		j.ID, j.UserID, j.Kind, j.Status, j.CreatedAt); err != nil {
+		logs.Errorf(ctx, "failed to create %s job for user %d: %v", j.Kind, j.UserID, err)
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *PostgresProvider) GetPrivacyJob(ctx context.Context, id string) (*PrivacyJob, error) {
+	return scanPrivacyJob(r.Pool.QueryRow(ctx,
+		`SELECT `+privacyJobColumns+` FROM privacy_jobs WHERE id = $1`, id))
+}
+
+// GetActivePrivacyJob returns the user's queued or running job of a kind that
+// made progress since the given time
+func (r *PostgresProvider) GetActivePrivacyJob(ctx context.Context, userID int64, kind string, since time.Time) (*PrivacyJob, error) {
+	return scanPrivacyJob(r.Pool.QueryRow(ctx,
+		`SELECT `+privacyJobColumns+` FROM privacy_jobs
+		 WHERE user_id = $1 AND kind = $2 AND status IN ('queued', 'running') AND updated_at > $3
+		 ORDER BY created_at DESC LIMIT 1`, userID, kind, since))
+}
+
+func (r *PostgresProvider) UpdatePrivacyJobProgress(ctx context.Context, id string, progress int, step string) error {
+	_, err := r.Pool.Exec(ctx,
+		`UPDATE privacy_jobs SET status = 'running', progress = $1, step = $2, updated_at = NOW() WHERE id = $3`,
+		progress, step, id)
+	return err
+}
+
+func (r *PostgresProvider) CompletePrivacyJob(ctx context.Context, id string, objectKey string, expiresAt *time.Time) error {
+	_, err := r.Pool.Exec(ctx,
+		`UPDATE privacy_jobs SET status = 'completed', progress = 100, step = NULL, object_key = NULLIF($1, ''), expires_at = $2,
+		 completed_at = NOW(), updated_at = NOW()
+		 WHERE id = $3`, objectKey, expiresAt, id)
+	return err
+}
+
+func (r *PostgresProvider) FailPrivacyJob(ctx context.Context, id string, reason string) error {
+	_, err := r.Pool.Exec(ctx,
+		`UPDATE privacy_jobs SET status = 'failed', error = $1, updated_at = NOW() WHERE id = $2`, reason, id)
 	return err
 }
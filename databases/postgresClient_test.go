@@ -0,0 +1,48 @@
+package database
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckCategoryPlacement(t *testing.T) {
+	// 1 > 2 > 3, 4 on its own, 5 and 6 already looping into each other,
+	// and a chain 100 > 101 > ... > 132 as deep as the tree may go
+	parents := map[int64]int64{1: 0, 2: 1, 3: 2, 4: 0, 5: 6, 6: 5}
+	for d := int64(0); d <= MaxCategoryDepth; d++ {
+		parents[100+d] = 0
+		if d > 0 {
+			parents[100+d] = 100 + d - 1
+		}
+	}
+	deepest := int64(100 + MaxCategoryDepth)
+
+	tests := []struct {
+		name   string
+		id     int64
+		parent int64
+		want   error
+	}{
+		{"under itself", 1, 1, ErrCategoryCycle},
+		{"under its child", 1, 2, ErrCategoryCycle},
+		{"under its grandchild", 1, 3, ErrCategoryCycle},
+		{"child under grandchild", 2, 3, ErrCategoryCycle},
+		{"under another tree", 1, 4, nil},
+		{"leaf under another tree", 3, 4, nil},
+		{"up the same tree", 3, 1, nil},
+		{"unknown parent", 1, 99, ErrUnknownParent},
+		{"new under the deepest", 0, deepest, ErrCategoryDepth},
+		{"new one level above the deepest", 0, deepest - 1, nil},
+		{"subtree that would end too deep", 1, deepest - 2, ErrCategoryDepth},
+		{"subtree that just fits", 1, deepest - 3, nil},
+		{"under an existing loop", 4, 5, ErrCategoryDepth},
+		{"new under an existing loop", 0, 6, ErrCategoryDepth},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkCategoryPlacement(parents, tt.id, tt.parent); !errors.Is(err, tt.want) {
+				t.Fatalf("checkCategoryPlacement(%d under %d) = %v, want %v", tt.id, tt.parent, err, tt.want)
+			}
+		})
+	}
+}
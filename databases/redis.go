@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -105,3 +106,137 @@ func (r *RedisProvider) HealthDetails(ctx context.Context) (uptime string, laten
 
 	return uptime, latencyMs, nil
 }
+
+// ----------------- Throttling -----------------
+
+// IncrFailures bumps the failure counter for key and returns the new count.
+// The counter slides: it expires window after the latest failure.
+func (r *RedisProvider) IncrFailures(ctx context.Context, key string, window time.Duration) (int64, error) {
+	pipe := r.Client.TxPipeline()
+	incr := pipe.Incr(ctx, "throttle:fail:"+key)
+	pipe.Expire(ctx, "throttle:fail:"+key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// IncrHits counts a request for key in a fixed window starting at the first hit
+func (r *RedisProvider) IncrHits(ctx context.Context, key string, window time.Duration) (int64, error) {
+	n, err := r.Client.Incr(ctx, "throttle:hits:"+key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if n == 1 {
+		r.Client.Expire(ctx, "throttle:hits:"+key, window)
+	}
+	return n, nil
+}
+
+// Lock blocks key for ttl. An existing longer lock is kept.
+func (r *RedisProvider) Lock(ctx context.Context, key string, ttl time.Duration) error {
+	if remaining, err := r.LockRemaining(ctx, key); err == nil && remaining >= ttl {
+		return nil
+	}
+	return r.Client.Set(ctx, "throttle:lock:"+key, "1", ttl).Err()
+}
+
+// LockRemaining returns how long key stays locked (0 if it is not)
+func (r *RedisProvider) LockRemaining(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := r.Client.PTTL(ctx, "throttle:lock:"+key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// ClearFailures drops the failure counter and any lock on key
+func (r *RedisProvider) ClearFailures(ctx context.Context, key string) error {
+	return r.Client.Del(ctx, "throttle:fail:"+key, "throttle:lock:"+key).Err()
+}
+
+// ----------------- Access Token Denylist -----------------
+
+// TrackAccessToken remembers an issued access token so it can be denylisted
+// later by session or user. Entries are "<sessionID> <jti>" scored by expiry.
+func (r *RedisProvider) TrackAccessToken(ctx context.Context, userID, sessionID, jti string, expiresAt time.Time) error {
+	key := "access:user:" + userID
+	pipe := r.Client.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, key, "-inf", fmt.Sprintf("%d", time.Now().Unix()))
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(expiresAt.Unix()), Member: sessionID + " " + jti})
+	pipe.ExpireAt(ctx, key, expiresAt)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// DenyAccessTokens denylists every live access token of userID, or only those of
+// sessionID when it is not empty. Each JTI is kept for its remaining lifetime.
+func (r *RedisProvider) DenyAccessTokens(ctx context.Context, userID, sessionID string) (int, error) {
+	key := "access:user:" + userID
+	now := time.Now()
+	entries, err := r.Client.ZRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+		Min: fmt.Sprintf("%d", now.Unix()),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	pipe := r.Client.TxPipeline()
+	denied := 0
+	for _, e := range entries {
+		member, _ := e.Member.(string)
+		sid, jti, ok := strings.Cut(member, " ")
+		if !ok || (sessionID != "" && sid != sessionID) {
+			continue
+		}
+		ttl := time.Unix(int64(e.Score), 0).Sub(now) + time.Second
+		pipe.Set(ctx, "denylist:jti:"+jti, "1", ttl)
+		pipe.ZRem(ctx, key, member)
+		denied++
+	}
+	if denied == 0 {
+		return 0, nil
+	}
+	_, err = pipe.Exec(ctx)
+	return denied, err
+}
+
+// IsAccessTokenDenied reports whether jti is on the denylist (single key lookup)
+func (r *RedisProvider) IsAccessTokenDenied(ctx context.Context, jti string) (bool, error) {
+	n, err := r.Client.Exists(ctx, "denylist:jti:"+jti).Result()
+	return n > 0, err
+}
+
+// ----------------- WebAuthn Challenges -----------------
+
+// SaveChallenge keeps the state of a started WebAuthn ceremony until it expires
+func (r *RedisProvider) SaveChallenge(ctx context.Context, challenge, state string, ttl time.Duration) error {
+	return r.Client.Set(ctx, "webauthn:challenge:"+challenge, state, ttl).Err()
+}
+
+// TakeChallenge returns and deletes the state of a ceremony, so each challenge
+// is answered once. Returns redis.Nil when it is unknown or expired.
+func (r *RedisProvider) TakeChallenge(ctx context.Context, challenge string) (string, error) {
+	return r.Client.GetDel(ctx, "webauthn:challenge:"+challenge).Result()
+}
+
+// ----------------- Cache -----------------
+
+// GetCached returns a cached value. Returns redis.Nil when it is missing or expired.
+func (r *RedisProvider) GetCached(ctx context.Context, key string) (string, error) {
+	return r.Client.Get(ctx, "cache:"+key).Result()
+}
+
+// SetCached stores a value for ttl
+func (r *RedisProvider) SetCached(ctx context.Context, key, value string, ttl time.Duration) error {
+	return r.Client.Set(ctx, "cache:"+key, value, ttl).Err()
+}
+
+// DropCached removes a cached value so the next read rebuilds it
+func (r *RedisProvider) DropCached(ctx context.Context, key string) error {
+	return r.Client.Del(ctx, "cache:"+key).Err()
+}
@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	product "Adornme/controllers/products"
+	"Adornme/logging"
+	"Adornme/models"
+	"Adornme/restapi/operations/admin_categories"
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+)
+
+func CreateCategory(params admin_categories.CreateCategoryParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "CreateCategory called by %s", principal.UserID)
+
+	// 🔹 Call service layer
+	resp, err := p.CreateCategory(ctx, principal.UserID, params.Body)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, product.ErrInvalidCategory):
+			return admin_categories.NewCreateCategoryBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, product.ErrCategorySlugTaken):
+			return admin_categories.NewCreateCategoryConflict().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_categories.NewCreateCategoryCreated().WithPayload(resp)
+}
+
+func UpdateCategory(params admin_categories.UpdateCategoryParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "UpdateCategory called by %s for categoryID: %d", principal.UserID, params.ID)
+
+	// 🔹 Call service layer
+	resp, err := p.UpdateCategory(ctx, principal.UserID, params.ID, params.Body)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, product.ErrInvalidCategory):
+			return admin_categories.NewUpdateCategoryBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, product.ErrCategoryNotFound):
+			return admin_categories.NewUpdateCategoryNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, product.ErrCategorySlugTaken):
+			return admin_categories.NewUpdateCategoryConflict().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_categories.NewUpdateCategoryOK().WithPayload(resp)
+}
+
+func DeleteCategory(params admin_categories.DeleteCategoryParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "DeleteCategory called by %s for categoryID: %d", principal.UserID, params.ID)
+
+	// 🔹 Call service layer
+	if err := p.DeleteCategory(ctx, principal.UserID, params.ID); err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, product.ErrCategoryNotFound):
+			return admin_categories.NewDeleteCategoryNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, product.ErrCategoryInUse):
+			return admin_categories.NewDeleteCategoryConflict().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_categories.NewDeleteCategoryNoContent()
+}
+
+func CreateCategoryAttribute(params admin_categories.CreateCategoryAttributeParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "CreateCategoryAttribute called by %s for categoryID: %d", principal.UserID, params.ID)
+
+	// 🔹 Call service layer
+	resp, err := p.CreateCategoryAttribute(ctx, principal.UserID, params.ID, params.Body)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, product.ErrInvalidAttribute):
+			return admin_categories.NewCreateCategoryAttributeBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, product.ErrCategoryNotFound):
+			return admin_categories.NewCreateCategoryAttributeNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, product.ErrAttributeExists):
+			return admin_categories.NewCreateCategoryAttributeConflict().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_categories.NewCreateCategoryAttributeCreated().WithPayload(resp)
+}
+
+func UpdateCategoryAttribute(params admin_categories.UpdateCategoryAttributeParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "UpdateCategoryAttribute called by %s for categoryID: %d, code: %s", principal.UserID, params.ID, params.Code)
+
+	// 🔹 Call service layer
+	resp, err := p.UpdateCategoryAttribute(ctx, principal.UserID, params.ID, params.Code, params.Body)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, product.ErrInvalidAttribute):
+			return admin_categories.NewUpdateCategoryAttributeBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, product.ErrAttributeNotFound):
+			return admin_categories.NewUpdateCategoryAttributeNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_categories.NewUpdateCategoryAttributeOK().WithPayload(resp)
+}
+
+func DeleteCategoryAttribute(params admin_categories.DeleteCategoryAttributeParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "DeleteCategoryAttribute called by %s for categoryID: %d, code: %s", principal.UserID, params.ID, params.Code)
+
+	// 🔹 Call service layer
+	if err := p.DeleteCategoryAttribute(ctx, principal.UserID, params.ID, params.Code); err != nil {
+		msg := err.Error()
+		if errors.Is(err, product.ErrAttributeNotFound) {
+			return admin_categories.NewDeleteCategoryAttributeNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_categories.NewDeleteCategoryAttributeNoContent()
+}
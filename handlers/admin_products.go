@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	product "Adornme/controllers/products"
+	"Adornme/logging"
+	"Adornme/models"
+	"Adornme/restapi/operations/admin_products"
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+)
+
+func CreateProduct(params admin_products.CreateProductParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "CreateProduct called by %s", principal.UserID)
+
+	// 🔹 Call service layer
+	resp, err := p.CreateProduct(ctx, principal.UserID, params.Body)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, product.ErrInvalidProduct) {
+			return admin_products.NewCreateProductBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_products.NewCreateProductCreated().WithPayload(resp)
+}
+
+func UpdateProduct(params admin_products.UpdateProductParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "UpdateProduct called by %s for productID: %d", principal.UserID, params.ID)
+
+	// 🔹 Call service layer
+	resp, err := p.UpdateProduct(ctx, principal.UserID, params.ID, params.Body)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, product.ErrInvalidProduct):
+			return admin_products.NewUpdateProductBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, product.ErrProductNotFound):
+			return admin_products.NewUpdateProductNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, product.ErrStaleProduct):
+			return admin_products.NewUpdateProductConflict().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_products.NewUpdateProductOK().WithPayload(resp)
+}
+
+func DeleteProduct(params admin_products.DeleteProductParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "DeleteProduct called by %s for productID: %d", principal.UserID, params.ID)
+
+	// 🔹 Call service layer
+	if err := p.DeleteProduct(ctx, principal.UserID, params.ID); err != nil {
+		msg := err.Error()
+		if errors.Is(err, product.ErrProductNotFound) {
+			return admin_products.NewDeleteProductNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_products.NewDeleteProductNoContent()
+}
+
+func CreateVariant(params admin_products.CreateVariantParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "CreateVariant called by %s for productID: %d", principal.UserID, params.ID)
+
+	// 🔹 Call service layer
+	resp, err := p.CreateVariant(ctx, principal.UserID, params.ID, params.Body)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, product.ErrInvalidVariant):
+			return admin_products.NewCreateVariantBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, product.ErrProductNotFound):
+			return admin_products.NewCreateVariantNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, product.ErrSKUTaken), errors.Is(err, product.ErrBarcodeTaken), errors.Is(err, product.ErrVariantExists),
+			errors.Is(err, product.ErrStaleProduct):
+			return admin_products.NewCreateVariantConflict().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_products.NewCreateVariantCreated().WithPayload(resp)
+}
+
+func UpdateVariant(params admin_products.UpdateVariantParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "UpdateVariant called by %s for productID: %d, variantID: %d", principal.UserID, params.ID, params.VariantID)
+
+	// 🔹 Call service layer
+	resp, err := p.UpdateVariant(ctx, principal.UserID, params.ID, params.VariantID, params.Body)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, product.ErrInvalidVariant):
+			return admin_products.NewUpdateVariantBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, product.ErrProductNotFound), errors.Is(err, product.ErrVariantNotFound):
+			return admin_products.NewUpdateVariantNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, product.ErrSKUTaken), errors.Is(err, product.ErrBarcodeTaken), errors.Is(err, product.ErrVariantExists),
+			errors.Is(err, product.ErrStaleProduct):
+			return admin_products.NewUpdateVariantConflict().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_products.NewUpdateVariantOK().WithPayload(resp)
+}
+
+func DeleteVariant(params admin_products.DeleteVariantParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "DeleteVariant called by %s for productID: %d, variantID: %d", principal.UserID, params.ID, params.VariantID)
+
+	// 🔹 Call service layer
+	if err := p.DeleteVariant(ctx, principal.UserID, params.ID, params.VariantID); err != nil {
+		msg := err.Error()
+		if errors.Is(err, product.ErrVariantNotFound) {
+			return admin_products.NewDeleteVariantNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_products.NewDeleteVariantNoContent()
+}
@@ -0,0 +1,256 @@
+package handlers
+
+import (
+	user "Adornme/controllers/users"
+	db "Adornme/databases"
+	"Adornme/logging"
+	"Adornme/models"
+	"Adornme/restapi/operations/admin_users"
+	"Adornme/utils"
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+)
+
+func UnlockUser(params admin_users.UnlockUserParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "UnlockUser called by %s for userID: %d", principal.UserID, params.ID)
+
+	// 🔹 Call service layer
+	if err := u.UnlockAccount(ctx, strconv.FormatInt(params.ID, 10)); err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrUserNotFound) {
+			return admin_users.NewUnlockUserNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	success := "account unlocked"
+	return admin_users.NewUnlockUserOK().WithPayload(&models.SuccessResponse{Message: &success})
+}
+
+func ImpersonateUser(params admin_users.ImpersonateUserParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "ImpersonateUser called by %s for userID: %d", principal.UserID, params.ID)
+
+	reason := ""
+	if params.Body != nil && params.Body.Reason != nil {
+		reason = strings.TrimSpace(*params.Body.Reason)
+	}
+
+	// 🔹 Call service layer
+	resp, err := u.ImpersonateUser(ctx, principal.UserID, strconv.FormatInt(params.ID, 10), reason)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, user.ErrUserNotFound):
+			return admin_users.NewImpersonateUserNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrCannotImpersonate), errors.Is(err, user.ErrImpersonationReason):
+			return admin_users.NewImpersonateUserBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrAuditUnavailable):
+			return middleware.Error(503, &models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_users.NewImpersonateUserOK().WithPayload(resp)
+}
+
+func ListUsers(params admin_users.ListUsersParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "ListUsers called by %s", principal.UserID)
+
+	// 🔹 Map query params to the filter
+	filter := db.UserFilter{
+		Verified: params.Verified,
+		Disabled: params.Disabled,
+	}
+	if params.Email != nil {
+		filter.Email = strings.TrimSpace(*params.Email)
+	}
+	if params.Phone != nil {
+		filter.Phone = strings.TrimSpace(*params.Phone)
+	}
+	if params.Role != nil {
+		filter.Role = *params.Role
+	}
+	if params.CreatedFrom != nil {
+		t := time.Time(*params.CreatedFrom)
+		filter.CreatedFrom = &t
+	}
+	if params.CreatedTo != nil {
+		t := time.Time(*params.CreatedTo)
+		filter.CreatedTo = &t
+	}
+
+	// 🔹 Call service layer
+	resp, err := u.ListUsers(ctx, filter, *params.Page, *params.Limit)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrInvalidUserListPage) || errors.Is(err, user.ErrInvalidRole) {
+			return admin_users.NewListUsersBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_users.NewListUsersOK().WithPayload(resp)
+}
+
+func GetUser(params admin_users.GetUserParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "GetUser called by %s for userID: %d", principal.UserID, params.ID)
+
+	// 🔹 Call service layer
+	resp, err := u.AdminGetUser(ctx, strconv.FormatInt(params.ID, 10))
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrUserNotFound) {
+			return admin_users.NewGetUserNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_users.NewGetUserOK().WithPayload(resp)
+}
+
+func UpdateUser(params admin_users.UpdateUserParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "UpdateUser called by %s for userID: %d", principal.UserID, params.ID)
+
+	// 🔹 Call service layer
+	resp, err := u.AdminUpdateUser(ctx, principal.UserID, strconv.FormatInt(params.ID, 10), params.Body)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, user.ErrUserNotFound):
+			return admin_users.NewUpdateUserNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrContactInUse):
+			return admin_users.NewUpdateUserConflict().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrInvalidRole), errors.Is(err, user.ErrInvalidUserUpdate), errors.Is(err, user.ErrSelfManagement):
+			return admin_users.NewUpdateUserBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_users.NewUpdateUserOK().WithPayload(resp)
+}
+
+func DisableUser(params admin_users.DisableUserParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "DisableUser called by %s for userID: %d", principal.UserID, params.ID)
+
+	// 🔹 Call service layer
+	if err := u.DisableUser(ctx, principal.UserID, strconv.FormatInt(params.ID, 10)); err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, user.ErrUserNotFound):
+			return admin_users.NewDisableUserNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrAlreadyDisabled):
+			return admin_users.NewDisableUserConflict().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrSelfManagement):
+			return admin_users.NewDisableUserBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	success := "account disabled"
+	return admin_users.NewDisableUserOK().WithPayload(&models.SuccessResponse{Message: &success})
+}
+
+func EnableUser(params admin_users.EnableUserParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "EnableUser called by %s for userID: %d", principal.UserID, params.ID)
+
+	// 🔹 Call service layer
+	if err := u.EnableUser(ctx, principal.UserID, strconv.FormatInt(params.ID, 10)); err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, user.ErrUserNotFound):
+			return admin_users.NewEnableUserNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrNotDisabled):
+			return admin_users.NewEnableUserConflict().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	success := "account enabled"
+	return admin_users.NewEnableUserOK().WithPayload(&models.SuccessResponse{Message: &success})
+}
+
+func DeleteUser(params admin_users.DeleteUserParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "DeleteUser called by %s for userID: %d", principal.UserID, params.ID)
+
+	// 🔹 Call service layer
+	if err := u.AdminDeleteUser(ctx, principal.UserID, strconv.FormatInt(params.ID, 10)); err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, user.ErrUserNotFound):
+			return admin_users.NewDeleteUserNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrSelfManagement):
+			return admin_users.NewDeleteUserBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_users.NewDeleteUserNoContent()
+}
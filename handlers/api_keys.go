@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	user "Adornme/controllers/users"
+	"Adornme/logging"
+	"Adornme/models"
+	"Adornme/restapi/operations/admin_api_keys"
+	"Adornme/utils"
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+)
+
+func ListAPIKeys(params admin_api_keys.ListAPIKeysParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "ListAPIKeys called by %s", principal.UserID)
+
+	keys, err := u.ListAPIKeys(ctx)
+	if err != nil {
+		msg := err.Error()
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_api_keys.NewListAPIKeysOK().WithPayload(keys)
+}
+
+func CreateAPIKey(params admin_api_keys.CreateAPIKeyParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "CreateAPIKey called by %s", principal.UserID)
+
+	// 🔹 Call service layer
+	created, err := u.CreateAPIKey(ctx, principal.UserID, params.Body)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrInvalidAPIKeyRequest) {
+			return admin_api_keys.NewCreateAPIKeyBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_api_keys.NewCreateAPIKeyCreated().WithPayload(created)
+}
+
+func RevokeAPIKey(params admin_api_keys.RevokeAPIKeyParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "RevokeAPIKey called by %s for key: %d", principal.UserID, params.ID)
+
+	// 🔹 Call service layer
+	if err := u.RevokeAPIKey(ctx, principal.UserID, params.ID); err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrAPIKeyNotFound) {
+			return admin_api_keys.NewRevokeAPIKeyNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return admin_api_keys.NewRevokeAPIKeyNoContent()
+}
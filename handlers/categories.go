@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	product "Adornme/controllers/products"
+	"Adornme/logging"
+	"Adornme/models"
+	"Adornme/restapi/operations/categories"
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+)
+
+func ListCategories(params categories.ListCategoriesParams) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	// 🔹 Call service layer
+	tree, err := p.ListCategories(ctx)
+	if err != nil {
+		msg := err.Error()
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return categories.NewListCategoriesOK().WithPayload(tree)
+}
+
+func ListCategoryAttributes(params categories.ListCategoryAttributesParams) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	// 🔹 Call service layer
+	resp, err := p.ListCategoryAttributes(ctx, params.ID)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, product.ErrCategoryNotFound) {
+			return categories.NewListCategoryAttributesNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return categories.NewListCategoryAttributesOK().WithPayload(resp)
+}
@@ -2,11 +2,14 @@ package handlers
 
 import (
 	ad "Adornme/cmd/app"
+	user "Adornme/controllers/users"
 	database "Adornme/databases"
 	"Adornme/logging"
+	"Adornme/models"
 	"Adornme/restapi/operations/system"
 	"Adornme/utils"
 	"context"
+	"errors"
 	"time"
 
 	"github.com/go-openapi/runtime/middleware"
@@ -99,3 +102,20 @@ func GetHealth(params system.GetHealthParams) middleware.Responder {
 
 	return system.NewGetHealthOK().WithPayload(resp)
 }
+
+// SmsDeliveryStatus handles the POST /webhooks/sms/{provider} endpoint
+func SmsDeliveryStatus(params system.SmsDeliveryStatusParams) middleware.Responder {
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	err := user.HandleSMSDeliveryStatus(ctx, params.Provider, params.HTTPRequest)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrSMSProviderMismatch) {
+			return system.NewSmsDeliveryStatusNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return system.NewSmsDeliveryStatusBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	return system.NewSmsDeliveryStatusNoContent()
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	auth "Adornme/Auth"
+	"Adornme/models"
+	"Adornme/restapi/operations/system"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetJWKS handles the GET /.well-known/jwks.json endpoint
+func GetJWKS(params system.GetJWKSParams) middleware.Responder {
+	keys := auth.JWKS()
+
+	resp := &models.JWKSet{Keys: make([]*models.JSONWebKey, 0, len(keys))}
+	for _, k := range keys {
+		kty, kid := k.Kty, k.Kid
+		resp.Keys = append(resp.Keys, &models.JSONWebKey{
+			Kty: &kty,
+			Kid: &kid,
+			Use: k.Use,
+			Alg: k.Alg,
+			N:   k.N,
+			E:   k.E,
+			Crv: k.Crv,
+			X:   k.X,
+		})
+	}
+
+	return system.NewGetJWKSOK().WithPayload(resp)
+}
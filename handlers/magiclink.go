@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	user "Adornme/controllers/users"
+	"Adornme/logging"
+	"Adornme/models"
+	"Adornme/restapi/operations/users"
+	"Adornme/utils"
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+)
+
+func SendMagicLink(params users.SendMagicLinkParams) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Info(ctx, "SendMagicLink request received")
+
+	// 🔹 Call service layer
+	if err := u.SendMagicLink(ctx, params.Body.Email.String(), *params.Body.DeviceID); err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrTooManyAttempts) {
+			return users.NewSendMagicLinkTooManyRequests().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	success := "If the account exists, a sign-in link has been sent"
+	return users.NewSendMagicLinkOK().WithPayload(&models.SuccessResponse{Message: &success})
+}
+
+func VerifyMagicLink(params users.VerifyMagicLinkParams) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Info(ctx, "VerifyMagicLink request received")
+
+	// 🔹 Call service layer
+	resp, challenge, err := u.VerifyMagicLink(ctx, *params.Body.Token, *params.Body.DeviceID)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrMagicLinkDevice) {
+			return users.NewVerifyMagicLinkForbidden().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return users.NewVerifyMagicLinkUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	// 🔹 Second factor pending
+	if challenge != nil {
+		return users.NewVerifyMagicLinkAccepted().WithPayload(challenge)
+	}
+
+	return users.NewVerifyMagicLinkOK().WithPayload(resp)
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	user "Adornme/controllers/users"
+	"Adornme/logging"
+	"Adornme/models"
+	"Adornme/restapi/operations/users"
+	"Adornme/utils"
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+)
+
+func VerifyMFA(params users.VerifyMFAParams) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Info(ctx, "VerifyMFA request received")
+
+	if params.Body.MfaToken == nil || params.Body.Code == nil {
+		msg := "mfaToken and code are required"
+		return users.NewVerifyMFAUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	// 🔹 Call service layer
+	resp, err := u.VerifyMFA(ctx, *params.Body.MfaToken, *params.Body.Code)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrTooManyAttempts) {
+			return users.NewVerifyMFATooManyRequests().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return users.NewVerifyMFAUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewVerifyMFAOK().WithPayload(resp)
+}
+
+func EnrollMFA(params users.EnrollMFAParams) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Info(ctx, "EnrollMFA request received")
+
+	if params.Body.MfaToken == nil {
+		msg := "mfaToken is required"
+		return users.NewEnrollMFAUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	// 🔹 Call service layer
+	enrollment, err := u.EnrollMFA(ctx, *params.Body.MfaToken)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrInvalidMFAToken) {
+			return users.NewEnrollMFAUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return users.NewEnrollMFABadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewEnrollMFAOK().WithPayload(enrollment)
+}
+
+func StartMFAEnrollment(params users.StartMFAEnrollmentParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "StartMFAEnrollment called for userID: %s", principal.UserID)
+
+	// 🔹 Call service layer
+	enrollment, err := u.StartMFAEnrollment(ctx, principal.UserID)
+	if err != nil {
+		msg := err.Error()
+		return users.NewStartMFAEnrollmentBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewStartMFAEnrollmentOK().WithPayload(enrollment)
+}
+
+func ConfirmMFA(params users.ConfirmMFAParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "ConfirmMFA called for userID: %s", principal.UserID)
+
+	if params.Body.Code == nil {
+		msg := "code is required"
+		return users.NewConfirmMFABadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	// 🔹 Call service layer
+	if err := u.ConfirmMFA(ctx, principal.UserID, *params.Body.Code); err != nil {
+		msg := err.Error()
+		return users.NewConfirmMFABadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	success := "mfa enabled"
+	return users.NewConfirmMFAOK().WithPayload(&models.SuccessResponse{Message: &success})
+}
+
+func DisableMFA(params users.DisableMFAParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "DisableMFA called for userID: %s", principal.UserID)
+
+	if params.Body.Code == nil {
+		msg := "code is required"
+		return users.NewDisableMFABadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	// 🔹 Call service layer
+	if err := u.DisableMFA(ctx, principal.UserID, *params.Body.Code); err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrMFAMandatory) {
+			return users.NewDisableMFAForbidden().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return users.NewDisableMFABadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	success := "mfa disabled"
+	return users.NewDisableMFAOK().WithPayload(&models.SuccessResponse{Message: &success})
+}
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	user "Adornme/controllers/users"
+	"Adornme/logging"
+	"Adornme/models"
+	"Adornme/restapi/operations/users"
+	"Adornme/utils"
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+)
+
+func BeginPasskeyRegistration(params users.BeginPasskeyRegistrationParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	// 🔹 Call service layer
+	opts, err := u.BeginPasskeyRegistration(ctx, principal.UserID)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrUserNotFound) || errors.Is(err, user.ErrAccountDisabled) {
+			return users.NewBeginPasskeyRegistrationUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewBeginPasskeyRegistrationOK().WithPayload(opts)
+}
+
+func RegisterPasskey(params users.RegisterPasskeyParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	// 🔹 Call service layer
+	passkey, err := u.RegisterPasskey(ctx, principal.UserID, params.Body)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, user.ErrInvalidPasskey):
+			return users.NewRegisterPasskeyBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrPasskeyExists):
+			return users.NewRegisterPasskeyConflict().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrUserNotFound), errors.Is(err, user.ErrAccountDisabled):
+			return users.NewRegisterPasskeyUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewRegisterPasskeyCreated().WithPayload(passkey)
+}
+
+func ListPasskeys(params users.ListPasskeysParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	// 🔹 Call service layer
+	passkeys, err := u.ListPasskeys(ctx, principal.UserID)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrUserNotFound) || errors.Is(err, user.ErrAccountDisabled) {
+			return users.NewListPasskeysUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewListPasskeysOK().WithPayload(passkeys)
+}
+
+func DeletePasskey(params users.DeletePasskeyParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	// 🔹 Call service layer
+	if err := u.DeletePasskey(ctx, principal.UserID, params.ID); err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, user.ErrPasskeyNotFound):
+			return users.NewDeletePasskeyNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrUserNotFound), errors.Is(err, user.ErrAccountDisabled):
+			return users.NewDeletePasskeyUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewDeletePasskeyNoContent()
+}
+
+func BeginPasskeyLogin(params users.BeginPasskeyLoginParams) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Info(ctx, "BeginPasskeyLogin request received")
+
+	// 🔹 Call service layer
+	opts, err := u.BeginPasskeyLogin(ctx, params.Body.Email.String(), params.Body.MfaToken)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, user.ErrTooManyAttempts):
+			return users.NewBeginPasskeyLoginTooManyRequests().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrInvalidMFAToken), errors.Is(err, user.ErrPasskeyNotFound):
+			return users.NewBeginPasskeyLoginUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewBeginPasskeyLoginOK().WithPayload(opts)
+}
+
+func LoginWithPasskey(params users.LoginWithPasskeyParams) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Info(ctx, "LoginWithPasskey request received")
+
+	// 🔹 Call service layer
+	resp, err := u.LoginWithPasskey(ctx, params.Body)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrPasskeysUnavailable) {
+			return middleware.Error(503, &models.ErrorResponse{Error: &msg})
+		}
+		return users.NewLoginWithPasskeyUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewLoginWithPasskeyOK().WithPayload(resp)
+}
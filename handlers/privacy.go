@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	user "Adornme/controllers/users"
+	"Adornme/logging"
+	"Adornme/models"
+	"Adornme/restapi/operations/users"
+	"Adornme/utils"
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+)
+
+func RequestDataExport(params users.RequestDataExportParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "RequestDataExport called for userID: %s", principal.UserID)
+
+	// 🔹 Call service layer
+	job, err := u.RequestDataExport(ctx, principal.UserID)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrUserNotFound) {
+			return users.NewRequestDataExportUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewRequestDataExportAccepted().WithPayload(job)
+}
+
+func RequestErasure(params users.RequestErasureParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "RequestErasure called for userID: %s", principal.UserID)
+
+	currentPassword := ""
+	if params.Body != nil {
+		currentPassword = params.Body.CurrentPassword
+	}
+
+	// 🔹 Call service layer
+	job, err := u.RequestErasure(ctx, principal.UserID, currentPassword)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, user.ErrWrongPassword):
+			return users.NewRequestErasureForbidden().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrInvalidErasureRequest):
+			return users.NewRequestErasureBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrUserNotFound):
+			return users.NewRequestErasureUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewRequestErasureAccepted().WithPayload(job)
+}
+
+func GetPrivacyJob(params users.GetPrivacyJobParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	// 🔹 Call service layer
+	job, err := u.GetPrivacyJob(ctx, principal.UserID, params.ID)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrPrivacyJobNotFound) {
+			return users.NewGetPrivacyJobNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewGetPrivacyJobOK().WithPayload(job)
+}
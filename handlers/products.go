@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	product "Adornme/controllers/products"
+	db "Adornme/databases"
+	"Adornme/logging"
+	"Adornme/models"
+	"Adornme/restapi/operations/products"
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+)
+
+func ListProducts(params products.ListProductsParams) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	// 🔹 Map query params to the filter
+	filter := db.ProductFilter{
+		CategoryID: params.CategoryID,
+		MinPrice:   params.MinPrice,
+		MaxPrice:   params.MaxPrice,
+	}
+	if params.Search != nil {
+		filter.Search = *params.Search
+	}
+	if params.InStock != nil {
+		filter.InStock = *params.InStock
+	}
+	if params.Sort != nil {
+		filter.Sort = *params.Sort
+	}
+	attrs, err := product.AttributeFilters(params.HTTPRequest.URL.Query())
+	if err != nil {
+		msg := err.Error()
+		return products.NewListProductsBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+	filter.Attributes = attrs
+
+	// 🔹 Call service layer
+	resp, err := p.ListProducts(ctx, filter, *params.Page, *params.Limit)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, product.ErrInvalidProductListPage) || errors.Is(err, product.ErrInvalidPriceRange) ||
+			errors.Is(err, product.ErrInvalidProductSort) {
+			return products.NewListProductsBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return products.NewListProductsOK().WithPayload(resp)
+}
+
+func GetProduct(params products.GetProductParams) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	p := product.NewProduct(requestID, "en", requestID, "My-Service")
+
+	// 🔹 Call service layer
+	resp, err := p.GetProduct(ctx, params.ID)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, product.ErrProductNotFound) {
+			return products.NewGetProductNotFound().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return products.NewGetProductOK().WithPayload(resp)
+}
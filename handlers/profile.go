@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	user "Adornme/controllers/users"
+	"Adornme/internal/otp"
+	"Adornme/logging"
+	"Adornme/models"
+	"Adornme/restapi/operations/users"
+	"Adornme/utils"
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+)
+
+func UpdateUserProfile(params users.UpdateUserProfileParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "UpdateUserProfile called for userID: %s", principal.UserID)
+
+	// 🔹 Call service layer
+	resp, err := u.UpdateProfile(ctx, principal.UserID, principal.SessionID, params.Body)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, user.ErrWrongPassword):
+			return users.NewUpdateUserProfileForbidden().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrContactInUse):
+			return users.NewUpdateUserProfileConflict().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrUserNotFound):
+			return users.NewUpdateUserProfileUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrInvalidProfileUpdate), errors.Is(err, user.ErrWeakPassword), errors.Is(err, otp.ErrCooldown):
+			return users.NewUpdateUserProfileBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return middleware.Error(500, &models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewUpdateUserProfileOK().WithPayload(resp)
+}
+
+func ConfirmEmailChange(params users.ConfirmEmailChangeParams) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Info(ctx, "ConfirmEmailChange request received")
+
+	if params.Body.Token == nil || *params.Body.Token == "" {
+		msg := "token is required"
+		return users.NewConfirmEmailChangeBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	// 🔹 Call service layer
+	if err := u.ConfirmEmailChange(ctx, *params.Body.Token); err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrContactInUse) {
+			return users.NewConfirmEmailChangeConflict().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return users.NewConfirmEmailChangeBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	success := "email changed"
+	return users.NewConfirmEmailChangeOK().WithPayload(&models.SuccessResponse{Message: &success})
+}
+
+func ConfirmPhoneChange(params users.ConfirmPhoneChangeParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "ConfirmPhoneChange called for userID: %s", principal.UserID)
+
+	if params.Body.Code == nil || *params.Body.Code == "" {
+		msg := "code is required"
+		return users.NewConfirmPhoneChangeBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	// 🔹 Call service layer
+	resp, err := u.ConfirmPhoneChange(ctx, principal.UserID, *params.Body.Code)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, otp.ErrTooManyAttempts):
+			return users.NewConfirmPhoneChangeTooManyRequests().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrContactInUse):
+			return users.NewConfirmPhoneChangeConflict().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, user.ErrUserNotFound):
+			return users.NewConfirmPhoneChangeUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return users.NewConfirmPhoneChangeBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewConfirmPhoneChangeOK().WithPayload(resp)
+}
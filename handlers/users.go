@@ -3,11 +3,13 @@ package handlers
 import (
 	auth "Adornme/Auth"
 	user "Adornme/controllers/users"
+	"Adornme/internal/otp"
 	"Adornme/logging"
 	"Adornme/models"
 	"Adornme/restapi/operations/users"
+	"Adornme/utils"
 	"context"
-	"fmt"
+	"errors"
 	"log"
 	"strconv"
 	"strings"
@@ -22,6 +24,7 @@ func RegisterUser(params users.RegisterUserParams) middleware.Responder {
 	// Generate a unique request ID
 	requestID := uuid.New().String()
 	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
 
 	logs.Infof(ctx, "Register User Called: %v", params.Body)
 
@@ -30,9 +33,15 @@ func RegisterUser(params users.RegisterUserParams) middleware.Responder {
 
 	u := user.NewUser(userID, "en", userID, "My-Service")
 	authResponse, err := u.RegisterUser(ctx, params.Body)
-
 	if err != nil {
-		return users.NewRegisterUserBadRequest().WithPayload(err)
+		msg := err.Error()
+		switch {
+		case errors.Is(err, user.ErrContactInUse):
+			return users.NewRegisterUserConflict().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, otp.ErrTooManyAttempts):
+			return users.NewRegisterUserTooManyRequests().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return users.NewRegisterUserBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
 	}
 
 	return users.NewRegisterUserCreated().WithPayload(authResponse)
@@ -101,16 +110,25 @@ func LoginUser(params users.LoginUserParams) middleware.Responder {
 
 	requestID := uuid.New().String()
 	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
 
 	u := user.NewUser(requestID, "en", requestID, "My-Service")
 
-	resp, err := u.Login(ctx, params.Body.Email, *params.Body.Password)
+	resp, challenge, err := u.Login(ctx, params.Body.Email, *params.Body.Password)
 	if err != nil {
 		msg := err.Error()
+		if errors.Is(err, user.ErrTooManyAttempts) {
+			return users.NewLoginUserTooManyRequests().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
 		return users.NewLoginUserUnauthorized().
 			WithPayload(&models.ErrorResponse{Error: &msg})
 	}
 
+	// 🔹 Second factor pending
+	if challenge != nil {
+		return users.NewLoginUserAccepted().WithPayload(challenge)
+	}
+
 	return users.NewLoginUserOK().WithPayload(resp)
 }
 
@@ -119,6 +137,7 @@ func RefreshToken(params users.RefreshTokenParams) middleware.Responder {
 	// 1️⃣ Request ID + context
 	requestID := uuid.New().String()
 	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
 
 	logs.Infof(ctx, "RefreshToken called")
 
@@ -172,7 +191,6 @@ func LogoutUser(params users.LogoutUserParams) middleware.Responder {
 	}
 
 	refreshToken := parts[1]
-	fmt.Printf("Extracted refresh token: %s\n", refreshToken)
 
 	// 🔹 3. Call service layer
 	err := u.Logout(ctx, refreshToken)
@@ -187,6 +205,48 @@ func LogoutUser(params users.LogoutUserParams) middleware.Responder {
 	return users.NewLogoutUserOK().WithPayload(&models.SuccessResponse{Message: &success})
 }
 
+func ListUserSessions(params users.ListUserSessionsParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "ListUserSessions called for userID: %s", principal.UserID)
+
+	sessions, errResp := u.ListSessions(ctx, principal.UserID, principal.SessionID)
+	if errResp != nil {
+		logs.Errorf(ctx, "LIST SESSIONS FAILED: userID=%s, error=%v", principal.UserID, *errResp.Error)
+		return users.NewListUserSessionsUnauthorized().WithPayload(errResp)
+	}
+
+	return users.NewListUserSessionsOK().WithPayload(sessions)
+}
+
+func RevokeUserSession(params users.RevokeUserSessionParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	// 🔹 Call service layer
+	err := u.RevokeSession(ctx, principal.UserID, params.ID)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, user.ErrSessionNotFound) {
+			return users.NewRevokeUserSessionNotFound().
+				WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return users.NewRevokeUserSessionUnauthorized().
+			WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewRevokeUserSessionNoContent()
+}
+
 func ForgetPassword(params users.ForgetPasswordParams) middleware.Responder {
 
 	// 🔹 Request context + logging
@@ -231,10 +291,42 @@ func ForgetPassword(params users.ForgetPasswordParams) middleware.Responder {
 		WithPayload(&users.ForgetPasswordOKBody{Message: success})
 }
 
+func ResetPassword(params users.ResetPasswordParams) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Info(ctx, "ResetPassword request received")
+
+	// 🔹 Validate input
+	if params.Body.Token == nil || strings.TrimSpace(*params.Body.Token) == "" || params.Body.NewPassword == nil {
+		msg := "token and newPassword are required"
+		return users.NewResetPasswordBadRequest().
+			WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	// 🔹 Call service layer
+	err := u.ResetPassword(ctx, *params.Body.Token, *params.Body.NewPassword)
+	if err != nil {
+		msg := err.Error()
+		logs.Error(ctx, "ResetPassword service failed", "error", msg)
+		return users.NewResetPasswordBadRequest().
+			WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	success := "password has been reset, please log in again"
+	return users.NewResetPasswordOK().WithPayload(&models.SuccessResponse{Message: &success})
+}
+
 func IdentifyUser(params users.IdentifyUserParams) middleware.Responder {
 	// 🔹 Request context + logging
 	requestID := uuid.New().String()
 	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
 
 	u := user.NewUser(requestID, "en", requestID, "My-Service")
 
@@ -253,10 +345,15 @@ func IdentifyUser(params users.IdentifyUserParams) middleware.Responder {
 	identifier := strings.TrimSpace(*params.Body.Identifier)
 
 	// ✅ Call controller
-	err := u.IdentifyUser(params.HTTPRequest.Context(), identifier)
+	err := u.IdentifyUser(ctx, identifier)
 	if err != nil {
 		log.Printf("Identify error: %v", err)
 
+		if errors.Is(err, user.ErrTooManyAttempts) {
+			msg := err.Error()
+			return users.NewIdentifyUserTooManyRequests().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+
 		msg := "invalid identifier"
 		return users.NewIdentifyUserBadRequest().WithPayload(&models.ErrorResponse{
 			Error: &msg,
@@ -271,6 +368,7 @@ func SendOTP(params users.OTPLoginParams) middleware.Responder {
 	// 🔹 Request context + logging
 	requestID := uuid.New().String()
 	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
 
 	u := user.NewUser(requestID, "en", requestID, "My-Service")
 
@@ -288,15 +386,90 @@ func SendOTP(params users.OTPLoginParams) middleware.Responder {
 	identifier := strings.TrimSpace(*params.Body.Identifier)
 
 	// ✅ Call controller
-	err := u.SendOTP(params.HTTPRequest.Context(), identifier)
+	err := u.SendOTP(ctx, identifier)
 	if err != nil {
 		log.Printf("Send OTP error: %v", err)
 
-		msg := "failed to send OTP"
+		msg := err.Error()
+		if errors.Is(err, otp.ErrCooldown) || errors.Is(err, user.ErrTooManyAttempts) {
+			return users.NewOTPLoginTooManyRequests().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		if !errors.Is(err, user.ErrInvalidIdentifier) {
+			msg = "failed to send OTP"
+		}
 		return users.NewOTPLoginBadRequest().WithPayload(&models.ErrorResponse{
 			Error: &msg,
 		})
 	}
 
-	return users.NewOTPLoginOK().WithPayload(&models.GenericResponse{Message: "OTP sent successfully"})
+	return users.NewOTPLoginOK().WithPayload(&models.GenericResponse{Message: "OTP sent if account exists"})
+}
+
+func ResendOTP(params users.ResendOTPParams) middleware.Responder {
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Info(ctx, "ResendOTP request received")
+
+	// 🔹 Validate input
+	if params.Body.Identifier == nil || strings.TrimSpace(*params.Body.Identifier) == "" {
+		msg := "invalid identifier format"
+		return users.NewResendOTPBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	// ✅ Same path as the first send; the cooldown decides whether a new code goes out
+	err := u.SendOTP(ctx, strings.TrimSpace(*params.Body.Identifier))
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, otp.ErrCooldown) || errors.Is(err, user.ErrTooManyAttempts) {
+			return users.NewResendOTPTooManyRequests().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		if !errors.Is(err, user.ErrInvalidIdentifier) {
+			msg = "failed to resend OTP"
+		}
+		return users.NewResendOTPBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	return users.NewResendOTPOK().WithPayload(&models.GenericResponse{Message: "OTP resent if account exists"})
+}
+
+func VerifyOTP(params users.VerifyOTPParams) middleware.Responder {
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+	ctx = utils.WithClientInfo(ctx, params.HTTPRequest.UserAgent(), utils.ClientIP(params.HTTPRequest))
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Info(ctx, "VerifyOTP request received")
+
+	// 🔹 Validate input
+	if params.Body.Identifier == nil || params.Body.Otp == nil || strings.TrimSpace(*params.Body.Identifier) == "" {
+		msg := "identifier and otp are required"
+		return users.NewVerifyOTPBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	// ✅ Call controller
+	resp, challenge, err := u.VerifyOTP(ctx, *params.Body.Identifier, *params.Body.Otp)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case errors.Is(err, user.ErrInvalidIdentifier):
+			return users.NewVerifyOTPBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+		case errors.Is(err, otp.ErrTooManyAttempts):
+			return users.NewVerifyOTPTooManyRequests().WithPayload(&models.ErrorResponse{Error: &msg})
+		default:
+			return users.NewVerifyOTPUnauthorized().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+	}
+
+	if challenge != nil {
+		return users.NewVerifyOTPAccepted().WithPayload(challenge)
+	}
+
+	return users.NewVerifyOTPOK().WithPayload(resp)
 }
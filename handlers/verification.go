@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	user "Adornme/controllers/users"
+	"Adornme/internal/otp"
+	"Adornme/logging"
+	"Adornme/models"
+	"Adornme/restapi/operations/users"
+	"context"
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+)
+
+func VerifyEmail(params users.VerifyEmailParams) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Info(ctx, "VerifyEmail request received")
+
+	if params.Body.Token == nil || *params.Body.Token == "" {
+		msg := "token is required"
+		return users.NewVerifyEmailBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	// 🔹 Call service layer
+	if err := u.VerifyEmail(ctx, *params.Body.Token); err != nil {
+		msg := err.Error()
+		return users.NewVerifyEmailBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	success := "email verified"
+	return users.NewVerifyEmailOK().WithPayload(&models.SuccessResponse{Message: &success})
+}
+
+func SendEmailVerification(params users.SendEmailVerificationParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "SendEmailVerification called for userID: %s", principal.UserID)
+
+	// 🔹 Call service layer
+	if err := u.SendEmailVerification(ctx, principal.UserID); err != nil {
+		msg := err.Error()
+		return users.NewSendEmailVerificationBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	success := "verification link sent"
+	return users.NewSendEmailVerificationOK().WithPayload(&models.SuccessResponse{Message: &success})
+}
+
+func SendPhoneVerification(params users.SendPhoneVerificationParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "SendPhoneVerification called for userID: %s", principal.UserID)
+
+	// 🔹 Call service layer
+	if err := u.SendPhoneVerification(ctx, principal.UserID); err != nil {
+		msg := err.Error()
+		if errors.Is(err, otp.ErrCooldown) {
+			return users.NewSendPhoneVerificationTooManyRequests().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return users.NewSendPhoneVerificationBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	success := "verification code sent"
+	return users.NewSendPhoneVerificationOK().WithPayload(&models.SuccessResponse{Message: &success})
+}
+
+func VerifyPhone(params users.VerifyPhoneParams, principal *models.Principal) middleware.Responder {
+
+	// 🔹 Request context + logging
+	requestID := uuid.New().String()
+	ctx := logging.WithRequestID(context.Background(), requestID)
+
+	u := user.NewUser(requestID, "en", requestID, "My-Service")
+
+	logs.Infof(ctx, "VerifyPhone called for userID: %s", principal.UserID)
+
+	if params.Body.Code == nil || *params.Body.Code == "" {
+		msg := "code is required"
+		return users.NewVerifyPhoneBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	// 🔹 Call service layer
+	if err := u.VerifyPhone(ctx, principal.UserID, *params.Body.Code); err != nil {
+		msg := err.Error()
+		if errors.Is(err, otp.ErrTooManyAttempts) {
+			return users.NewVerifyPhoneTooManyRequests().WithPayload(&models.ErrorResponse{Error: &msg})
+		}
+		return users.NewVerifyPhoneBadRequest().WithPayload(&models.ErrorResponse{Error: &msg})
+	}
+
+	success := "phone verified"
+	return users.NewVerifyPhoneOK().WithPayload(&models.SuccessResponse{Message: &success})
+}
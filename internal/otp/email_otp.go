@@ -0,0 +1,9 @@
+package otp
+
+import "Adornme/utils"
+
+type EmailSender struct{}
+
+func (e *EmailSender) Send(email, otp string) error {
+	return utils.SendOTPEmail(email, otp)
+}
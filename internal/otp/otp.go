@@ -1,12 +1,24 @@
 package otp
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
-	"math/rand"
-	"time"
+	"math/big"
 )
 
-func GenerateOTP() string {
-	rand.Seed(time.Now().UnixNano())
-	return fmt.Sprintf("%06d", rand.Intn(1000000))
+// GenerateOTP returns a 6 digit code from crypto/rand
+func GenerateOTP() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// hashOTP binds the code to its target so a stored hash is useless for any other identifier
+func hashOTP(target, otp string) string {
+	sum := sha256.Sum256([]byte(target + ":" + otp))
+	return hex.EncodeToString(sum[:])
 }
@@ -2,12 +2,14 @@ package otp
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 )
 
-var ctx = context.Background()
+// ErrNotFound is returned when no code is pending for the target
+var ErrNotFound = errors.New("otp not found")
 
 type Store struct {
 	Client *redis.Client
@@ -20,20 +22,91 @@ func NewStore(addr string) *Store {
 	return &Store{Client: rdb}
 }
 
-// Save OTP
-func (s *Store) Save(userID, otpType, otp string) error {
-	key := "otp:" + otpType + ":" + userID
-	return s.Client.Set(ctx, key, otp, 5*time.Minute).Err()
+// NewStoreFromClient reuses an already connected client (databases.Do["redis"])
+func NewStoreFromClient(client *redis.Client) *Store {
+	return &Store{Client: client}
 }
 
-// Get OTP
-func (s *Store) Get(userID, otpType string) (string, error) {
-	key := "otp:" + otpType + ":" + userID
-	return s.Client.Get(ctx, key).Result()
+func otpKey(otpType, target string) string {
+	return "otp:" + otpType + ":" + target
+}
+
+func cooldownKey(otpType, target string) string {
+	return "otp:cooldown:" + otpType + ":" + target
+}
+
+// Save OTP hash; replaces any pending code and resets its attempt counter
+func (s *Store) Save(ctx context.Context, target, otpType, hash string, ttl time.Duration) error {
+	key := otpKey(otpType, target)
+	pipe := s.Client.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.HSet(ctx, key, "hash", hash, "attempts", 0)
+	pipe.Expire(ctx, key, ttl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// checkScript compares and consumes in one step, so two requests with the
+// right code cannot both pass, and a miss is only counted on a key that still
+// exists (HINCRBY on an expired key would recreate it without a TTL).
+// Returns 1 on a match, 0 on a miss, -1 when no code is pending and -2 once
+// attempts run out. The compared values are SHA-256 digests, so Lua's
+// non-constant-time comparison leaks nothing about the code.
+var checkScript = redis.NewScript(`
+local stored = redis.call('HGET', KEYS[1], 'hash')
+if not stored then
+	return -1
+end
+local max = tonumber(ARGV[2])
+if tonumber(redis.call('HGET', KEYS[1], 'attempts') or '0') >= max then
+	redis.call('DEL', KEYS[1])
+	return -2
+end
+if stored == ARGV[1] then
+	redis.call('DEL', KEYS[1])
+	return 1
+end
+if redis.call('HINCRBY', KEYS[1], 'attempts', 1) >= max then
+	redis.call('DEL', KEYS[1])
+	return -2
+end
+return 0
+`)
+
+// Check verifies hash against the pending code: a match consumes it, a miss
+// counts against maxAttempts and the last allowed miss deletes it.
+// Returns nil, ErrInvalidOTP, ErrTooManyAttempts or ErrNotFound.
+func (s *Store) Check(ctx context.Context, target, otpType, hash string, maxAttempts int) error {
+	res, err := checkScript.Run(ctx, s.Client, []string{otpKey(otpType, target)}, hash, maxAttempts).Int()
+	if err != nil {
+		return err
+	}
+	switch res {
+	case 1:
+		return nil
+	case -1:
+		return ErrNotFound
+	case -2:
+		return ErrTooManyAttempts
+	}
+	return ErrInvalidOTP
 }
 
 // Delete OTP
-func (s *Store) Delete(userID, otpType string) {
-	key := "otp:" + otpType + ":" + userID
-	s.Client.Del(ctx, key)
+func (s *Store) Delete(ctx context.Context, target, otpType string) {
+	s.Client.Del(ctx, otpKey(otpType, target))
+}
+
+// StartCooldown claims the resend window; false if one is already running
+func (s *Store) StartCooldown(ctx context.Context, target, otpType string, d time.Duration) (bool, error) {
+	return s.Client.SetNX(ctx, cooldownKey(otpType, target), 1, d).Result()
+}
+
+// CooldownRemaining reports how long until another code may be sent
+func (s *Store) CooldownRemaining(ctx context.Context, target, otpType string) time.Duration {
+	ttl, err := s.Client.TTL(ctx, cooldownKey(otpType, target)).Result()
+	if err != nil || ttl < 0 {
+		return 0
+	}
+	return ttl
 }
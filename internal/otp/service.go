@@ -1,11 +1,49 @@
 package otp
 
-import "errors"
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Defaults for a code's lifetime, its guess budget and the resend window
+const (
+	DefaultTTL         = 5 * time.Minute
+	DefaultMaxAttempts = 5
+	DefaultCooldown    = 60 * time.Second
+)
+
+// Purposes keep codes for different flows apart, so a login code can't confirm a contact and vice versa
+const (
+	PurposeLogin  = "login"
+	PurposeVerify = "verify"
+	PurposeChange = "change" // confirms a new phone before it replaces the current one
+)
+
+var (
+	ErrInvalidType     = errors.New("invalid otp type")
+	ErrInvalidOTP      = errors.New("invalid otp")
+	ErrExpired         = errors.New("otp expired or not requested")
+	ErrTooManyAttempts = errors.New("too many attempts, request a new otp")
+	ErrCooldown        = errors.New("please wait before requesting another otp")
+)
+
+// codeStore keeps pending codes and resend windows; Store is the Redis implementation
+type codeStore interface {
+	Save(ctx context.Context, target, otpType, hash string, ttl time.Duration) error
+	Check(ctx context.Context, target, otpType, hash string, maxAttempts int) error
+	StartCooldown(ctx context.Context, target, otpType string, d time.Duration) (bool, error)
+	CooldownRemaining(ctx context.Context, target, otpType string) time.Duration
+}
 
 type Service struct {
-	store       *Store
+	store       codeStore
 	emailSender *EmailSender
 	smsSender   *SMSSender
+
+	TTL         time.Duration
+	MaxAttempts int
+	Cooldown    time.Duration
 }
 
 func NewService(store *Store, email *EmailSender, sms *SMSSender) *Service {
@@ -13,42 +51,75 @@ func NewService(store *Store, email *EmailSender, sms *SMSSender) *Service {
 		store:       store,
 		emailSender: email,
 		smsSender:   sms,
+		TTL:         DefaultTTL,
+		MaxAttempts: DefaultMaxAttempts,
+		Cooldown:    DefaultCooldown,
 	}
 }
 
 // 🔥 Send OTP
-func (s *Service) SendOTP(userID, target, otpType string) error {
+// A new code replaces the pending one; sends to the same target are rate limited by Cooldown.
+func (s *Service) SendOTP(ctx context.Context, target, otpType string) error {
+	return s.SendCode(ctx, target, otpType, PurposeLogin)
+}
 
-	otp := GenerateOTP()
+// SendCode sends a code for the given purpose
+func (s *Service) SendCode(ctx context.Context, target, otpType, purpose string) error {
 
-	err := s.store.Save(userID, otpType, otp)
+	if otpType != "email" && otpType != "phone" {
+		return ErrInvalidType
+	}
+	key := storeType(otpType, purpose)
+
+	ok, err := s.store.StartCooldown(ctx, target, key, s.Cooldown)
 	if err != nil {
 		return err
 	}
+	if !ok {
+		return ErrCooldown
+	}
 
-	if otpType == "email" {
-		return s.emailSender.Send(target, otp)
+	otp, err := GenerateOTP()
+	if err != nil {
+		return err
+	}
+
+	err = s.store.Save(ctx, target, key, hashOTP(target, otp), s.TTL)
+	if err != nil {
+		return err
 	}
 
-	if otpType == "phone" {
-		return s.smsSender.Send(target, otp)
+	if otpType == "email" {
+		return s.emailSender.Send(target, otp)
 	}
+	return s.smsSender.Send(target, otp)
+}
 
-	return errors.New("invalid otp type")
+// CooldownRemaining reports how long the caller must wait before SendOTP succeeds again
+func (s *Service) CooldownRemaining(ctx context.Context, target, otpType string) time.Duration {
+	return s.store.CooldownRemaining(ctx, target, otpType)
 }
 
 // 🔥 Verify OTP
-func (s *Service) VerifyOTP(userID, otpType, inputOTP string) (bool, error) {
+// The code is deleted on success and after MaxAttempts wrong guesses.
+func (s *Service) VerifyOTP(ctx context.Context, target, otpType, inputOTP string) error {
+	return s.VerifyCode(ctx, target, otpType, PurposeLogin, inputOTP)
+}
 
-	storedOTP, err := s.store.Get(userID, otpType)
-	if err != nil {
-		return false, err
+// VerifyCode checks a code sent with SendCode for the same purpose
+func (s *Service) VerifyCode(ctx context.Context, target, otpType, purpose, inputOTP string) error {
+	// compare, consume and count misses atomically in the store
+	err := s.store.Check(ctx, target, storeType(otpType, purpose), hashOTP(target, inputOTP), s.MaxAttempts)
+	if errors.Is(err, ErrNotFound) {
+		return ErrExpired
 	}
+	return err
+}
 
-	if storedOTP == inputOTP {
-		s.store.Delete(userID, otpType)
-		return true, nil
+// storeType namespaces Redis keys by purpose; login keeps the bare type
+func storeType(otpType, purpose string) string {
+	if purpose == "" || purpose == PurposeLogin {
+		return otpType
 	}
-
-	return false, nil
+	return purpose + ":" + otpType
 }
@@ -0,0 +1,386 @@
+package otp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memStore is an in-memory codeStore with a hand-driven clock, following the
+// semantics of Store's Redis script
+type memStore struct {
+	mu        sync.Mutex
+	now       time.Time
+	codes     map[string]*memCode
+	cooldowns map[string]time.Time
+}
+
+type memCode struct {
+	hash     string
+	attempts int
+	expires  time.Time
+}
+
+func newMemStore() *memStore {
+	return &memStore{
+		now:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		codes:     map[string]*memCode{},
+		cooldowns: map[string]time.Time{},
+	}
+}
+
+func (m *memStore) advance(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.now = m.now.Add(d)
+}
+
+func (m *memStore) Save(ctx context.Context, target, otpType, hash string, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.codes[otpKey(otpType, target)] = &memCode{hash: hash, expires: m.now.Add(ttl)}
+	return nil
+}
+
+func (m *memStore) Check(ctx context.Context, target, otpType, hash string, maxAttempts int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := otpKey(otpType, target)
+	c, ok := m.codes[key]
+	if !ok || !m.now.Before(c.expires) {
+		delete(m.codes, key)
+		return ErrNotFound
+	}
+	if c.attempts >= maxAttempts {
+		delete(m.codes, key)
+		return ErrTooManyAttempts
+	}
+	if c.hash == hash {
+		delete(m.codes, key)
+		return nil
+	}
+	c.attempts++
+	if c.attempts >= maxAttempts {
+		delete(m.codes, key)
+		return ErrTooManyAttempts
+	}
+	return ErrInvalidOTP
+}
+
+func (m *memStore) StartCooldown(ctx context.Context, target, otpType string, d time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := cooldownKey(otpType, target)
+	if until, ok := m.cooldowns[key]; ok && m.now.Before(until) {
+		return false, nil
+	}
+	m.cooldowns[key] = m.now.Add(d)
+	return true, nil
+}
+
+func (m *memStore) CooldownRemaining(ctx context.Context, target, otpType string) time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if until, ok := m.cooldowns[cooldownKey(otpType, target)]; ok && m.now.Before(until) {
+		return until.Sub(m.now)
+	}
+	return 0
+}
+
+// outbox is an SMSProvider that keeps what it is given, so the service is
+// tested apart from any gateway adapter
+type outbox struct {
+	mu   sync.Mutex
+	sent []SMSMessage
+}
+
+func (o *outbox) Name() string { return "outbox" }
+
+func (o *outbox) Send(ctx context.Context, msg SMSMessage) (string, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.sent = append(o.sent, msg)
+	return fmt.Sprintf("outbox-%d", len(o.sent)), nil
+}
+
+func (o *outbox) ParseDeliveryStatus(r *http.Request) ([]DeliveryStatus, error) {
+	return nil, errors.New("outbox: no delivery reports")
+}
+
+func (o *outbox) count() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.sent)
+}
+
+// last returns the most recent message to phone
+func (o *outbox) last(phone string) (SMSMessage, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for i := len(o.sent) - 1; i >= 0; i-- {
+		if o.sent[i].To == phone {
+			return o.sent[i], true
+		}
+	}
+	return SMSMessage{}, false
+}
+
+const testPhone = "+919876543210"
+
+func newTestService() (*Service, *memStore, *outbox) {
+	store := newMemStore()
+	sms := &outbox{}
+	svc := &Service{
+		store:       store,
+		smsSender:   NewSMSSender(sms, "ADORNM", "tmpl-1"),
+		TTL:         DefaultTTL,
+		MaxAttempts: DefaultMaxAttempts,
+		Cooldown:    DefaultCooldown,
+	}
+	return svc, store, sms
+}
+
+// lastCode reads the code from the most recent text to testPhone
+func lastCode(t *testing.T, sms *outbox) string {
+	t.Helper()
+	msg, ok := sms.last(testPhone)
+	if !ok {
+		t.Fatal("no sms sent")
+	}
+	return msg.Vars["otp"]
+}
+
+func TestGenerateOTP(t *testing.T) {
+	six := regexp.MustCompile(`^[0-9]{6}$`)
+	for i := 0; i < 100; i++ {
+		code, err := GenerateOTP()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !six.MatchString(code) {
+			t.Fatalf("GenerateOTP() = %q, want 6 digits", code)
+		}
+	}
+}
+
+func TestHashOTPBindsTarget(t *testing.T) {
+	if hashOTP("+911111111111", "123456") == hashOTP("+912222222222", "123456") {
+		t.Fatal("same code for different targets hashed alike")
+	}
+	if hashOTP(testPhone, "123456") != hashOTP(testPhone, "123456") {
+		t.Fatal("hashOTP is not deterministic")
+	}
+}
+
+func TestSendCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		otpType string
+		wantErr error
+	}{
+		{"phone", "phone", nil},
+		{"unknown type", "pigeon", ErrInvalidType},
+		{"empty type", "", ErrInvalidType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, sms := newTestService()
+			err := svc.SendCode(context.Background(), testPhone, tt.otpType, PurposeLogin)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SendCode() error = %v, want %v", err, tt.wantErr)
+			}
+			if sent := sms.count(); (tt.wantErr == nil) != (sent == 1) {
+				t.Fatalf("sent %d messages", sent)
+			}
+			if tt.wantErr == nil {
+				msg, _ := sms.last(testPhone)
+				if msg.TemplateID != "tmpl-1" || msg.SenderID != "ADORNM" {
+					t.Fatalf("message not rendered with sender and template: %+v", msg)
+				}
+			}
+		})
+	}
+}
+
+func TestSendCodeCooldown(t *testing.T) {
+	ctx := context.Background()
+	svc, store, sms := newTestService()
+
+	if err := svc.SendOTP(ctx, testPhone, "phone"); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.SendOTP(ctx, testPhone, "phone"); !errors.Is(err, ErrCooldown) {
+		t.Fatalf("resend inside the window: error = %v, want %v", err, ErrCooldown)
+	}
+	if left := svc.CooldownRemaining(ctx, testPhone, "phone"); left <= 0 || left > DefaultCooldown {
+		t.Fatalf("CooldownRemaining() = %v", left)
+	}
+
+	// other purposes have their own window
+	if err := svc.SendCode(ctx, testPhone, "phone", PurposeVerify); err != nil {
+		t.Fatalf("verify code during login cooldown: %v", err)
+	}
+
+	store.advance(DefaultCooldown)
+	if err := svc.SendOTP(ctx, testPhone, "phone"); err != nil {
+		t.Fatalf("resend after the window: %v", err)
+	}
+	if got := sms.count(); got != 3 {
+		t.Fatalf("sent %d messages, want 3", got)
+	}
+}
+
+func TestVerifyCode(t *testing.T) {
+	// wrong is a code that differs from the one sent
+	wrong := func(code string) string {
+		if code == "000000" {
+			return "111111"
+		}
+		return "000000"
+	}
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T, svc *Service, store *memStore, code string) error
+		want error
+	}{
+		{
+			name: "right code",
+			run: func(t *testing.T, svc *Service, _ *memStore, code string) error {
+				return svc.VerifyOTP(context.Background(), testPhone, "phone", code)
+			},
+		},
+		{
+			name: "wrong code",
+			run: func(t *testing.T, svc *Service, _ *memStore, code string) error {
+				return svc.VerifyOTP(context.Background(), testPhone, "phone", wrong(code))
+			},
+			want: ErrInvalidOTP,
+		},
+		{
+			name: "code is single use",
+			run: func(t *testing.T, svc *Service, _ *memStore, code string) error {
+				if err := svc.VerifyOTP(context.Background(), testPhone, "phone", code); err != nil {
+					t.Fatalf("first use: %v", err)
+				}
+				return svc.VerifyOTP(context.Background(), testPhone, "phone", code)
+			},
+			want: ErrExpired,
+		},
+		{
+			name: "expired",
+			run: func(t *testing.T, svc *Service, store *memStore, code string) error {
+				store.advance(DefaultTTL)
+				return svc.VerifyOTP(context.Background(), testPhone, "phone", code)
+			},
+			want: ErrExpired,
+		},
+		{
+			name: "last attempt burns the code",
+			run: func(t *testing.T, svc *Service, _ *memStore, code string) error {
+				for i := 1; i < DefaultMaxAttempts; i++ {
+					if err := svc.VerifyOTP(context.Background(), testPhone, "phone", wrong(code)); !errors.Is(err, ErrInvalidOTP) {
+						t.Fatalf("miss %d: error = %v", i, err)
+					}
+				}
+				if err := svc.VerifyOTP(context.Background(), testPhone, "phone", wrong(code)); !errors.Is(err, ErrTooManyAttempts) {
+					t.Fatalf("last miss: error = %v, want %v", err, ErrTooManyAttempts)
+				}
+				return svc.VerifyOTP(context.Background(), testPhone, "phone", code)
+			},
+			want: ErrExpired,
+		},
+		{
+			name: "other target",
+			run: func(t *testing.T, svc *Service, _ *memStore, code string) error {
+				return svc.VerifyOTP(context.Background(), "+919999999999", "phone", code)
+			},
+			want: ErrExpired,
+		},
+		{
+			name: "other purpose",
+			run: func(t *testing.T, svc *Service, _ *memStore, code string) error {
+				return svc.VerifyCode(context.Background(), testPhone, "phone", PurposeChange, code)
+			},
+			want: ErrExpired,
+		},
+		{
+			name: "resend replaces the pending code",
+			run: func(t *testing.T, svc *Service, store *memStore, code string) error {
+				store.advance(DefaultCooldown)
+				if err := svc.SendOTP(context.Background(), testPhone, "phone"); err != nil {
+					t.Fatal(err)
+				}
+				newCode := lastCode(t, svc.smsSender.Provider.(*outbox))
+				if newCode == code {
+					return nil // same six digits drawn twice; nothing to tell apart
+				}
+				if err := svc.VerifyOTP(context.Background(), testPhone, "phone", code); !errors.Is(err, ErrInvalidOTP) {
+					t.Fatalf("old code: error = %v, want %v", err, ErrInvalidOTP)
+				}
+				return svc.VerifyOTP(context.Background(), testPhone, "phone", newCode)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, store, sms := newTestService()
+			if err := svc.SendOTP(context.Background(), testPhone, "phone"); err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.run(t, svc, store, lastCode(t, sms)); !errors.Is(err, tt.want) {
+				t.Fatalf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyCodePurposes(t *testing.T) {
+	ctx := context.Background()
+	svc, _, sms := newTestService()
+
+	purposes := []string{PurposeLogin, PurposeVerify, PurposeChange}
+	codes := map[string]string{}
+	for _, purpose := range purposes {
+		if err := svc.SendCode(ctx, testPhone, "phone", purpose); err != nil {
+			t.Fatalf("send %s: %v", purpose, err)
+		}
+		codes[purpose] = lastCode(t, sms)
+	}
+
+	// each purpose only accepts its own code, and keeps it pending after a miss elsewhere
+	for _, purpose := range purposes {
+		for _, other := range purposes {
+			if other == purpose || codes[other] == codes[purpose] {
+				continue
+			}
+			if err := svc.VerifyCode(ctx, testPhone, "phone", purpose, codes[other]); !errors.Is(err, ErrInvalidOTP) {
+				t.Fatalf("%s with the %s code: error = %v, want %v", purpose, other, err, ErrInvalidOTP)
+			}
+		}
+		if err := svc.VerifyCode(ctx, testPhone, "phone", purpose, codes[purpose]); err != nil {
+			t.Fatalf("%s with its own code: %v", purpose, err)
+		}
+	}
+}
+
+func TestStoreType(t *testing.T) {
+	tests := []struct {
+		otpType, purpose, want string
+	}{
+		{"phone", PurposeLogin, "phone"},
+		{"email", "", "email"},
+		{"phone", PurposeVerify, "verify:phone"},
+		{"email", PurposeChange, "change:email"},
+	}
+	for _, tt := range tests {
+		if got := storeType(tt.otpType, tt.purpose); got != tt.want {
+			t.Errorf("storeType(%q, %q) = %q, want %q", tt.otpType, tt.purpose, got, tt.want)
+		}
+	}
+}
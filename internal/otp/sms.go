@@ -1,11 +1,116 @@
 package otp
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
-type SMSSender struct{}
+	"Adornme/config"
+)
 
+// Delivery states reported by providers, normalized
+const (
+	SMSStatusQueued      = "queued"
+	SMSStatusSent        = "sent"
+	SMSStatusDelivered   = "delivered"
+	SMSStatusFailed      = "failed"
+	SMSStatusUndelivered = "undelivered"
+)
+
+// SMS provider setup errors
+var (
+	ErrUnknownSMSProvider = errors.New("unknown sms provider")
+	ErrSMSMisconfigured   = errors.New("sms provider misconfigured")
+)
+
+// SMSMessage is one outbound text.
+// TemplateID is the DLT content template the body was registered under (mandatory for Indian routes).
+type SMSMessage struct {
+	To         string            `json:"to"`
+	Body       string            `json:"body"`
+	SenderID   string            `json:"senderId,omitempty"`
+	TemplateID string            `json:"templateId,omitempty"`
+	Vars       map[string]string `json:"vars,omitempty"`
+}
+
+// DeliveryStatus is one delivery report received on the provider callback
+type DeliveryStatus struct {
+	Provider  string    `json:"provider"`
+	MessageID string    `json:"messageId"`
+	To        string    `json:"to"`
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	At        time.Time `json:"at"`
+}
+
+// SMSProvider is a gateway adapter
+type SMSProvider interface {
+	// Name is the provider key used in config and in the callback path
+	Name() string
+	// Send submits the message and returns the provider's message ID
+	Send(ctx context.Context, msg SMSMessage) (string, error)
+	// ParseDeliveryStatus authenticates and decodes a delivery-status callback
+	ParseDeliveryStatus(r *http.Request) ([]DeliveryStatus, error)
+}
+
+// SMSSender renders OTP texts and hands them to the configured provider
+type SMSSender struct {
+	Provider   SMSProvider
+	SenderID   string
+	TemplateID string
+}
+
+func NewSMSSender(provider SMSProvider, senderID, templateID string) *SMSSender {
+	return &SMSSender{Provider: provider, SenderID: senderID, TemplateID: templateID}
+}
+
+// Send texts otp to phone, which must be in E.164
 func (s *SMSSender) Send(phone, otp string) error {
-	// TODO: integrate Twilio later
-	fmt.Printf("Sending OTP %s to phone %s\n", otp, phone)
-	return nil
+	if s.Provider == nil {
+		return errors.New("sms provider not configured")
+	}
+
+	// Body must match the DLT template word for word; only the variable changes
+	msg := SMSMessage{
+		To:         phone,
+		Body:       fmt.Sprintf("%s is your Adornme verification code. It is valid for 5 minutes. Do not share it with anyone.", otp),
+		SenderID:   s.SenderID,
+		TemplateID: s.TemplateID,
+		Vars:       map[string]string{"otp": otp},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := s.Provider.Send(ctx, msg)
+	return err
+}
+
+// NewSMSProvider builds the adapter selected by SMS_PROVIDER. A real provider
+// must have its credentials; the fake has to be asked for and only runs in dev mode.
+func NewSMSProvider(cfg *config.Config) (SMSProvider, error) {
+	switch strings.ToLower(cfg.SMSProvider) {
+	case "msg91":
+		if cfg.SMSAuthKey == "" || cfg.SMSFlowID == "" {
+			return nil, fmt.Errorf("%w: msg91 needs SMS_AUTH_KEY and SMS_FLOW_ID", ErrSMSMisconfigured)
+		}
+		return NewMSG91Provider(cfg.SMSBaseURL, cfg.SMSAuthKey, cfg.SMSFlowID, cfg.SMSWebhookToken), nil
+	case "twilio":
+		if cfg.SMSAccountSID == "" || cfg.SMSAuthKey == "" || cfg.SMSSenderID == "" {
+			return nil, fmt.Errorf("%w: twilio needs SMS_ACCOUNT_SID, SMS_AUTH_KEY and SMS_SENDER_ID", ErrSMSMisconfigured)
+		}
+		return NewTwilioProvider(cfg.SMSBaseURL, cfg.SMSAccountSID, cfg.SMSAuthKey, cfg.SMSStatusCallbackURL), nil
+	case "fake":
+		if !cfg.DevMode {
+			return nil, fmt.Errorf("%w: the fake provider only runs with APP_ENV=development", ErrSMSMisconfigured)
+		}
+		return NewFakeSMSProvider(), nil
+	case "":
+		return nil, fmt.Errorf("%w: SMS_PROVIDER is not set", ErrSMSMisconfigured)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSMSProvider, cfg.SMSProvider)
+	}
 }
@@ -0,0 +1,168 @@
+package otp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxFakeRecords caps the outbox and the delivery reports; the oldest go first
+const maxFakeRecords = 500
+
+// FakeSMSProvider records messages instead of sending them, so OTP flows work offline.
+// It also serves as a local HTTP stand-in for the MSG91 Flow API (see cmd/sms-standin):
+// point SMS_BASE_URL at it and read the outbox from GET /messages.
+// Bodies carry codes, so they are only readable from the outbox, never logged.
+type FakeSMSProvider struct {
+	mu       sync.Mutex
+	seq      int
+	messages []FakeSMS
+	statuses []DeliveryStatus
+}
+
+// FakeSMS is a recorded message
+type FakeSMS struct {
+	ID     string    `json:"id"`
+	SentAt time.Time `json:"sentAt"`
+	SMSMessage
+}
+
+func NewFakeSMSProvider() *FakeSMSProvider {
+	return &FakeSMSProvider{}
+}
+
+func (p *FakeSMSProvider) Name() string { return "fake" }
+
+func (p *FakeSMSProvider) Send(ctx context.Context, msg SMSMessage) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.seq++
+	id := fmt.Sprintf("fake-%d", p.seq)
+	now := time.Now().UTC()
+	p.messages = capped(append(p.messages, FakeSMS{ID: id, SentAt: now, SMSMessage: msg}))
+	p.statuses = capped(append(p.statuses, DeliveryStatus{Provider: p.Name(), MessageID: id, To: msg.To, Status: SMSStatusDelivered, At: now}))
+
+	log.Printf("[fake-sms] %s → %s (template %s)", id, msg.To, msg.TemplateID)
+	return id, nil
+}
+
+// capped drops the oldest records beyond maxFakeRecords
+func capped[T any](records []T) []T {
+	if over := len(records) - maxFakeRecords; over > 0 {
+		return append(records[:0:0], records[over:]...)
+	}
+	return records
+}
+
+// ParseDeliveryStatus accepts {"messageId": "...", "status": "..."} so callbacks can be simulated
+func (p *FakeSMSProvider) ParseDeliveryStatus(r *http.Request) ([]DeliveryStatus, error) {
+	var st DeliveryStatus
+	if err := json.NewDecoder(r.Body).Decode(&st); err != nil {
+		return nil, fmt.Errorf("fake: decode callback: %w", err)
+	}
+	st.Provider = p.Name()
+	st.At = time.Now().UTC()
+
+	p.mu.Lock()
+	p.statuses = capped(append(p.statuses, st))
+	p.mu.Unlock()
+
+	return []DeliveryStatus{st}, nil
+}
+
+// Messages returns a copy of everything sent so far
+func (p *FakeSMSProvider) Messages() []FakeSMS {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]FakeSMS(nil), p.messages...)
+}
+
+// Last returns the most recent message sent to a number
+func (p *FakeSMSProvider) Last(to string) (FakeSMS, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i := len(p.messages) - 1; i >= 0; i-- {
+		if p.messages[i].To == to {
+			return p.messages[i], true
+		}
+	}
+	return FakeSMS{}, false
+}
+
+// Statuses returns the recorded delivery reports
+func (p *FakeSMSProvider) Statuses() []DeliveryStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]DeliveryStatus(nil), p.statuses...)
+}
+
+// Reset clears the outbox
+func (p *FakeSMSProvider) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = nil
+	p.statuses = nil
+}
+
+// ServeHTTP implements the stand-in gateway:
+//
+//	POST /api/v5/flow/   MSG91 Flow API, records the message
+//	GET  /messages       recorded messages (?to= filters by number)
+//	DELETE /messages     clears the outbox
+func (p *FakeSMSProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case r.Method == http.MethodPost && strings.TrimRight(r.URL.Path, "/") == "/api/v5/flow":
+		var req msg91FlowRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(msg91Response{Type: "error", Message: err.Error()})
+			return
+		}
+
+		var id string
+		for _, rcpt := range req.Recipients {
+			vars := map[string]string{}
+			for k, v := range rcpt {
+				if k != "mobiles" {
+					vars[k] = v
+				}
+			}
+			id, _ = p.Send(r.Context(), SMSMessage{
+				To:         "+" + strings.TrimPrefix(rcpt["mobiles"], "+"),
+				Body:       fmt.Sprintf("flow %s %v", req.TemplateID, vars),
+				SenderID:   req.Sender,
+				TemplateID: req.DLTTEID,
+				Vars:       vars,
+			})
+		}
+		_ = json.NewEncoder(w).Encode(msg91Response{Type: "success", Message: id})
+
+	case r.Method == http.MethodGet && r.URL.Path == "/messages":
+		out := p.Messages()
+		if to := r.URL.Query().Get("to"); to != "" {
+			filtered := out[:0]
+			for _, m := range out {
+				if m.To == to {
+					filtered = append(filtered, m)
+				}
+			}
+			out = filtered
+		}
+		_ = json.NewEncoder(w).Encode(out)
+
+	case r.Method == http.MethodDelete && r.URL.Path == "/messages":
+		p.Reset()
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
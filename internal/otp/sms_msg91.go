@@ -0,0 +1,148 @@
+package otp
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const msg91DefaultBaseURL = "https://control.msg91.com"
+
+// MSG91Provider sends through the MSG91 Flow API.
+// The flow template carries the DLT template ID and sender header registered with MSG91.
+type MSG91Provider struct {
+	BaseURL      string
+	AuthKey      string
+	FlowID       string
+	WebhookToken string
+	Client       *http.Client
+}
+
+func NewMSG91Provider(baseURL, authKey, flowID, webhookToken string) *MSG91Provider {
+	if baseURL == "" {
+		baseURL = msg91DefaultBaseURL
+	}
+	return &MSG91Provider{
+		BaseURL:      strings.TrimRight(baseURL, "/"),
+		AuthKey:      authKey,
+		FlowID:       flowID,
+		WebhookToken: webhookToken,
+		Client:       &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *MSG91Provider) Name() string { return "msg91" }
+
+type msg91FlowRequest struct {
+	TemplateID string              `json:"template_id"`
+	Sender     string              `json:"sender,omitempty"`
+	DLTTEID    string              `json:"DLT_TE_ID,omitempty"`
+	ShortURL   string              `json:"short_url"`
+	Recipients []map[string]string `json:"recipients"`
+}
+
+type msg91Response struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+func (p *MSG91Provider) Send(ctx context.Context, msg SMSMessage) (string, error) {
+	recipient := map[string]string{"mobiles": strings.TrimPrefix(msg.To, "+")}
+	for k, v := range msg.Vars {
+		recipient[k] = v
+	}
+
+	payload, err := json.Marshal(msg91FlowRequest{
+		TemplateID: p.FlowID,
+		Sender:     msg.SenderID,
+		DLTTEID:    msg.TemplateID,
+		ShortURL:   "0",
+		Recipients: []map[string]string{recipient},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.BaseURL+"/api/v5/flow/", bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("authkey", p.AuthKey)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("msg91: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var out msg91Response
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", fmt.Errorf("msg91: decode response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || out.Type != "success" {
+		return "", fmt.Errorf("msg91: send failed (%d): %s", resp.StatusCode, out.Message)
+	}
+
+	// On success "message" holds the request ID that delivery reports refer to
+	return out.Message, nil
+}
+
+type msg91Report struct {
+	RequestID string `json:"requestId"`
+	Number    string `json:"number"`
+	Status    string `json:"status"`
+	Desc      string `json:"desc"`
+}
+
+// ParseDeliveryStatus decodes the MSG91 webhook; MSG91 does not sign callbacks,
+// so the configured token must be present in the callback URL (?token=...).
+func (p *MSG91Provider) ParseDeliveryStatus(r *http.Request) ([]DeliveryStatus, error) {
+	if p.WebhookToken == "" ||
+		subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("token")), []byte(p.WebhookToken)) != 1 {
+		return nil, errors.New("msg91: invalid webhook token")
+	}
+
+	var body struct {
+		Data []msg91Report `json:"data"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("msg91: decode callback: %w", err)
+	}
+
+	out := make([]DeliveryStatus, 0, len(body.Data))
+	for _, d := range body.Data {
+		out = append(out, DeliveryStatus{
+			Provider:  p.Name(),
+			MessageID: d.RequestID,
+			To:        d.Number,
+			Status:    msg91Status(d.Status),
+			Error:     d.Desc,
+			At:        time.Now().UTC(),
+		})
+	}
+	return out, nil
+}
+
+// msg91Status maps MSG91 report codes (1 delivered, 2 failed, 8 submitted, 16 rejected ...) to ours
+func msg91Status(code string) string {
+	switch code {
+	case "1":
+		return SMSStatusDelivered
+	case "2", "16", "17":
+		return SMSStatusFailed
+	case "8", "25", "26":
+		return SMSStatusSent
+	case "9":
+		return SMSStatusUndelivered
+	default:
+		return SMSStatusQueued
+	}
+}
@@ -0,0 +1,49 @@
+package otp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMSG91ParseDeliveryStatus(t *testing.T) {
+	const body = `{"data":[{"requestId":"r-1","number":"919876543210","status":"1"},{"requestId":"r-2","number":"919876543211","status":"2","desc":"DND"}]}`
+
+	tests := []struct {
+		name         string
+		webhookToken string
+		target       string
+		body         string
+		wantErr      bool
+	}{
+		{name: "valid token", webhookToken: "hook-secret", target: "/webhooks/sms/msg91?token=hook-secret", body: body},
+		{name: "missing token", webhookToken: "hook-secret", target: "/webhooks/sms/msg91", body: body, wantErr: true},
+		{name: "wrong token", webhookToken: "hook-secret", target: "/webhooks/sms/msg91?token=hook-secreT", body: body, wantErr: true},
+		{name: "token prefix", webhookToken: "hook-secret", target: "/webhooks/sms/msg91?token=hook", body: body, wantErr: true},
+		{name: "no token configured", target: "/webhooks/sms/msg91?token=", body: body, wantErr: true},
+		{name: "bad body", webhookToken: "hook-secret", target: "/webhooks/sms/msg91?token=hook-secret", body: "{", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewMSG91Provider("", "auth", "flow", tt.webhookToken)
+			r := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(tt.body))
+			got, err := p.ParseDeliveryStatus(r)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDeliveryStatus() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != 2 {
+				t.Fatalf("got %d reports, want 2", len(got))
+			}
+			if got[0].MessageID != "r-1" || got[0].Status != SMSStatusDelivered {
+				t.Errorf("report 0 = %+v", got[0])
+			}
+			if got[1].Status != SMSStatusFailed || got[1].Error != "DND" {
+				t.Errorf("report 1 = %+v", got[1])
+			}
+		})
+	}
+}
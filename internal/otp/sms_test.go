@@ -0,0 +1,62 @@
+package otp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"Adornme/config"
+)
+
+func TestNewSMSProvider(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      config.Config
+		wantName string
+		wantErr  error
+	}{
+		{
+			name:     "msg91",
+			cfg:      config.Config{SMSProvider: "MSG91", SMSAuthKey: "k", SMSFlowID: "f"},
+			wantName: "msg91",
+		},
+		{name: "msg91 without flow", cfg: config.Config{SMSProvider: "msg91", SMSAuthKey: "k"}, wantErr: ErrSMSMisconfigured},
+		{
+			name:     "twilio",
+			cfg:      config.Config{SMSProvider: "twilio", SMSAccountSID: "AC1", SMSAuthKey: "t", SMSSenderID: "+15550100"},
+			wantName: "twilio",
+		},
+		{name: "twilio without sender", cfg: config.Config{SMSProvider: "twilio", SMSAccountSID: "AC1", SMSAuthKey: "t"}, wantErr: ErrSMSMisconfigured},
+		{name: "fake in dev mode", cfg: config.Config{SMSProvider: "fake", DevMode: true}, wantName: "fake"},
+		{name: "fake outside dev mode", cfg: config.Config{SMSProvider: "fake"}, wantErr: ErrSMSMisconfigured},
+		{name: "not set", cfg: config.Config{DevMode: true}, wantErr: ErrSMSMisconfigured},
+		{name: "unknown", cfg: config.Config{SMSProvider: "pigeon"}, wantErr: ErrUnknownSMSProvider},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewSMSProvider(&tt.cfg)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewSMSProvider() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && p.Name() != tt.wantName {
+				t.Fatalf("NewSMSProvider() = %s, want %s", p.Name(), tt.wantName)
+			}
+		})
+	}
+}
+
+func TestFakeSMSProviderCapsRecords(t *testing.T) {
+	p := NewFakeSMSProvider()
+	for i := 0; i < maxFakeRecords+10; i++ {
+		if _, err := p.Send(context.Background(), SMSMessage{To: testPhone, Body: "x"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	msgs := p.Messages()
+	if len(msgs) != maxFakeRecords || len(p.Statuses()) != maxFakeRecords {
+		t.Fatalf("kept %d messages and %d statuses, want %d", len(msgs), len(p.Statuses()), maxFakeRecords)
+	}
+	if msgs[0].ID != "fake-11" {
+		t.Fatalf("oldest kept message is %s, want fake-11", msgs[0].ID)
+	}
+}
@@ -0,0 +1,147 @@
+package otp
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const twilioDefaultBaseURL = "https://api.twilio.com"
+
+// TwilioProvider sends through the Twilio Messages API.
+// For Indian numbers the body must match a DLT template registered against the sender.
+type TwilioProvider struct {
+	BaseURL           string
+	AccountSID        string
+	AuthToken         string
+	StatusCallbackURL string
+	Client            *http.Client
+}
+
+func NewTwilioProvider(baseURL, accountSID, authToken, statusCallbackURL string) *TwilioProvider {
+	if baseURL == "" {
+		baseURL = twilioDefaultBaseURL
+	}
+	return &TwilioProvider{
+		BaseURL:           strings.TrimRight(baseURL, "/"),
+		AccountSID:        accountSID,
+		AuthToken:         authToken,
+		StatusCallbackURL: statusCallbackURL,
+		Client:            &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *TwilioProvider) Name() string { return "twilio" }
+
+type twilioResponse struct {
+	SID     string `json:"sid"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+func (p *TwilioProvider) Send(ctx context.Context, msg SMSMessage) (string, error) {
+	form := url.Values{}
+	form.Set("To", msg.To)
+	form.Set("Body", msg.Body)
+	if strings.HasPrefix(msg.SenderID, "MG") {
+		form.Set("MessagingServiceSid", msg.SenderID)
+	} else {
+		form.Set("From", msg.SenderID)
+	}
+	if p.StatusCallbackURL != "" {
+		form.Set("StatusCallback", p.StatusCallbackURL)
+	}
+
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", p.BaseURL, p.AccountSID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(p.AccountSID, p.AuthToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("twilio: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var out twilioResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", fmt.Errorf("twilio: decode response: %w", err)
+	}
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("twilio: send failed (%d): %s", resp.StatusCode, out.Message)
+	}
+	return out.SID, nil
+}
+
+// ParseDeliveryStatus checks X-Twilio-Signature against StatusCallbackURL and decodes the form callback
+func (p *TwilioProvider) ParseDeliveryStatus(r *http.Request) ([]DeliveryStatus, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, fmt.Errorf("twilio: parse callback: %w", err)
+	}
+	if !p.validSignature(r.Header.Get("X-Twilio-Signature"), r.PostForm) {
+		return nil, errors.New("twilio: invalid signature")
+	}
+
+	return []DeliveryStatus{{
+		Provider:  p.Name(),
+		MessageID: r.PostForm.Get("MessageSid"),
+		To:        r.PostForm.Get("To"),
+		Status:    twilioStatus(r.PostForm.Get("MessageStatus")),
+		Error:     r.PostForm.Get("ErrorCode"),
+		At:        time.Now().UTC(),
+	}}, nil
+}
+
+// validSignature implements Twilio's scheme: base64(HMAC-SHA1(token, url + sorted key/value pairs))
+func (p *TwilioProvider) validSignature(signature string, form url.Values) bool {
+	if signature == "" || p.StatusCallbackURL == "" {
+		return false
+	}
+
+	keys := make([]string, 0, len(form))
+	for k := range form {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(p.StatusCallbackURL)
+	for _, k := range keys {
+		for _, v := range form[k] {
+			b.WriteString(k)
+			b.WriteString(v)
+		}
+	}
+
+	mac := hmac.New(sha1.New, []byte(p.AuthToken))
+	mac.Write([]byte(b.String()))
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+func twilioStatus(s string) string {
+	switch s {
+	case "delivered":
+		return SMSStatusDelivered
+	case "sent":
+		return SMSStatusSent
+	case "failed":
+		return SMSStatusFailed
+	case "undelivered":
+		return SMSStatusUndelivered
+	default:
+		return SMSStatusQueued
+	}
+}
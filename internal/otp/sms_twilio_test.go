@@ -0,0 +1,93 @@
+package otp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const testCallbackURL = "https://api.adornme.test/webhooks/sms/twilio"
+
+// twilioSign signs form as Twilio does: HMAC-SHA1 over the URL and the sorted key/value pairs
+func twilioSign(token, callbackURL, payload string) string {
+	mac := hmac.New(sha1.New, []byte(token))
+	mac.Write([]byte(callbackURL + payload))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func twilioCallback(form url.Values, signature string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/webhooks/sms/twilio", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if signature != "" {
+		r.Header.Set("X-Twilio-Signature", signature)
+	}
+	return r
+}
+
+func TestTwilioValidSignatureVector(t *testing.T) {
+	// Example from Twilio's webhook security documentation
+	p := NewTwilioProvider("", "AC123", "12345", "https://mycompany.com/myapp.php?foo=1&bar=2")
+	form := url.Values{
+		"CallSid": {"CA1234567890ABCDE"},
+		"Caller":  {"+12349013030"},
+		"Digits":  {"1234"},
+		"From":    {"+12349013030"},
+		"To":      {"+18005551212"},
+	}
+	if !p.validSignature("0/KCTR6DLpKmkAf8muzZqo1nDgQ=", form) {
+		t.Fatal("documented signature rejected")
+	}
+}
+
+func TestTwilioParseDeliveryStatus(t *testing.T) {
+	form := url.Values{
+		"MessageSid":    {"SM123"},
+		"MessageStatus": {"delivered"},
+		"To":            {testPhone},
+	}
+	// sorted keys: MessageSid, MessageStatus, To
+	signed := twilioSign("secret", testCallbackURL, "MessageSidSM123MessageStatusdeliveredTo"+testPhone)
+
+	tests := []struct {
+		name        string
+		token       string
+		callbackURL string
+		form        url.Values
+		signature   string
+		wantErr     bool
+	}{
+		{name: "valid", token: "secret", callbackURL: testCallbackURL, form: form, signature: signed},
+		{name: "missing signature", token: "secret", callbackURL: testCallbackURL, form: form, wantErr: true},
+		{name: "wrong token", token: "other", callbackURL: testCallbackURL, form: form, signature: signed, wantErr: true},
+		{name: "other callback url", token: "secret", callbackURL: testCallbackURL + "/x", form: form, signature: signed, wantErr: true},
+		{name: "no callback url configured", token: "secret", form: form, signature: signed, wantErr: true},
+		{
+			name: "tampered status", token: "secret", callbackURL: testCallbackURL, signature: signed, wantErr: true,
+			form: url.Values{"MessageSid": {"SM123"}, "MessageStatus": {"failed"}, "To": {testPhone}},
+		},
+		{
+			name: "extra field", token: "secret", callbackURL: testCallbackURL, signature: signed, wantErr: true,
+			form: url.Values{"MessageSid": {"SM123"}, "MessageStatus": {"delivered"}, "To": {testPhone}, "ErrorCode": {"30003"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewTwilioProvider("", "AC123", tt.token, tt.callbackURL)
+			got, err := p.ParseDeliveryStatus(twilioCallback(tt.form, tt.signature))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDeliveryStatus() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != 1 || got[0].MessageID != "SM123" || got[0].Status != SMSStatusDelivered || got[0].To != testPhone {
+				t.Fatalf("ParseDeliveryStatus() = %+v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package otp
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the RFC 6238 SHA-1 test key "12345678901234567890", base32 encoded
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCodeRFC6238(t *testing.T) {
+	// RFC 6238 appendix B, truncated from 8 to 6 digits
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		got, err := TOTPCode(rfcSecret, TOTPStep(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatalf("TOTPCode at %d: %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Errorf("TOTPCode at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	step := TOTPStep(now)
+	codeAt := func(s int64) string {
+		code, err := TOTPCode(rfcSecret, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return code
+	}
+
+	tests := []struct {
+		name     string
+		secret   string
+		code     string
+		wantStep int64
+		wantOK   bool
+	}{
+		{"current step", rfcSecret, codeAt(step), step, true},
+		{"previous step (clock drift)", rfcSecret, codeAt(step - 1), step - 1, true},
+		{"next step (clock drift)", rfcSecret, codeAt(step + 1), step + 1, true},
+		{"two steps old", rfcSecret, codeAt(step - 2), 0, false},
+		{"two steps ahead", rfcSecret, codeAt(step + 2), 0, false},
+		{"surrounding spaces", rfcSecret, " " + codeAt(step) + " ", step, true},
+		{"lowercase padded secret", strings.ToLower(rfcSecret) + "====", codeAt(step), step, true},
+		{"wrong code", rfcSecret, "000000", 0, false},
+		{"too short", rfcSecret, codeAt(step)[:5], 0, false},
+		{"too long", rfcSecret, codeAt(step) + "0", 0, false},
+		{"empty", rfcSecret, "", 0, false},
+		{"invalid secret", "not base32!", "123456", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStep, ok := ValidateTOTP(tt.secret, tt.code, now)
+			if ok != tt.wantOK || gotStep != tt.wantStep {
+				t.Fatalf("ValidateTOTP() = (%d, %t), want (%d, %t)", gotStep, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestGenerateTOTPSecret(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil {
+		t.Fatalf("secret %q is not unpadded base32: %v", secret, err)
+	}
+	if len(key) != 20 {
+		t.Fatalf("secret is %d bytes, want 20", len(key))
+	}
+	now := time.Now()
+	code, _ := TOTPCode(secret, TOTPStep(now))
+	if _, ok := ValidateTOTP(secret, code, now); !ok {
+		t.Fatal("a fresh secret does not validate its own code")
+	}
+}
+
+func TestTOTPProvisioningURI(t *testing.T) {
+	uri := TOTPProvisioningURI(rfcSecret, "Adornme", "asha@example.com")
+	u, err := url.Parse(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/Adornme:asha@example.com" {
+		t.Fatalf("unexpected URI %s", uri)
+	}
+	q := u.Query()
+	for k, want := range map[string]string{"secret": rfcSecret, "issuer": "Adornme", "algorithm": "SHA1", "digits": "6", "period": "30"} {
+		if got := q.Get(k); got != want {
+			t.Errorf("%s = %q, want %q", k, got, want)
+		}
+	}
+}
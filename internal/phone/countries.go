@@ -0,0 +1,54 @@
+package phone
+
+// Country holds the numbering rules needed to parse and validate mobile numbers
+type Country struct {
+	ISO         string   // ISO 3166-1 alpha-2, e.g. "IN"
+	Name        string   // English short name
+	CallingCode string   // country calling code without "+", e.g. "91"
+	TrunkPrefix string   // dialled before national numbers at home, e.g. "0"
+	Lengths     []int    // allowed lengths of the national significant number
+	Mobile      []string // leading digits of mobile numbers; empty accepts any
+	AreaCodes   []string // leading digits that tell this region apart from others sharing its calling code
+}
+
+// countries lists the regions we ship to or expect customers from.
+// Regions sharing a calling code are told apart by AreaCodes; the one
+// without any takes the remaining numbers (e.g. "+1" → US unless Canadian).
+var countries = []Country{
+	{ISO: "IN", Name: "India", CallingCode: "91", TrunkPrefix: "0", Lengths: []int{10}, Mobile: []string{"6", "7", "8", "9"}},
+	{ISO: "US", Name: "United States", CallingCode: "1", TrunkPrefix: "1", Lengths: []int{10}},
+	{ISO: "CA", Name: "Canada", CallingCode: "1", TrunkPrefix: "1", Lengths: []int{10}, AreaCodes: canadianAreaCodes},
+	{ISO: "GB", Name: "United Kingdom", CallingCode: "44", TrunkPrefix: "0", Lengths: []int{10}, Mobile: []string{"7"}},
+	{ISO: "AE", Name: "United Arab Emirates", CallingCode: "971", TrunkPrefix: "0", Lengths: []int{9}, Mobile: []string{"5"}},
+	{ISO: "SA", Name: "Saudi Arabia", CallingCode: "966", TrunkPrefix: "0", Lengths: []int{9}, Mobile: []string{"5"}},
+	{ISO: "QA", Name: "Qatar", CallingCode: "974", Lengths: []int{8}, Mobile: []string{"3", "5", "6", "7"}},
+	{ISO: "SG", Name: "Singapore", CallingCode: "65", Lengths: []int{8}, Mobile: []string{"8", "9"}},
+	{ISO: "MY", Name: "Malaysia", CallingCode: "60", TrunkPrefix: "0", Lengths: []int{9, 10}, Mobile: []string{"1"}},
+	{ISO: "AU", Name: "Australia", CallingCode: "61", TrunkPrefix: "0", Lengths: []int{9}, Mobile: []string{"4"}},
+	{ISO: "NZ", Name: "New Zealand", CallingCode: "64", TrunkPrefix: "0", Lengths: []int{8, 9, 10}, Mobile: []string{"2"}},
+	{ISO: "DE", Name: "Germany", CallingCode: "49", TrunkPrefix: "0", Lengths: []int{10, 11}, Mobile: []string{"15", "16", "17"}},
+	{ISO: "FR", Name: "France", CallingCode: "33", TrunkPrefix: "0", Lengths: []int{9}, Mobile: []string{"6", "7"}},
+	{ISO: "NL", Name: "Netherlands", CallingCode: "31", TrunkPrefix: "0", Lengths: []int{9}, Mobile: []string{"6"}},
+	{ISO: "NP", Name: "Nepal", CallingCode: "977", TrunkPrefix: "0", Lengths: []int{10}, Mobile: []string{"9"}},
+	{ISO: "BD", Name: "Bangladesh", CallingCode: "880", TrunkPrefix: "0", Lengths: []int{10}, Mobile: []string{"1"}},
+	{ISO: "LK", Name: "Sri Lanka", CallingCode: "94", TrunkPrefix: "0", Lengths: []int{9}, Mobile: []string{"7"}},
+}
+
+// canadianAreaCodes are the NANP area codes assigned to Canada, non-geographic 600 and 622 included
+var canadianAreaCodes = []string{
+	"204", "226", "236", "249", "250", "257", "263", "289", "306", "343", "354", "365", "367", "368",
+	"382", "403", "416", "418", "428", "431", "437", "438", "450", "460", "468", "474", "506", "514",
+	"519", "548", "579", "581", "584", "587", "600", "604", "613", "622", "639", "647", "672", "683",
+	"705", "709", "742", "753", "778", "780", "782", "807", "819", "825", "867", "873", "879", "902",
+	"905", "942",
+}
+
+// unsupportedAreaCodes belong to regions outside the list that share a
+// calling code with one in it: the Caribbean and Atlantic members of NANP.
+// US territories (Puerto Rico, Guam, ...) are reported as US.
+var unsupportedAreaCodes = map[string][]string{
+	"1": {
+		"242", "246", "264", "268", "284", "345", "441", "473", "649", "658", "664", "721",
+		"758", "767", "784", "809", "829", "849", "868", "869", "876",
+	},
+}
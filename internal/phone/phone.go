@@ -0,0 +1,184 @@
+package phone
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalidNumber  = errors.New("invalid phone number")
+	ErrUnknownCountry = errors.New("unsupported country calling code")
+)
+
+// maxE164Digits is the longest number E.164 allows, calling code included
+const maxE164Digits = 15
+
+// Number is a parsed, validated mobile number
+type Number struct {
+	E164     string   // "+919876543210"
+	National string   // national significant number, "9876543210"
+	Country  *Country // region the number belongs to
+}
+
+func (n *Number) String() string { return n.E164 }
+
+// CountryByISO looks up a supported region by its ISO code
+func CountryByISO(iso string) (*Country, bool) {
+	iso = strings.ToUpper(strings.TrimSpace(iso))
+	for i := range countries {
+		if countries[i].ISO == iso {
+			return &countries[i], true
+		}
+	}
+	return nil, false
+}
+
+// Parse reads a mobile number typed by a user. Input with a leading "+" or
+// "00" is international; anything else is read as a national number of
+// defaultRegion, with or without its trunk prefix or calling code.
+func Parse(input string, defaultRegion string) (*Number, error) {
+	raw, international := clean(input)
+	if raw == "" || len(raw) > maxE164Digits {
+		return nil, ErrInvalidNumber
+	}
+
+	if international {
+		c, national := splitCallingCode(raw)
+		if c == nil {
+			return nil, ErrUnknownCountry
+		}
+		return build(c, national)
+	}
+
+	c, ok := CountryByISO(defaultRegion)
+	if !ok {
+		return nil, ErrUnknownCountry
+	}
+	// Trunk prefix dialled at home, e.g. "09876543210"
+	if c.TrunkPrefix != "" && strings.HasPrefix(raw, c.TrunkPrefix) {
+		if n, err := build(c, raw[len(c.TrunkPrefix):]); err == nil {
+			return n, nil
+		}
+	}
+	if n, err := build(c, raw); err == nil {
+		return n, nil
+	}
+	// Calling code typed without the "+", e.g. "919876543210"
+	if strings.HasPrefix(raw, c.CallingCode) {
+		return build(c, strings.TrimPrefix(raw, c.CallingCode))
+	}
+	return nil, ErrInvalidNumber
+}
+
+// Normalize parses input and returns it in E.164 form
+func Normalize(input string, defaultRegion string) (string, error) {
+	n, err := Parse(input, defaultRegion)
+	if err != nil {
+		return "", err
+	}
+	return n.E164, nil
+}
+
+// clean strips formatting characters and reports whether the number was
+// written in international form
+func clean(input string) (digits string, international bool) {
+	input = strings.TrimSpace(input)
+	input = strings.ReplaceAll(input, "＋", "+")
+
+	switch {
+	case strings.HasPrefix(input, "+"):
+		international = true
+		input = input[1:]
+	case strings.HasPrefix(input, "00"):
+		international = true
+		input = input[2:]
+	}
+
+	var b strings.Builder
+	for _, r := range input {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '.' || r == '(' || r == ')' || r == '\u00a0':
+			// formatting
+		default:
+			return "", international
+		}
+	}
+	return b.String(), international
+}
+
+// splitCallingCode finds a region whose calling code prefixes digits; build
+// settles which of the regions sharing that code the number belongs to.
+// Calling codes are prefix-free, so at most one length matches.
+func splitCallingCode(digits string) (*Country, string) {
+	for l := 1; l <= 3 && l < len(digits); l++ {
+		for i := range countries {
+			if countries[i].CallingCode == digits[:l] {
+				return &countries[i], digits[l:]
+			}
+		}
+	}
+	return nil, ""
+}
+
+// build validates a national significant number against the region's rules
+// and reports the region it belongs to
+func build(c *Country, national string) (*Number, error) {
+	if !validLength(c, national) || !validMobile(c, national) {
+		return nil, ErrInvalidNumber
+	}
+	owner := region(c, national)
+	if owner == nil {
+		return nil, ErrUnknownCountry
+	}
+	return &Number{E164: "+" + c.CallingCode + national, National: national, Country: owner}, nil
+}
+
+// region picks, among the regions sharing c's calling code, the one national
+// belongs to: the region whose AreaCodes match, else the one without any.
+// nil when the number belongs to a region we do not support.
+func region(c *Country, national string) *Country {
+	var rest *Country
+	for i := range countries {
+		o := &countries[i]
+		if o.CallingCode != c.CallingCode {
+			continue
+		}
+		if len(o.AreaCodes) == 0 {
+			if rest == nil {
+				rest = o
+			}
+			continue
+		}
+		if hasAnyPrefix(national, o.AreaCodes) {
+			return o
+		}
+	}
+	if hasAnyPrefix(national, unsupportedAreaCodes[c.CallingCode]) {
+		return nil
+	}
+	return rest
+}
+
+func validLength(c *Country, national string) bool {
+	for _, l := range c.Lengths {
+		if len(national) == l {
+			return true
+		}
+	}
+	return false
+}
+
+func validMobile(c *Country, national string) bool {
+	return len(c.Mobile) == 0 || hasAnyPrefix(national, c.Mobile)
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package phone
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		region    string
+		wantE164  string
+		wantISO   string
+		wantError error
+	}{
+		// international input
+		{"plus", "+919876543210", "IN", "+919876543210", "IN", nil},
+		{"double zero", "00919876543210", "GB", "+919876543210", "IN", nil},
+		{"formatting", "+91 (98765) 432-10", "IN", "+919876543210", "IN", nil},
+		{"fullwidth plus", "＋44 7700 900123", "IN", "+447700900123", "GB", nil},
+		{"non-breaking space", "+91 9876543210", "IN", "+919876543210", "IN", nil},
+		{"three digit calling code", "+971501234567", "IN", "+971501234567", "AE", nil},
+		{"international ignores region", "+6591234567", "US", "+6591234567", "SG", nil},
+
+		// national input in the default region
+		{"national", "9876543210", "IN", "+919876543210", "IN", nil},
+		{"trunk prefix", "09876543210", "IN", "+919876543210", "IN", nil},
+		{"calling code without plus", "919876543210", "IN", "+919876543210", "IN", nil},
+		{"national GB", "07700 900123", "GB", "+447700900123", "GB", nil},
+		{"region is case-insensitive", "9876543210", " in ", "+919876543210", "IN", nil},
+
+		// NANP: +1 is split by area code
+		{"US", "+1 212 555 0123", "IN", "+12125550123", "US", nil},
+		{"Canada", "+1 416 555 0123", "IN", "+14165550123", "CA", nil},
+		{"Canada typed nationally in US", "(604) 555-0123", "US", "+16045550123", "CA", nil},
+		{"US typed nationally in Canada", "1 212 555 0123", "CA", "+12125550123", "US", nil},
+		{"Puerto Rico reported as US", "+1 787 555 0123", "IN", "+17875550123", "US", nil},
+		{"Jamaica unsupported", "+1 876 555 0123", "IN", "", "", ErrUnknownCountry},
+
+		// rejected
+		{"empty", "", "IN", "", "", ErrInvalidNumber},
+		{"letters", "+91 98765 4321O", "IN", "", "", ErrInvalidNumber},
+		{"too long for E.164", "+9198765432101234", "IN", "", "", ErrInvalidNumber},
+		{"landline prefix", "+912212345678", "IN", "", "", ErrInvalidNumber},
+		{"too short", "+91987654321", "IN", "", "", ErrInvalidNumber},
+		{"national too short", "98765", "IN", "", "", ErrInvalidNumber},
+		{"unknown calling code", "+999123456789", "IN", "", "", ErrUnknownCountry},
+		{"unknown default region", "9876543210", "ZZ", "", "", ErrUnknownCountry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := Parse(tt.input, tt.region)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("Parse(%q, %q) error = %v, want %v", tt.input, tt.region, err, tt.wantError)
+			}
+			if err != nil {
+				return
+			}
+			if n.E164 != tt.wantE164 || n.Country.ISO != tt.wantISO {
+				t.Fatalf("Parse(%q, %q) = %s (%s), want %s (%s)", tt.input, tt.region, n.E164, n.Country.ISO, tt.wantE164, tt.wantISO)
+			}
+			if n.E164 != "+"+n.Country.CallingCode+n.National {
+				t.Fatalf("E164 %s does not join calling code %s and national %s", n.E164, n.Country.CallingCode, n.National)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	got, err := Normalize("098765 43210", "IN")
+	if err != nil || got != "+919876543210" {
+		t.Fatalf("Normalize() = %q, %v", got, err)
+	}
+	if _, err := Normalize("12345", "IN"); !errors.Is(err, ErrInvalidNumber) {
+		t.Fatalf("Normalize(short) error = %v, want %v", err, ErrInvalidNumber)
+	}
+}
+
+func TestCallingCodesArePrefixFree(t *testing.T) {
+	for i := range countries {
+		for j := range countries {
+			a, b := countries[i].CallingCode, countries[j].CallingCode
+			if a != b && len(a) < len(b) && b[:len(a)] == a {
+				t.Errorf("calling code %s (%s) prefixes %s (%s)", a, countries[i].ISO, b, countries[j].ISO)
+			}
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package webauthn
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// ErrMalformedCBOR is returned for CBOR outside the subset WebAuthn uses
+var ErrMalformedCBOR = errors.New("malformed cbor")
+
+// maxCBORDepth bounds nesting; attestation objects and COSE keys are shallow
+const maxCBORDepth = 8
+
+// decodeCBOR decodes one data item from b and returns it with the bytes after it.
+// Only what attestation objects and COSE keys use is supported: integers (as
+// int64), byte strings, text strings, arrays, maps with integer or text keys,
+// tags (dropped) and false, true and null.
+func decodeCBOR(b []byte) (any, []byte, error) {
+	return decodeItem(b, 0)
+}
+
+func decodeItem(b []byte, depth int) (any, []byte, error) {
+	if depth > maxCBORDepth || len(b) == 0 {
+		return nil, nil, ErrMalformedCBOR
+	}
+	major, info := b[0]>>5, b[0]&0x1f
+	arg, rest, err := readArg(info, b[1:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch major {
+	case 0:
+		if arg > math.MaxInt64 {
+			return nil, nil, ErrMalformedCBOR
+		}
+		return int64(arg), rest, nil
+	case 1:
+		if arg > math.MaxInt64 {
+			return nil, nil, ErrMalformedCBOR
+		}
+		return -1 - int64(arg), rest, nil
+	case 2, 3:
+		if arg > uint64(len(rest)) {
+			return nil, nil, ErrMalformedCBOR
+		}
+		if major == 3 {
+			return string(rest[:arg]), rest[arg:], nil
+		}
+		return rest[:arg:arg], rest[arg:], nil
+	case 4:
+		if arg > uint64(len(rest)) {
+			return nil, nil, ErrMalformedCBOR
+		}
+		out := make([]any, 0, arg)
+		for i := uint64(0); i < arg; i++ {
+			var v any
+			if v, rest, err = decodeItem(rest, depth+1); err != nil {
+				return nil, nil, err
+			}
+			out = append(out, v)
+		}
+		return out, rest, nil
+	case 5:
+		if arg > uint64(len(rest))/2 {
+			return nil, nil, ErrMalformedCBOR
+		}
+		out := make(map[any]any, arg)
+		for i := uint64(0); i < arg; i++ {
+			var k, v any
+			if k, rest, err = decodeItem(rest, depth+1); err != nil {
+				return nil, nil, err
+			}
+			switch k.(type) {
+			case int64, string:
+			default:
+				return nil, nil, ErrMalformedCBOR
+			}
+			if v, rest, err = decodeItem(rest, depth+1); err != nil {
+				return nil, nil, err
+			}
+			if _, dup := out[k]; dup {
+				return nil, nil, ErrMalformedCBOR
+			}
+			out[k] = v
+		}
+		return out, rest, nil
+	case 6:
+		return decodeItem(rest, depth+1)
+	default: // 7: simple values; floats never appear in WebAuthn structures
+		switch info {
+		case 20:
+			return false, rest, nil
+		case 21:
+			return true, rest, nil
+		case 22:
+			return nil, rest, nil
+		}
+		return nil, nil, ErrMalformedCBOR
+	}
+}
+
+// readArg reads the argument that follows an initial byte; indefinite lengths are refused
+func readArg(info byte, b []byte) (uint64, []byte, error) {
+	switch {
+	case info < 24:
+		return uint64(info), b, nil
+	case info == 24 && len(b) >= 1:
+		return uint64(b[0]), b[1:], nil
+	case info == 25 && len(b) >= 2:
+		return uint64(binary.BigEndian.Uint16(b)), b[2:], nil
+	case info == 26 && len(b) >= 4:
+		return uint64(binary.BigEndian.Uint32(b)), b[4:], nil
+	case info == 27 && len(b) >= 8:
+		return binary.BigEndian.Uint64(b), b[8:], nil
+	}
+	return 0, nil, ErrMalformedCBOR
+}
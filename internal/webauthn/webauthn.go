@@ -0,0 +1,426 @@
+// Package webauthn verifies WebAuthn registration (attestation) and login
+// (assertion) ceremonies. It does no I/O: challenges and credentials are kept
+// by the caller, so a software authenticator can drive it end to end.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Ceremony errors
+var (
+	ErrInvalidClientData      = errors.New("invalid client data")
+	ErrChallengeMismatch      = errors.New("challenge mismatch")
+	ErrOriginNotAllowed       = errors.New("origin not allowed")
+	ErrInvalidAuthData        = errors.New("invalid authenticator data")
+	ErrRPIDMismatch           = errors.New("credential belongs to another relying party")
+	ErrUserNotPresent         = errors.New("user presence was not confirmed")
+	ErrUserNotVerified        = errors.New("user verification is required")
+	ErrUnsupportedAttestation = errors.New("unsupported attestation format")
+	ErrInvalidAttestation     = errors.New("invalid attestation statement")
+	ErrCredentialMismatch     = errors.New("credential id mismatch")
+	ErrClonedAuthenticator    = errors.New("signature counter went backwards, authenticator may be cloned")
+)
+
+// Authenticator data flags (WebAuthn §6.1)
+const (
+	flagUP = 0x01 // user present
+	flagUV = 0x04 // user verified
+	flagBE = 0x08 // backup eligible (synced passkey)
+	flagBS = 0x10 // backed up
+	flagAT = 0x40 // attested credential data included
+	flagED = 0x80 // extension data included
+)
+
+// ChallengeSize is the number of random bytes in a challenge
+const ChallengeSize = 32
+
+// maxCredentialIDLength is the WebAuthn limit on credential ids
+const maxCredentialIDLength = 1023
+
+// RelyingParty is this service as WebAuthn sees it
+type RelyingParty struct {
+	ID      string   // effective domain, e.g. adornme.in
+	Name    string   // shown by the authenticator
+	Origins []string // web origins and android:apk-key-hash:… app origins
+	Timeout time.Duration
+}
+
+// Credential is a registered public key credential
+type Credential struct {
+	ID             []byte
+	PublicKey      []byte // COSE_Key, as sent by the authenticator
+	Algorithm      int64
+	SignCount      uint32
+	AAGUID         []byte
+	Transports     []string
+	BackupEligible bool
+	BackedUp       bool
+}
+
+// Attestation is the client's answer to navigator.credentials.create()
+type Attestation struct {
+	CredentialID      []byte
+	ClientDataJSON    []byte
+	AttestationObject []byte
+	Transports        []string
+}
+
+// Assertion is the client's answer to navigator.credentials.get()
+type Assertion struct {
+	CredentialID      []byte
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+	UserHandle        []byte
+}
+
+// AssertionResult is what a verified assertion tells about the authenticator
+type AssertionResult struct {
+	SignCount    uint32
+	UserVerified bool
+	BackedUp     bool
+}
+
+// User identifies the account a credential is created for
+type User struct {
+	ID          []byte // user handle; opaque, no personal data
+	Name        string
+	DisplayName string
+}
+
+// ---------------- Options (JSON for PublicKeyCredential.parse*OptionsFromJSON) ----------------
+
+type CredentialDescriptor struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Transports []string `json:"transports,omitempty"`
+}
+
+type CredentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+type CreationOptions struct {
+	RP struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"rp"`
+	User struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	} `json:"user"`
+	Challenge              string                 `json:"challenge"`
+	PubKeyCredParams       []CredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection struct {
+		ResidentKey      string `json:"residentKey"`
+		UserVerification string `json:"userVerification"`
+	} `json:"authenticatorSelection"`
+	Attestation string `json:"attestation"`
+}
+
+type RequestOptions struct {
+	Challenge        string                 `json:"challenge"`
+	Timeout          int64                  `json:"timeout"`
+	RPID             string                 `json:"rpId"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// NewChallenge returns fresh random challenge bytes
+func NewChallenge() ([]byte, error) {
+	b := make([]byte, ChallengeSize)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// EncodeBase64URL is the encoding WebAuthn JSON uses for binary fields
+func EncodeBase64URL(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeBase64URL accepts base64url with or without padding
+func DecodeBase64URL(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// CreationOptions builds the options for registering a new credential for user.
+// Existing credentials are excluded so an authenticator is not registered twice.
+func (rp *RelyingParty) CreationOptions(challenge []byte, user User, existing []Credential) *CreationOptions {
+	o := &CreationOptions{
+		Challenge:          EncodeBase64URL(challenge),
+		Timeout:            rp.Timeout.Milliseconds(),
+		ExcludeCredentials: descriptors(existing),
+		Attestation:        "none",
+	}
+	o.RP.ID, o.RP.Name = rp.ID, rp.Name
+	o.User.ID, o.User.Name, o.User.DisplayName = EncodeBase64URL(user.ID), user.Name, user.DisplayName
+	for _, alg := range SupportedAlgorithms {
+		o.PubKeyCredParams = append(o.PubKeyCredParams, CredentialParameter{Type: "public-key", Alg: alg})
+	}
+	// Discoverable credentials allow a login without typing an email
+	o.AuthenticatorSelection.ResidentKey = "preferred"
+	o.AuthenticatorSelection.UserVerification = "preferred"
+	return o
+}
+
+// RequestOptions builds the options for an assertion. An empty allow list lets
+// the authenticator offer any discoverable credential for this RP.
+func (rp *RelyingParty) RequestOptions(challenge []byte, allow []Credential, userVerification string) *RequestOptions {
+	return &RequestOptions{
+		Challenge:        EncodeBase64URL(challenge),
+		Timeout:          rp.Timeout.Milliseconds(),
+		RPID:             rp.ID,
+		AllowCredentials: descriptors(allow),
+		UserVerification: userVerification,
+	}
+}
+
+func descriptors(creds []Credential) []CredentialDescriptor {
+	out := make([]CredentialDescriptor, 0, len(creds))
+	for _, c := range creds {
+		out = append(out, CredentialDescriptor{Type: "public-key", ID: EncodeBase64URL(c.ID), Transports: c.Transports})
+	}
+	return out
+}
+
+// ---------------- Ceremonies ----------------
+
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+// ChallengeFromClientData returns the base64url challenge a response answers,
+// so the caller can look up the ceremony it started.
+func ChallengeFromClientData(clientDataJSON []byte) (string, error) {
+	var cd clientData
+	if err := json.Unmarshal(clientDataJSON, &cd); err != nil || cd.Challenge == "" {
+		return "", ErrInvalidClientData
+	}
+	return cd.Challenge, nil
+}
+
+// VerifyAttestation checks a registration response against the challenge that
+// was issued and returns the new credential. Only the "none" and "packed"
+// formats are accepted, as registration asks for no attestation.
+func (rp *RelyingParty) VerifyAttestation(challenge []byte, a *Attestation, requireUV bool) (*Credential, error) {
+	if err := rp.checkClientData(a.ClientDataJSON, "webauthn.create", challenge); err != nil {
+		return nil, err
+	}
+
+	item, rest, err := decodeCBOR(a.AttestationObject)
+	if err != nil || len(rest) != 0 {
+		return nil, ErrMalformedCBOR
+	}
+	obj, ok := item.(map[any]any)
+	if !ok {
+		return nil, ErrMalformedCBOR
+	}
+	format, _ := obj["fmt"].(string)
+	stmt, _ := obj["attStmt"].(map[any]any)
+	rawAuthData, _ := obj["authData"].([]byte)
+
+	ad, err := rp.parseAuthData(rawAuthData, requireUV)
+	if err != nil {
+		return nil, err
+	}
+	if ad.flags&flagAT == 0 || ad.credential == nil {
+		return nil, ErrInvalidAuthData
+	}
+	cred := ad.credential
+	if len(a.CredentialID) > 0 && !bytes.Equal(a.CredentialID, cred.ID) {
+		return nil, ErrCredentialMismatch
+	}
+
+	key, err := parseCOSEKey(cred.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	cred.Algorithm = key.alg
+
+	clientHash := sha256.Sum256(a.ClientDataJSON)
+	signed := append(slices.Clip(rawAuthData), clientHash[:]...)
+	if err := verifyStatement(format, stmt, key, signed); err != nil {
+		return nil, err
+	}
+
+	cred.Transports = a.Transports
+	return cred, nil
+}
+
+// VerifyAssertion checks a login response made with cred against the challenge
+// that was issued. The caller stores the returned sign count.
+func (rp *RelyingParty) VerifyAssertion(challenge []byte, a *Assertion, cred *Credential, requireUV bool) (*AssertionResult, error) {
+	if !bytes.Equal(a.CredentialID, cred.ID) {
+		return nil, ErrCredentialMismatch
+	}
+	if err := rp.checkClientData(a.ClientDataJSON, "webauthn.get", challenge); err != nil {
+		return nil, err
+	}
+	ad, err := rp.parseAuthData(a.AuthenticatorData, requireUV)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := parseCOSEKey(cred.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	clientHash := sha256.Sum256(a.ClientDataJSON)
+	signed := append(slices.Clip(a.AuthenticatorData), clientHash[:]...)
+	if err := key.verify(signed, a.Signature); err != nil {
+		return nil, err
+	}
+
+	// Authenticators without a counter always send 0 (synced passkeys do)
+	if (ad.signCount != 0 || cred.SignCount != 0) && ad.signCount <= cred.SignCount {
+		return nil, ErrClonedAuthenticator
+	}
+
+	return &AssertionResult{
+		SignCount:    ad.signCount,
+		UserVerified: ad.flags&flagUV != 0,
+		BackedUp:     ad.flags&flagBS != 0,
+	}, nil
+}
+
+func (rp *RelyingParty) checkClientData(raw []byte, ceremony string, challenge []byte) error {
+	var cd clientData
+	if err := json.Unmarshal(raw, &cd); err != nil || cd.Type != ceremony {
+		return ErrInvalidClientData
+	}
+	got, err := DecodeBase64URL(cd.Challenge)
+	if err != nil || subtle.ConstantTimeCompare(got, challenge) != 1 {
+		return ErrChallengeMismatch
+	}
+	if cd.CrossOrigin || !slices.Contains(rp.Origins, cd.Origin) {
+		return ErrOriginNotAllowed
+	}
+	return nil
+}
+
+type authData struct {
+	flags      byte
+	signCount  uint32
+	credential *Credential
+}
+
+// parseAuthData checks the RP id hash and flags and extracts the attested credential, if any
+func (rp *RelyingParty) parseAuthData(b []byte, requireUV bool) (*authData, error) {
+	if len(b) < 37 {
+		return nil, ErrInvalidAuthData
+	}
+	rpHash := sha256.Sum256([]byte(rp.ID))
+	if subtle.ConstantTimeCompare(b[:32], rpHash[:]) != 1 {
+		return nil, ErrRPIDMismatch
+	}
+	ad := &authData{flags: b[32], signCount: binary.BigEndian.Uint32(b[33:37])}
+	if ad.flags&flagUP == 0 {
+		return nil, ErrUserNotPresent
+	}
+	if requireUV && ad.flags&flagUV == 0 {
+		return nil, ErrUserNotVerified
+	}
+	rest := b[37:]
+
+	if ad.flags&flagAT != 0 {
+		if len(rest) < 18 {
+			return nil, ErrInvalidAuthData
+		}
+		aaguid, idLen := rest[:16], int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if idLen == 0 || idLen > maxCredentialIDLength || len(rest) < idLen {
+			return nil, ErrInvalidAuthData
+		}
+		id := rest[:idLen]
+		rest = rest[idLen:]
+
+		// The COSE key runs up to the extensions, if any
+		_, after, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, ErrInvalidAuthData
+		}
+		ad.credential = &Credential{
+			ID:             bytes.Clone(id),
+			PublicKey:      bytes.Clone(rest[:len(rest)-len(after)]),
+			SignCount:      ad.signCount,
+			AAGUID:         bytes.Clone(aaguid),
+			BackupEligible: ad.flags&flagBE != 0,
+			BackedUp:       ad.flags&flagBS != 0,
+		}
+		rest = after
+	}
+
+	if ad.flags&flagED != 0 {
+		_, after, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, ErrInvalidAuthData
+		}
+		rest = after
+	}
+	if len(rest) != 0 {
+		return nil, ErrInvalidAuthData
+	}
+	return ad, nil
+}
+
+// verifyStatement checks the attestation statement. "packed" is accepted as
+// self attestation or with a certificate, whose chain is not checked: the
+// credential is trusted for what it signs, not for the make it claims to be.
+func verifyStatement(format string, stmt map[any]any, key *publicKey, signed []byte) error {
+	switch format {
+	case "none":
+		if len(stmt) != 0 {
+			return ErrInvalidAttestation
+		}
+		return nil
+
+	case "packed":
+		alg, _ := stmt["alg"].(int64)
+		sig, _ := stmt["sig"].([]byte)
+		if len(sig) == 0 {
+			return ErrInvalidAttestation
+		}
+		x5c, _ := stmt["x5c"].([]any)
+		if len(x5c) == 0 {
+			if alg != key.alg {
+				return ErrInvalidAttestation
+			}
+			return key.verify(signed, sig)
+		}
+		der, _ := x5c[0].([]byte)
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return ErrInvalidAttestation
+		}
+		sigAlg, ok := x509Algorithm(alg)
+		if !ok {
+			return ErrInvalidAttestation
+		}
+		if err := cert.CheckSignature(sigAlg, signed, sig); err != nil {
+			return ErrBadSignature
+		}
+		return nil
+	}
+	return ErrUnsupportedAttestation
+}
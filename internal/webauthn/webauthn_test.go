@@ -0,0 +1,406 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+const (
+	testRPID   = "adornme.in"
+	testOrigin = "https://adornme.in"
+)
+
+var testRP = &RelyingParty{ID: testRPID, Name: "Adornme", Origins: []string{testOrigin, "android:apk-key-hash:abc"}}
+
+// ---------------- CBOR encoding, only what the authenticator below sends ----------------
+
+// pair keeps map entries in the order they are written
+type pair struct {
+	k, v any
+}
+
+func encodeCBOR(v any) []byte {
+	switch v := v.(type) {
+	case int:
+		if v < 0 {
+			return cborHead(1, uint64(-1-v))
+		}
+		return cborHead(0, uint64(v))
+	case int64:
+		return encodeCBOR(int(v))
+	case []byte:
+		return append(cborHead(2, uint64(len(v))), v...)
+	case string:
+		return append(cborHead(3, uint64(len(v))), v...)
+	case []pair:
+		out := cborHead(5, uint64(len(v)))
+		for _, p := range v {
+			out = append(out, encodeCBOR(p.k)...)
+			out = append(out, encodeCBOR(p.v)...)
+		}
+		return out
+	}
+	panic("encodeCBOR: unsupported type")
+}
+
+func cborHead(major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return []byte{major<<5 | byte(n)}
+	case n <= 0xff:
+		return []byte{major<<5 | 24, byte(n)}
+	case n <= 0xffff:
+		return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(n))
+	}
+	return binary.BigEndian.AppendUint32([]byte{major<<5 | 26}, uint32(n))
+}
+
+// ---------------- Software authenticator ----------------
+
+type authenticator struct {
+	alg   int64
+	id    []byte
+	ec    *ecdsa.PrivateKey
+	ed    ed25519.PrivateKey
+	count uint32
+}
+
+func newAuthenticator(t *testing.T, alg int64) *authenticator {
+	t.Helper()
+	a := &authenticator{alg: alg, id: make([]byte, 16)}
+	if _, err := rand.Read(a.id); err != nil {
+		t.Fatal(err)
+	}
+	var err error
+	switch alg {
+	case AlgES256:
+		a.ec, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case AlgEdDSA:
+		_, a.ed, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		t.Fatalf("no software key for alg %d", alg)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func (a *authenticator) coseKey() []byte {
+	if a.ec != nil {
+		return encodeCBOR([]pair{
+			{coseKty, ktyEC2}, {coseAlg, AlgES256}, {coseCrv, crvP256},
+			{coseX, a.ec.X.FillBytes(make([]byte, 32))},
+			{coseY, a.ec.Y.FillBytes(make([]byte, 32))},
+		})
+	}
+	return encodeCBOR([]pair{
+		{coseKty, ktyOKP}, {coseAlg, AlgEdDSA}, {coseCrv, crvEd25519},
+		{coseX, []byte(a.ed.Public().(ed25519.PublicKey))},
+	})
+}
+
+func (a *authenticator) sign(t *testing.T, data []byte) []byte {
+	t.Helper()
+	if a.ed != nil {
+		return ed25519.Sign(a.ed, data)
+	}
+	digest := sha256.Sum256(data)
+	sig, err := ecdsa.SignASN1(rand.Reader, a.ec, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+// response describes one ceremony answer; tests start from a valid one and break a single field
+type response struct {
+	typ         string
+	challenge   []byte
+	origin      string
+	crossOrigin bool
+	rpID        string
+	flags       byte
+	signCount   uint32
+	trailing    []byte // appended to authenticator data
+	format      string // attestation only
+}
+
+func validResponse(typ string, challenge []byte) *response {
+	return &response{
+		typ:       typ,
+		challenge: challenge,
+		origin:    testOrigin,
+		rpID:      testRPID,
+		flags:     flagUP | flagUV,
+		format:    "none",
+	}
+}
+
+func (r *response) clientDataJSON(t *testing.T) []byte {
+	t.Helper()
+	b, err := json.Marshal(clientData{
+		Type:        r.typ,
+		Challenge:   EncodeBase64URL(r.challenge),
+		Origin:      r.origin,
+		CrossOrigin: r.crossOrigin,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func (a *authenticator) authData(r *response, attested bool) []byte {
+	rpHash := sha256.Sum256([]byte(r.rpID))
+	flags := r.flags
+	if attested {
+		flags |= flagAT
+	}
+	out := append(rpHash[:], flags)
+	out = binary.BigEndian.AppendUint32(out, r.signCount)
+	if attested {
+		out = append(out, make([]byte, 16)...) // AAGUID
+		out = binary.BigEndian.AppendUint16(out, uint16(len(a.id)))
+		out = append(out, a.id...)
+		out = append(out, a.coseKey()...)
+	}
+	return append(out, r.trailing...)
+}
+
+// create answers navigator.credentials.create(); "packed" is self attestation
+func (a *authenticator) create(t *testing.T, r *response) *Attestation {
+	t.Helper()
+	cdj := r.clientDataJSON(t)
+	ad := a.authData(r, true)
+
+	stmt := []pair{}
+	if r.format == "packed" {
+		clientHash := sha256.Sum256(cdj)
+		sig := a.sign(t, append(bytes.Clone(ad), clientHash[:]...))
+		stmt = []pair{{"alg", a.alg}, {"sig", sig}}
+	}
+	return &Attestation{
+		CredentialID:      a.id,
+		ClientDataJSON:    cdj,
+		AttestationObject: encodeCBOR([]pair{{"fmt", r.format}, {"attStmt", stmt}, {"authData", ad}}),
+		Transports:        []string{"internal"},
+	}
+}
+
+// get answers navigator.credentials.get()
+func (a *authenticator) get(t *testing.T, r *response) *Assertion {
+	t.Helper()
+	cdj := r.clientDataJSON(t)
+	ad := a.authData(r, false)
+	clientHash := sha256.Sum256(cdj)
+	return &Assertion{
+		CredentialID:      a.id,
+		ClientDataJSON:    cdj,
+		AuthenticatorData: ad,
+		Signature:         a.sign(t, append(bytes.Clone(ad), clientHash[:]...)),
+	}
+}
+
+func newTestChallenge(t *testing.T) []byte {
+	t.Helper()
+	c, err := NewChallenge()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// ---------------- Tests ----------------
+
+func TestVerifyAttestation(t *testing.T) {
+	tests := []struct {
+		name      string
+		alg       int64
+		mutate    func(*response)
+		tamper    func(*Attestation)
+		requireUV bool
+		wantErr   error
+	}{
+		{name: "none ES256", alg: AlgES256},
+		{name: "none EdDSA", alg: AlgEdDSA},
+		{name: "packed ES256", alg: AlgES256, mutate: func(r *response) { r.format = "packed" }},
+		{name: "packed EdDSA", alg: AlgEdDSA, mutate: func(r *response) { r.format = "packed" }},
+		{name: "app origin", alg: AlgES256, mutate: func(r *response) { r.origin = "android:apk-key-hash:abc" }},
+		{name: "no UV when not required", alg: AlgES256, mutate: func(r *response) { r.flags = flagUP }},
+
+		{name: "wrong rpId hash", alg: AlgES256, mutate: func(r *response) { r.rpID = "evil.example" }, wantErr: ErrRPIDMismatch},
+		{name: "wrong origin", alg: AlgES256, mutate: func(r *response) { r.origin = "https://evil.example" }, wantErr: ErrOriginNotAllowed},
+		{name: "cross origin", alg: AlgES256, mutate: func(r *response) { r.crossOrigin = true }, wantErr: ErrOriginNotAllowed},
+		{name: "user not present", alg: AlgES256, mutate: func(r *response) { r.flags = flagUV }, wantErr: ErrUserNotPresent},
+		{name: "user not verified", alg: AlgES256, mutate: func(r *response) { r.flags = flagUP }, requireUV: true, wantErr: ErrUserNotVerified},
+		{name: "challenge mismatch", alg: AlgES256, mutate: func(r *response) { r.challenge = []byte("another challenge") }, wantErr: ErrChallengeMismatch},
+		{name: "assertion client data", alg: AlgES256, mutate: func(r *response) { r.typ = "webauthn.get" }, wantErr: ErrInvalidClientData},
+		{name: "trailing bytes in authData", alg: AlgES256, mutate: func(r *response) { r.trailing = []byte{0x00} }, wantErr: ErrInvalidAuthData},
+		{name: "trailing bytes, packed", alg: AlgEdDSA, mutate: func(r *response) { r.format, r.trailing = "packed", []byte{0xa0} }, wantErr: ErrInvalidAuthData},
+		{name: "unsupported format", alg: AlgES256, mutate: func(r *response) { r.format = "fido-u2f" }, wantErr: ErrUnsupportedAttestation},
+		{
+			name: "packed with a bad signature", alg: AlgES256,
+			mutate:  func(r *response) { r.format = "packed" },
+			tamper:  func(a *Attestation) { a.ClientDataJSON = append(a.ClientDataJSON, ' ') },
+			wantErr: ErrBadSignature,
+		},
+		{
+			name: "credential id mismatch", alg: AlgES256,
+			tamper:  func(a *Attestation) { a.CredentialID = []byte("someone else") },
+			wantErr: ErrCredentialMismatch,
+		},
+		{
+			name: "trailing bytes after attestation object", alg: AlgES256,
+			tamper:  func(a *Attestation) { a.AttestationObject = append(a.AttestationObject, 0x00) },
+			wantErr: ErrMalformedCBOR,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth := newAuthenticator(t, tt.alg)
+			challenge := newTestChallenge(t)
+			r := validResponse("webauthn.create", challenge)
+			if tt.mutate != nil {
+				tt.mutate(r)
+			}
+			att := auth.create(t, r)
+			if tt.tamper != nil {
+				tt.tamper(att)
+			}
+
+			cred, err := testRP.VerifyAttestation(challenge, att, tt.requireUV)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyAttestation() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !bytes.Equal(cred.ID, auth.id) || cred.Algorithm != tt.alg {
+				t.Fatalf("credential = id %x alg %d, want id %x alg %d", cred.ID, cred.Algorithm, auth.id, tt.alg)
+			}
+			if !bytes.Equal(cred.PublicKey, auth.coseKey()) {
+				t.Fatal("stored public key is not the authenticator's COSE key")
+			}
+			if len(cred.Transports) != 1 || cred.Transports[0] != "internal" {
+				t.Fatalf("transports = %v", cred.Transports)
+			}
+		})
+	}
+}
+
+func TestVerifyAttestationPackedAlgMismatch(t *testing.T) {
+	auth := newAuthenticator(t, AlgES256)
+	challenge := newTestChallenge(t)
+	r := validResponse("webauthn.create", challenge)
+	r.format = "packed"
+	auth.alg = AlgEdDSA // claims a different algorithm than its key
+	if _, err := testRP.VerifyAttestation(challenge, auth.create(t, r), false); !errors.Is(err, ErrInvalidAttestation) {
+		t.Fatalf("VerifyAttestation() error = %v, want %v", err, ErrInvalidAttestation)
+	}
+}
+
+// register runs a valid registration and returns the stored credential
+func register(t *testing.T, auth *authenticator) *Credential {
+	t.Helper()
+	challenge := newTestChallenge(t)
+	cred, err := testRP.VerifyAttestation(challenge, auth.create(t, validResponse("webauthn.create", challenge)), false)
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	return cred
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	tests := []struct {
+		name        string
+		alg         int64
+		storedCount uint32
+		mutate      func(*response)
+		tamper      func(*Assertion)
+		requireUV   bool
+		wantErr     error
+	}{
+		{name: "ES256", alg: AlgES256, storedCount: 4, mutate: func(r *response) { r.signCount = 5 }},
+		{name: "EdDSA", alg: AlgEdDSA, storedCount: 4, mutate: func(r *response) { r.signCount = 9 }},
+		{name: "no counter (synced passkey)", alg: AlgES256},
+		{name: "UV required and given", alg: AlgEdDSA, requireUV: true},
+
+		{name: "sign count regression", alg: AlgES256, storedCount: 7, mutate: func(r *response) { r.signCount = 6 }, wantErr: ErrClonedAuthenticator},
+		{name: "sign count repeated", alg: AlgES256, storedCount: 7, mutate: func(r *response) { r.signCount = 7 }, wantErr: ErrClonedAuthenticator},
+		{name: "counter reset to zero", alg: AlgEdDSA, storedCount: 7, wantErr: ErrClonedAuthenticator},
+		{name: "wrong rpId hash", alg: AlgES256, mutate: func(r *response) { r.rpID = "evil.example" }, wantErr: ErrRPIDMismatch},
+		{name: "wrong origin", alg: AlgES256, mutate: func(r *response) { r.origin = "https://adornme.in.evil.example" }, wantErr: ErrOriginNotAllowed},
+		{name: "cross origin", alg: AlgEdDSA, mutate: func(r *response) { r.crossOrigin = true }, wantErr: ErrOriginNotAllowed},
+		{name: "user not present", alg: AlgES256, mutate: func(r *response) { r.flags = flagUV }, wantErr: ErrUserNotPresent},
+		{name: "user not verified", alg: AlgES256, mutate: func(r *response) { r.flags = flagUP }, requireUV: true, wantErr: ErrUserNotVerified},
+		{name: "challenge mismatch", alg: AlgES256, mutate: func(r *response) { r.challenge = []byte("replayed challenge") }, wantErr: ErrChallengeMismatch},
+		{name: "registration client data", alg: AlgES256, mutate: func(r *response) { r.typ = "webauthn.create" }, wantErr: ErrInvalidClientData},
+		{name: "trailing bytes in authData", alg: AlgES256, mutate: func(r *response) { r.trailing = []byte{0x00} }, wantErr: ErrInvalidAuthData},
+		{name: "extensions flag without extensions", alg: AlgEdDSA, mutate: func(r *response) { r.flags |= flagED }, wantErr: ErrInvalidAuthData},
+		{
+			name: "signature over other data", alg: AlgES256,
+			tamper:  func(a *Assertion) { a.ClientDataJSON = append(a.ClientDataJSON, ' ') },
+			wantErr: ErrBadSignature,
+		},
+		{
+			name: "signature by another key", alg: AlgEdDSA,
+			tamper:  func(a *Assertion) { a.Signature[0] ^= 0xff },
+			wantErr: ErrBadSignature,
+		},
+		{
+			name: "other credential", alg: AlgES256,
+			tamper:  func(a *Assertion) { a.CredentialID = []byte("someone else") },
+			wantErr: ErrCredentialMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth := newAuthenticator(t, tt.alg)
+			cred := register(t, auth)
+			cred.SignCount = tt.storedCount
+
+			challenge := newTestChallenge(t)
+			r := validResponse("webauthn.get", challenge)
+			if tt.mutate != nil {
+				tt.mutate(r)
+			}
+			a := auth.get(t, r)
+			if tt.tamper != nil {
+				tt.tamper(a)
+			}
+
+			res, err := testRP.VerifyAssertion(challenge, a, cred, tt.requireUV)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyAssertion() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if res.SignCount != r.signCount || res.UserVerified != (r.flags&flagUV != 0) {
+				t.Fatalf("result = %+v, want sign count %d", res, r.signCount)
+			}
+		})
+	}
+}
+
+func TestChallengeFromClientData(t *testing.T) {
+	challenge := newTestChallenge(t)
+	got, err := ChallengeFromClientData(validResponse("webauthn.get", challenge).clientDataJSON(t))
+	if err != nil || got != EncodeBase64URL(challenge) {
+		t.Fatalf("ChallengeFromClientData() = %q, %v", got, err)
+	}
+	for _, raw := range []string{"", "{}", `{"challenge":""}`, "not json"} {
+		if _, err := ChallengeFromClientData([]byte(raw)); !errors.Is(err, ErrInvalidClientData) {
+			t.Errorf("ChallengeFromClientData(%q) error = %v, want %v", raw, err, ErrInvalidClientData)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
@@ -97,13 +98,18 @@ var (
 	configMap = make(map[string]Level)
 	once      sync.Once
 
+	// consoleOnly is set when the level config cannot be read. Component loggers
+	// are still created, at INFO and without log files, instead of staying nil
+	// and panicking on their first call.
+	consoleOnly bool
+
 	podID = os.Getenv("POD_ID")
 	env   = os.Getenv("ENV")
 )
 
 func init() {
 	if err := Init("config/logging-level.json"); err != nil {
-		log.Printf("Logging auto-init failed: %v\n", err)
+		log.Printf("Logging auto-init failed, logging to the console only: %v\n", err)
 	}
 }
 
@@ -111,26 +117,8 @@ func init() {
 func Init(configPath string) error {
 	var err error
 	once.Do(func() {
-		configFile, e := os.Open(configPath)
-		if e != nil {
-			err = fmt.Errorf("failed to open logging config: %w", e)
-			return
-		}
-		defer configFile.Close()
-
-		var raw map[string]string
-		if e := json.NewDecoder(configFile).Decode(&raw); e != nil {
-			err = fmt.Errorf("failed to parse logging config: %w", e)
-			return
-		}
-
-		for comp, lvl := range raw {
-			lvl = strings.ToUpper(lvl)
-			if parsed, ok := levelNames[lvl]; ok {
-				configMap[comp] = parsed
-			} else {
-				configMap[comp] = INFO
-			}
+		if err = loadLevels(configPath); err != nil {
+			consoleOnly = true
 		}
 
 		Database = setupLogger("database")
@@ -145,6 +133,30 @@ func Init(configPath string) error {
 	return err
 }
 
+// loadLevels reads the per-component levels into configMap
+func loadLevels(configPath string) error {
+	configFile, err := os.Open(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to open logging config: %w", err)
+	}
+	defer configFile.Close()
+
+	var raw map[string]string
+	if err := json.NewDecoder(configFile).Decode(&raw); err != nil {
+		return fmt.Errorf("failed to parse logging config: %w", err)
+	}
+
+	for comp, lvl := range raw {
+		lvl = strings.ToUpper(lvl)
+		if parsed, ok := levelNames[lvl]; ok {
+			configMap[comp] = parsed
+		} else {
+			configMap[comp] = INFO
+		}
+	}
+	return nil
+}
+
 // -------------------- COMPONENT LOGGER --------------------
 func Component(name string) *Logger {
 	switch strings.ToLower(name) {
@@ -175,17 +187,19 @@ func setupLogger(component string) *Logger {
 		return lg
 	}
 
-	_ = os.MkdirAll("logs", 0755)
+	fileLogger := log.New(io.Discard, "", 0)
+	if !consoleOnly {
+		_ = os.MkdirAll("logs", 0755)
 
-	fileWriter := &lumberjack.Logger{
-		Filename:   filepath.Join("logs", component+".log"),
-		MaxSize:    1,    // 1 MB for testing, rotate quickly
-		MaxBackups: 5,    // keep last 5 rotated files
-		MaxAge:     7,    // keep for 7 days
-		Compress:   true, // compress old files
+		fileWriter := &lumberjack.Logger{
+			Filename:   filepath.Join("logs", component+".log"),
+			MaxSize:    1,    // 1 MB for testing, rotate quickly
+			MaxBackups: 5,    // keep last 5 rotated files
+			MaxAge:     7,    // keep for 7 days
+			Compress:   true, // compress old files
+		}
+		fileLogger = log.New(fileWriter, "", 0)
 	}
-
-	fileLogger := log.New(fileWriter, "", 0)
 	consoleLogger := log.New(os.Stdout, "", 0)
 
 	minLevel := INFO
@@ -4,7 +4,10 @@ package models
 
 import (
 	"context"
+	stderrors "errors"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -18,6 +21,9 @@ type CartItem struct {
 	// Example: Gold Ring
 	Name string `json:"name,omitempty"`
 
+	// options
+	Options []*VariantOption `json:"options"`
+
 	// price
 	// Example: 1499.75
 	Price float32 `json:"price,omitempty"`
@@ -30,6 +36,14 @@ type CartItem struct {
 	// Example: 2
 	Quantity int64 `json:"quantity,omitempty"`
 
+	// sku
+	// Example: RING-101-18K-Y-7
+	Sku string `json:"sku,omitempty"`
+
+	// sku Id
+	// Example: 7001
+	SkuID int64 `json:"skuId,omitempty"`
+
 	// subtotal
 	// Example: 2999.5
 	Subtotal float32 `json:"subtotal,omitempty"`
@@ -37,11 +51,88 @@ type CartItem struct {
 
 // Validate validates this cart item
 func (m *CartItem) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateOptions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CartItem) validateOptions(formats strfmt.Registry) error {
+	if swag.IsZero(m.Options) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Options); i++ {
+		if swag.IsZero(m.Options[i]) { // not required
+			continue
+		}
+
+		if m.Options[i] != nil {
+			if err := m.Options[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
-// ContextValidate validates this cart item based on context it is used
+// ContextValidate validate this cart item based on the context it is used
 func (m *CartItem) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateOptions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CartItem) contextValidateOptions(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Options); i++ {
+
+		if m.Options[i] != nil {
+
+			if swag.IsZero(m.Options[i]) { // not required
+				return nil
+			}
+
+			if err := m.Options[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
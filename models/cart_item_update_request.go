@@ -16,25 +16,26 @@ import (
 // swagger:model CartItemUpdateRequest
 type CartItemUpdateRequest struct {
 
-	// product Id
-	// Required: true
-	ProductID *int64 `json:"productId"`
-
 	// quantity
 	// Required: true
 	// Minimum: 1
 	Quantity *int64 `json:"quantity"`
+
+	// id of the product variant
+	// Required: true
+	// Minimum: 1
+	SkuID *int64 `json:"skuId"`
 }
 
 // Validate validates this cart item update request
 func (m *CartItemUpdateRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
-	if err := m.validateProductID(formats); err != nil {
+	if err := m.validateQuantity(formats); err != nil {
 		res = append(res, err)
 	}
 
-	if err := m.validateQuantity(formats); err != nil {
+	if err := m.validateSkuID(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -44,22 +45,26 @@ func (m *CartItemUpdateRequest) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *CartItemUpdateRequest) validateProductID(formats strfmt.Registry) error {
+func (m *CartItemUpdateRequest) validateQuantity(formats strfmt.Registry) error {
+
+	if err := validate.Required("quantity", "body", m.Quantity); err != nil {
+		return err
+	}
 
-	if err := validate.Required("productId", "body", m.ProductID); err != nil {
+	if err := validate.MinimumInt("quantity", "body", *m.Quantity, 1, false); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (m *CartItemUpdateRequest) validateQuantity(formats strfmt.Registry) error {
+func (m *CartItemUpdateRequest) validateSkuID(formats strfmt.Registry) error {
 
-	if err := validate.Required("quantity", "body", m.Quantity); err != nil {
+	if err := validate.Required("skuId", "body", m.SkuID); err != nil {
 		return err
 	}
 
-	if err := validate.MinimumInt("quantity", "body", *m.Quantity, 1, false); err != nil {
+	if err := validate.MinimumInt("skuId", "body", *m.SkuID, 1, false); err != nil {
 		return err
 	}
 
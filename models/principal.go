@@ -28,9 +28,15 @@ type Principal struct {
 	// Workaround for AT event tracker
 	Crn string `json:"crn,omitempty"`
 
+	// ID of the support agent acting as this user (act claim); requests are read-only
+	ImpersonatorID string `json:"impersonatorId,omitempty"`
+
 	// Workaround for API's not having region in their context
 	Region string `json:"region,omitempty"`
 
+	// ID of the session the access token was issued for
+	SessionID string `json:"sessionId,omitempty"`
+
 	// OAuth2 Token
 	Token *Token `json:"token,omitempty"`
 
@@ -4,6 +4,8 @@ package models
 
 import (
 	"context"
+	stderrors "errors"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -16,6 +18,16 @@ import (
 // swagger:model Product
 type Product struct {
 
+	// Set once the product is deleted; archived products are hidden from listProducts but still resolve by id
+	// Format: date-time
+	ArchivedAt *strfmt.DateTime `json:"archivedAt,omitempty"`
+
+	// attributes
+	Attributes []*ProductAttribute `json:"attributes"`
+
+	// Path from the top-level category down to categoryId
+	Breadcrumbs []*CategoryRef `json:"breadcrumbs"`
+
 	// category Id
 	// Example: 5
 	// Required: true
@@ -25,6 +37,11 @@ type Product struct {
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"createdAt,omitempty"`
 
+	// currency
+	// Example: INR
+	// Required: true
+	Currency *string `json:"currency"`
+
 	// description
 	// Example: 22K pure gold necklace with intricate design
 	Description string `json:"description,omitempty"`
@@ -43,12 +60,15 @@ type Product struct {
 	// Required: true
 	Name *string `json:"name"`
 
-	// price
+	// Option axes the product varies along
+	Options []*ProductOption `json:"options"`
+
+	// Lowest variant price when the product has variants
 	// Example: 14999.99
 	// Required: true
 	Price *float32 `json:"price"`
 
-	// stock
+	// Total stock of all variants when the product has variants
 	// Example: 20
 	// Required: true
 	Stock *int64 `json:"stock"`
@@ -56,12 +76,32 @@ type Product struct {
 	// updated at
 	// Format: date-time
 	UpdatedAt strfmt.DateTime `json:"updatedAt,omitempty"`
+
+	// The variant matrix: one SKU per offered combination of option values
+	Variants []*ProductVariant `json:"variants"`
+
+	// Increases with every change; send it back with updateProduct
+	// Example: 3
+	// Required: true
+	Version *int64 `json:"version"`
 }
 
 // Validate validates this product
 func (m *Product) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateArchivedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAttributes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateBreadcrumbs(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCategoryID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -70,6 +110,10 @@ func (m *Product) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCurrency(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -78,6 +122,10 @@ func (m *Product) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateOptions(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePrice(formats); err != nil {
 		res = append(res, err)
 	}
@@ -90,12 +138,92 @@ func (m *Product) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateVariants(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *Product) validateArchivedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ArchivedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("archivedAt", "body", "date-time", m.ArchivedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Product) validateAttributes(formats strfmt.Registry) error {
+	if swag.IsZero(m.Attributes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Attributes); i++ {
+		if swag.IsZero(m.Attributes[i]) { // not required
+			continue
+		}
+
+		if m.Attributes[i] != nil {
+			if err := m.Attributes[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("attributes" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("attributes" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Product) validateBreadcrumbs(formats strfmt.Registry) error {
+	if swag.IsZero(m.Breadcrumbs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Breadcrumbs); i++ {
+		if swag.IsZero(m.Breadcrumbs[i]) { // not required
+			continue
+		}
+
+		if m.Breadcrumbs[i] != nil {
+			if err := m.Breadcrumbs[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("breadcrumbs" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("breadcrumbs" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Product) validateCategoryID(formats strfmt.Registry) error {
 
 	if err := validate.Required("categoryId", "body", m.CategoryID); err != nil {
@@ -117,6 +245,15 @@ func (m *Product) validateCreatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Product) validateCurrency(formats strfmt.Registry) error {
+
+	if err := validate.Required("currency", "body", m.Currency); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Product) validateID(formats strfmt.Registry) error {
 
 	if err := validate.Required("id", "body", m.ID); err != nil {
@@ -135,6 +272,36 @@ func (m *Product) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Product) validateOptions(formats strfmt.Registry) error {
+	if swag.IsZero(m.Options) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Options); i++ {
+		if swag.IsZero(m.Options[i]) { // not required
+			continue
+		}
+
+		if m.Options[i] != nil {
+			if err := m.Options[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Product) validatePrice(formats strfmt.Registry) error {
 
 	if err := validate.Required("price", "body", m.Price); err != nil {
@@ -165,8 +332,184 @@ func (m *Product) validateUpdatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this product based on context it is used
+func (m *Product) validateVariants(formats strfmt.Registry) error {
+	if swag.IsZero(m.Variants) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Variants); i++ {
+		if swag.IsZero(m.Variants[i]) { // not required
+			continue
+		}
+
+		if m.Variants[i] != nil {
+			if err := m.Variants[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("variants" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("variants" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Product) validateVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("version", "body", m.Version); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this product based on the context it is used
 func (m *Product) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAttributes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateBreadcrumbs(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateOptions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVariants(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Product) contextValidateAttributes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Attributes); i++ {
+
+		if m.Attributes[i] != nil {
+
+			if swag.IsZero(m.Attributes[i]) { // not required
+				return nil
+			}
+
+			if err := m.Attributes[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("attributes" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("attributes" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Product) contextValidateBreadcrumbs(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Breadcrumbs); i++ {
+
+		if m.Breadcrumbs[i] != nil {
+
+			if swag.IsZero(m.Breadcrumbs[i]) { // not required
+				return nil
+			}
+
+			if err := m.Breadcrumbs[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("breadcrumbs" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("breadcrumbs" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Product) contextValidateOptions(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Options); i++ {
+
+		if m.Options[i] != nil {
+
+			if swag.IsZero(m.Options[i]) { // not required
+				return nil
+			}
+
+			if err := m.Options[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Product) contextValidateVariants(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Variants); i++ {
+
+		if m.Variants[i] != nil {
+
+			if swag.IsZero(m.Variants[i]) { // not required
+				return nil
+			}
+
+			if err := m.Variants[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("variants" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("variants" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
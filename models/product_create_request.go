@@ -4,6 +4,8 @@ package models
 
 import (
 	"context"
+	stderrors "errors"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -16,26 +18,45 @@ import (
 // swagger:model ProductCreateRequest
 type ProductCreateRequest struct {
 
+	// Values for the attributes of the category and its parents
+	// Max Items: 50
+	Attributes []*ProductAttribute `json:"attributes"`
+
 	// category Id
 	// Required: true
+	// Minimum: 1
 	CategoryID *int64 `json:"categoryId"`
 
+	// ISO 4217 code; INR when omitted
+	// Example: INR
+	// Pattern: ^[A-Z]{3}$
+	Currency string `json:"currency,omitempty"`
+
 	// description
+	// Max Length: 5000
 	Description string `json:"description,omitempty"`
 
-	// images
+	// Absolute http(s) URLs, at most 10; the first is the cover
 	Images []string `json:"images"`
 
 	// name
 	// Required: true
+	// Max Length: 200
+	// Min Length: 1
 	Name *string `json:"name"`
 
+	// Option axes, such as Size, Metal and Purity
+	// Max Items: 5
+	Options []*ProductOption `json:"options"`
+
 	// price
 	// Required: true
+	// Minimum: > 0
 	Price *float32 `json:"price"`
 
 	// stock
 	// Required: true
+	// Minimum: 0
 	Stock *int64 `json:"stock"`
 }
 
@@ -43,14 +64,30 @@ type ProductCreateRequest struct {
 func (m *ProductCreateRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAttributes(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCategoryID(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateCurrency(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDescription(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateOptions(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePrice(formats); err != nil {
 		res = append(res, err)
 	}
@@ -65,12 +102,70 @@ func (m *ProductCreateRequest) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ProductCreateRequest) validateAttributes(formats strfmt.Registry) error {
+	if swag.IsZero(m.Attributes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Attributes); i++ {
+		if swag.IsZero(m.Attributes[i]) { // not required
+			continue
+		}
+
+		if m.Attributes[i] != nil {
+			if err := m.Attributes[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("attributes" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("attributes" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ProductCreateRequest) validateCategoryID(formats strfmt.Registry) error {
 
 	if err := validate.Required("categoryId", "body", m.CategoryID); err != nil {
 		return err
 	}
 
+	if err := validate.MinimumInt("categoryId", "body", *m.CategoryID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProductCreateRequest) validateCurrency(formats strfmt.Registry) error {
+	if swag.IsZero(m.Currency) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("currency", "body", m.Currency, `^[A-Z]{3}$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProductCreateRequest) validateDescription(formats strfmt.Registry) error {
+	if swag.IsZero(m.Description) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("description", "body", m.Description, 5000); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -80,6 +175,44 @@ func (m *ProductCreateRequest) validateName(formats strfmt.Registry) error {
 		return err
 	}
 
+	if err := validate.MinLength("name", "body", *m.Name, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("name", "body", *m.Name, 200); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProductCreateRequest) validateOptions(formats strfmt.Registry) error {
+	if swag.IsZero(m.Options) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Options); i++ {
+		if swag.IsZero(m.Options[i]) { // not required
+			continue
+		}
+
+		if m.Options[i] != nil {
+			if err := m.Options[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -89,6 +222,10 @@ func (m *ProductCreateRequest) validatePrice(formats strfmt.Registry) error {
 		return err
 	}
 
+	if err := validate.Minimum("price", "body", float64(*m.Price), 0, true); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -98,11 +235,86 @@ func (m *ProductCreateRequest) validateStock(formats strfmt.Registry) error {
 		return err
 	}
 
+	if err := validate.MinimumInt("stock", "body", *m.Stock, 0, false); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-// ContextValidate validates this product create request based on context it is used
+// ContextValidate validate this product create request based on the context it is used
 func (m *ProductCreateRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAttributes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateOptions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ProductCreateRequest) contextValidateAttributes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Attributes); i++ {
+
+		if m.Attributes[i] != nil {
+
+			if swag.IsZero(m.Attributes[i]) { // not required
+				return nil
+			}
+
+			if err := m.Attributes[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("attributes" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("attributes" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ProductCreateRequest) contextValidateOptions(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Options); i++ {
+
+		if m.Options[i] != nil {
+
+			if swag.IsZero(m.Options[i]) { // not required
+				return nil
+			}
+
+			if err := m.Options[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
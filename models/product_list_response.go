@@ -17,6 +17,9 @@ import (
 // swagger:model ProductListResponse
 type ProductListResponse struct {
 
+	// facets
+	Facets []*Facet `json:"facets"`
+
 	// items
 	Items []*Product `json:"items"`
 
@@ -31,12 +34,20 @@ type ProductListResponse struct {
 	// total
 	// Example: 100
 	Total int64 `json:"total,omitempty"`
+
+	// total pages
+	// Example: 5
+	TotalPages int64 `json:"totalPages,omitempty"`
 }
 
 // Validate validates this product list response
 func (m *ProductListResponse) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateFacets(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateItems(formats); err != nil {
 		res = append(res, err)
 	}
@@ -47,6 +58,36 @@ func (m *ProductListResponse) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ProductListResponse) validateFacets(formats strfmt.Registry) error {
+	if swag.IsZero(m.Facets) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Facets); i++ {
+		if swag.IsZero(m.Facets[i]) { // not required
+			continue
+		}
+
+		if m.Facets[i] != nil {
+			if err := m.Facets[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("facets" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("facets" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ProductListResponse) validateItems(formats strfmt.Registry) error {
 	if swag.IsZero(m.Items) { // not required
 		return nil
@@ -81,6 +122,10 @@ func (m *ProductListResponse) validateItems(formats strfmt.Registry) error {
 func (m *ProductListResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateFacets(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateItems(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -91,6 +136,35 @@ func (m *ProductListResponse) ContextValidate(ctx context.Context, formats strfm
 	return nil
 }
 
+func (m *ProductListResponse) contextValidateFacets(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Facets); i++ {
+
+		if m.Facets[i] != nil {
+
+			if swag.IsZero(m.Facets[i]) { // not required
+				return nil
+			}
+
+			if err := m.Facets[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("facets" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("facets" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *ProductListResponse) contextValidateItems(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Items); i++ {
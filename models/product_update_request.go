@@ -4,42 +4,326 @@ package models
 
 import (
 	"context"
+	stderrors "errors"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
-// ProductUpdateRequest Request to update product details.
+// ProductUpdateRequest Changes to a product. Omitted fields are left unchanged; images replaces the whole list. version must match the stored one.
 //
 // swagger:model ProductUpdateRequest
 type ProductUpdateRequest struct {
 
+	// Replaces all attribute values
+	// Max Items: 50
+	Attributes []*ProductAttribute `json:"attributes"`
+
 	// category Id
-	CategoryID int64 `json:"categoryId,omitempty"`
+	// Minimum: 1
+	CategoryID *int64 `json:"categoryId,omitempty"`
+
+	// currency
+	// Pattern: ^[A-Z]{3}$
+	Currency *string `json:"currency,omitempty"`
 
 	// description
-	Description string `json:"description,omitempty"`
+	// Max Length: 5000
+	Description *string `json:"description,omitempty"`
 
 	// images
 	Images []string `json:"images"`
 
 	// name
-	Name string `json:"name,omitempty"`
+	// Max Length: 200
+	// Min Length: 1
+	Name *string `json:"name,omitempty"`
+
+	// Replaces the option axes; every live variant must still fit them
+	// Max Items: 5
+	Options []*ProductOption `json:"options"`
 
-	// price
-	Price float32 `json:"price,omitempty"`
+	// Only for products without variants
+	// Minimum: > 0
+	Price *float32 `json:"price,omitempty"`
 
-	// stock
-	Stock int64 `json:"stock,omitempty"`
+	// Only for products without variants
+	// Minimum: 0
+	Stock *int64 `json:"stock,omitempty"`
+
+	// version of the product this change is based on
+	// Example: 3
+	// Required: true
+	Version *int64 `json:"version"`
 }
 
 // Validate validates this product update request
 func (m *ProductUpdateRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAttributes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCategoryID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCurrency(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDescription(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOptions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePrice(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStock(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ProductUpdateRequest) validateAttributes(formats strfmt.Registry) error {
+	if swag.IsZero(m.Attributes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Attributes); i++ {
+		if swag.IsZero(m.Attributes[i]) { // not required
+			continue
+		}
+
+		if m.Attributes[i] != nil {
+			if err := m.Attributes[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("attributes" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("attributes" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ProductUpdateRequest) validateCategoryID(formats strfmt.Registry) error {
+	if swag.IsZero(m.CategoryID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("categoryId", "body", *m.CategoryID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProductUpdateRequest) validateCurrency(formats strfmt.Registry) error {
+	if swag.IsZero(m.Currency) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("currency", "body", *m.Currency, `^[A-Z]{3}$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProductUpdateRequest) validateDescription(formats strfmt.Registry) error {
+	if swag.IsZero(m.Description) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("description", "body", *m.Description, 5000); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProductUpdateRequest) validateName(formats strfmt.Registry) error {
+	if swag.IsZero(m.Name) { // not required
+		return nil
+	}
+
+	if err := validate.MinLength("name", "body", *m.Name, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("name", "body", *m.Name, 200); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-// ContextValidate validates this product update request based on context it is used
+func (m *ProductUpdateRequest) validateOptions(formats strfmt.Registry) error {
+	if swag.IsZero(m.Options) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Options); i++ {
+		if swag.IsZero(m.Options[i]) { // not required
+			continue
+		}
+
+		if m.Options[i] != nil {
+			if err := m.Options[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ProductUpdateRequest) validatePrice(formats strfmt.Registry) error {
+	if swag.IsZero(m.Price) { // not required
+		return nil
+	}
+
+	if err := validate.Minimum("price", "body", float64(*m.Price), 0, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProductUpdateRequest) validateStock(formats strfmt.Registry) error {
+	if swag.IsZero(m.Stock) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("stock", "body", *m.Stock, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProductUpdateRequest) validateVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("version", "body", m.Version); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this product update request based on the context it is used
 func (m *ProductUpdateRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAttributes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateOptions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ProductUpdateRequest) contextValidateAttributes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Attributes); i++ {
+
+		if m.Attributes[i] != nil {
+
+			if swag.IsZero(m.Attributes[i]) { // not required
+				return nil
+			}
+
+			if err := m.Attributes[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("attributes" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("attributes" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ProductUpdateRequest) contextValidateOptions(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Options); i++ {
+
+		if m.Options[i] != nil {
+
+			if swag.IsZero(m.Options[i]) { // not required
+				return nil
+			}
+
+			if err := m.Options[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("options" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
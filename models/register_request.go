@@ -11,29 +11,31 @@ import (
 	"github.com/go-openapi/validate"
 )
 
-// RegisterRequest Payload to register a new user.
+// RegisterRequest Payload to register a new user. Either email and password, or phone and the code sent to it by sendOTP for a passwordless account.
 //
 // swagger:model RegisterRequest
 type RegisterRequest struct {
 
 	// email
 	// Example: paras@example.com
-	// Required: true
 	// Format: email
-	Email *strfmt.Email `json:"email"`
+	Email strfmt.Email `json:"email,omitempty"`
 
 	// name
 	// Example: Paras Jain
 	// Required: true
 	Name *string `json:"name"`
 
+	// Code sent to the phone by sendOTP; registers without a password
+	// Example: 123456
+	Otp string `json:"otp,omitempty"`
+
 	// password
 	// Example: strongPassword123
-	// Required: true
-	Password *string `json:"password"`
+	Password string `json:"password,omitempty"`
 
-	// phone
-	// Example: 919876543210
+	// Mobile number; national numbers are read in the default region and stored in E.164
+	// Example: +919876543210
 	Phone string `json:"phone,omitempty"`
 }
 
@@ -49,10 +51,6 @@ func (m *RegisterRequest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := m.validatePassword(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -60,9 +58,8 @@ func (m *RegisterRequest) Validate(formats strfmt.Registry) error {
 }
 
 func (m *RegisterRequest) validateEmail(formats strfmt.Registry) error {
-
-	if err := validate.Required("email", "body", m.Email); err != nil {
-		return err
+	if swag.IsZero(m.Email) { // not required
+		return nil
 	}
 
 	if err := validate.FormatOf("email", "body", "email", m.Email.String(), formats); err != nil {
@@ -81,15 +78,6 @@ func (m *RegisterRequest) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *RegisterRequest) validatePassword(formats strfmt.Registry) error {
-
-	if err := validate.Required("password", "body", m.Password); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // ContextValidate validates this register request based on context it is used
 func (m *RegisterRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
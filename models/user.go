@@ -20,12 +20,18 @@ type User struct {
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"createdAt,omitempty"`
 
+	// True while an admin has the account disabled
+	Disabled bool `json:"disabled,omitempty"`
+
 	// User email address
 	// Example: paras@example.com
 	// Required: true
 	// Format: email
 	Email *strfmt.Email `json:"email"`
 
+	// True once the email has been confirmed
+	EmailVerified bool `json:"emailVerified,omitempty"`
+
 	// Unique user identifier
 	// Example: 101
 	// Required: true
@@ -39,10 +45,28 @@ type User struct {
 	// Password of user
 	Password string `json:"password,omitempty"`
 
-	// User phone number
-	// Example: 919876543210
+	// New email waiting for confirmation from the link sent to it
+	// Format: email
+	PendingEmail strfmt.Email `json:"pendingEmail,omitempty"`
+
+	// New phone waiting for confirmation with the code sent to it
+	PendingPhone string `json:"pendingPhone,omitempty"`
+
+	// Mobile number in E.164
+	// Example: +919876543210
 	Phone string `json:"phone,omitempty"`
 
+	// ISO 3166-1 region of the phone number
+	// Example: IN
+	PhoneCountry string `json:"phoneCountry,omitempty"`
+
+	// True once the phone has been confirmed
+	PhoneVerified bool `json:"phoneVerified,omitempty"`
+
+	// Roles granted to the user (admin views only)
+	// Example: ["customer"]
+	Roles []string `json:"roles"`
+
 	// Last update timestamp
 	// Format: date-time
 	UpdatedAt strfmt.DateTime `json:"updatedAt,omitempty"`
@@ -68,6 +92,10 @@ func (m *User) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePendingEmail(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateUpdatedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -121,6 +149,18 @@ func (m *User) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *User) validatePendingEmail(formats strfmt.Registry) error {
+	if swag.IsZero(m.PendingEmail) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("pendingEmail", "body", "email", m.PendingEmail.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *User) validateUpdatedAt(formats strfmt.Registry) error {
 	if swag.IsZero(m.UpdatedAt) { // not required
 		return nil
@@ -24,10 +24,10 @@ type UserAccess struct {
 	Rev string `json:"_rev,omitempty" datastore:"-"`
 
 	// Base Role for this User
-	// Enum: ["user-admin","user-readonly","service-admin","service-readonly","disabled","pending"]
+	// Enum: ["admin","catalog-manager","support","customer"]
 	BaseRole string `json:"baseRole,omitempty" datastore:"baseRole"`
 
-	// Member of (Tenants)
+	// Roles granted to this User
 	MemberOf []string `json:"memberOf" datastore:"memberOf"`
 
 	// Notes for development (not used internally)
@@ -52,7 +52,7 @@ var userAccessTypeBaseRolePropEnum []any
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["user-admin","user-readonly","service-admin","service-readonly","disabled","pending"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["admin","catalog-manager","support","customer"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -62,23 +62,17 @@ func init() {
 
 const (
 
-	// UserAccessBaseRoleUserDashAdmin captures enum value "user-admin"
-	UserAccessBaseRoleUserDashAdmin string = "user-admin"
+	// UserAccessBaseRoleAdmin captures enum value "admin"
+	UserAccessBaseRoleAdmin string = "admin"
 
-	// UserAccessBaseRoleUserDashReadonly captures enum value "user-readonly"
-	UserAccessBaseRoleUserDashReadonly string = "user-readonly"
+	// UserAccessBaseRoleCatalogDashManager captures enum value "catalog-manager"
+	UserAccessBaseRoleCatalogDashManager string = "catalog-manager"
 
-	// UserAccessBaseRoleServiceDashAdmin captures enum value "service-admin"
-	UserAccessBaseRoleServiceDashAdmin string = "service-admin"
+	// UserAccessBaseRoleSupport captures enum value "support"
+	UserAccessBaseRoleSupport string = "support"
 
-	// UserAccessBaseRoleServiceDashReadonly captures enum value "service-readonly"
-	UserAccessBaseRoleServiceDashReadonly string = "service-readonly"
-
-	// UserAccessBaseRoleDisabled captures enum value "disabled"
-	UserAccessBaseRoleDisabled string = "disabled"
-
-	// UserAccessBaseRolePending captures enum value "pending"
-	UserAccessBaseRolePending string = "pending"
+	// UserAccessBaseRoleCustomer captures enum value "customer"
+	UserAccessBaseRoleCustomer string = "customer"
 )
 
 // prop value enum
@@ -5,26 +5,60 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
-// UserUpdateRequest Payload to update user profile.
+// UserUpdateRequest Partial update of the own profile. A new email or phone only takes effect once confirmed; a new password needs the current one.
 //
 // swagger:model UserUpdateRequest
 type UserUpdateRequest struct {
 
+	// current password
+	CurrentPassword string `json:"currentPassword,omitempty"`
+
+	// email
+	// Example: paras@example.com
+	// Format: email
+	Email strfmt.Email `json:"email,omitempty"`
+
 	// name
 	// Example: Paras Jain
 	Name string `json:"name,omitempty"`
 
+	// new password
+	NewPassword string `json:"newPassword,omitempty"`
+
 	// phone
-	// Example: 919876543210
+	// Example: +919876543210
 	Phone string `json:"phone,omitempty"`
 }
 
 // Validate validates this user update request
 func (m *UserUpdateRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEmail(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *UserUpdateRequest) validateEmail(formats strfmt.Registry) error {
+	if swag.IsZero(m.Email) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("email", "body", "email", m.Email.String(), formats); err != nil {
+		return err
+	}
+
 	return nil
 }
 
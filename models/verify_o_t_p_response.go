@@ -4,7 +4,9 @@ package models
 
 import (
 	"context"
+	stderrors "errors"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -18,18 +20,94 @@ type VerifyOTPResponse struct {
 	// Example: Login successful
 	Message string `json:"message,omitempty"`
 
+	// True when this login created the account
+	NewUser bool `json:"newUser,omitempty"`
+
+	// refresh token
+	// Example: refresh-token-here
+	RefreshToken string `json:"refreshToken,omitempty"`
+
 	// token
 	// Example: jwt-token-here
 	Token string `json:"token,omitempty"`
+
+	// user
+	User *User `json:"user,omitempty"`
 }
 
 // Validate validates this verify o t p response
 func (m *VerifyOTPResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateUser(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *VerifyOTPResponse) validateUser(formats strfmt.Registry) error {
+	if swag.IsZero(m.User) { // not required
+		return nil
+	}
+
+	if m.User != nil {
+		if err := m.User.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("user")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("user")
+			}
+
+			return err
+		}
+	}
+
 	return nil
 }
 
-// ContextValidate validates this verify o t p response based on context it is used
+// ContextValidate validate this verify o t p response based on the context it is used
 func (m *VerifyOTPResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateUser(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *VerifyOTPResponse) contextValidateUser(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.User != nil {
+
+		if swag.IsZero(m.User) { // not required
+			return nil
+		}
+
+		if err := m.User.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("user")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("user")
+			}
+
+			return err
+		}
+	}
+
 	return nil
 }
 
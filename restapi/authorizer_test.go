@@ -0,0 +1,80 @@
+package restapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime/middleware"
+
+	auth "Adornme/Auth"
+	"Adornme/models"
+	"Adornme/restapi/operations"
+)
+
+func TestAuthorizeAPIKeyScopes(t *testing.T) {
+	spec, err := loads.Analyzed(SwaggerJSON, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := operations.NewAdronmeCodeAPI(spec)
+	api.Init()
+
+	// The router puts the matched route on the request, as in the served API
+	var principal *models.Principal
+	var authErr error
+	router := middleware.NewRouter(api.Context(), http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		authErr = roleAuthorizer{}.Authorize(r, principal)
+		rw.WriteHeader(http.StatusOK)
+	}))
+
+	apiKey := func(scopes ...string) *models.Principal {
+		return &models.Principal{
+			UserID:     "apikey:1",
+			AuthID:     "ak_00000000",
+			UserAccess: &models.UserAccess{UserID: "apikey:1", BaseRole: auth.APIKeyBaseRole, MemberOf: scopes},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		principal *models.Principal
+		wantErr   bool
+	}{
+		{"read scope lists users", "GET", "/users", apiKey(auth.ScopeUsersRead), false},
+		{"read scope cannot update", "PUT", "/users/5", apiKey(auth.ScopeUsersRead), true},
+		{"write scope updates", "PUT", "/users/5", apiKey(auth.ScopeUsersWrite), false},
+		{"write scope does not imply read", "GET", "/users", apiKey(auth.ScopeUsersWrite), true},
+		{"products scope", "POST", "/products", apiKey(auth.ScopeProductsWrite), false},
+		{"users scope on products", "POST", "/products", apiKey(auth.ScopeUsersRead, auth.ScopeUsersWrite), true},
+		{"no scopes", "GET", "/users", apiKey(), true},
+		// Operations without a scope are closed to keys, even ones customers may call
+		{"unscoped operation", "GET", "/users/me", apiKey(auth.ScopeUsersRead, auth.ScopeUsersWrite, auth.ScopeProductsWrite), true},
+		{"keys cannot mint keys", "POST", "/api-keys", apiKey(auth.ScopeUsersWrite), true},
+		// Role names in an API key's MemberOf grant nothing
+		{"role as scope", "GET", "/users", apiKey(auth.RoleAdmin), true},
+		{"admin user", "GET", "/users", &models.Principal{UserID: "1", UserAccess: &models.UserAccess{UserID: "1", BaseRole: auth.RoleAdmin, MemberOf: []string{auth.RoleAdmin}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, authErr = tt.principal, nil
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("no route for %s %s: status %d", tt.method, tt.path, rec.Code)
+			}
+			err := authErr
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Authorize() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				if fe, ok := err.(*forbiddenError); !ok || fe.Code() != http.StatusForbidden {
+					t.Fatalf("Authorize() error = %#v, want a 403", err)
+				}
+			}
+		})
+	}
+}
@@ -3,23 +3,33 @@
 package restapi
 
 import (
+	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"net/http"
 
-	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
 
 	auth "Adornme/Auth"
+	"Adornme/config"
+	user "Adornme/controllers/users"
+	db "Adornme/databases"
 	"Adornme/handlers"
+	"Adornme/logging"
 	"Adornme/models"
 	"Adornme/restapi/operations"
+	"Adornme/restapi/operations/admin_api_keys"
+	"Adornme/restapi/operations/admin_categories"
 	"Adornme/restapi/operations/admin_products"
 	"Adornme/restapi/operations/admin_users"
 	"Adornme/restapi/operations/cart"
+	"Adornme/restapi/operations/categories"
 	"Adornme/restapi/operations/orders"
 	"Adornme/restapi/operations/payments"
+	"Adornme/restapi/operations/products"
 	"Adornme/restapi/operations/shipping"
 	"Adornme/restapi/operations/system"
 	"Adornme/restapi/operations/users"
@@ -32,9 +42,15 @@ func configureFlags(api *operations.AdronmeCodeAPI) {
 }
 
 func configureAPI(api *operations.AdronmeCodeAPI) http.Handler {
+	// Open the databases before any handler or auth check can use them
+	db.Connect()
+	if err := auth.LoadKeys(config.LoadConfig()); err != nil {
+		logs.Fatalf(context.Background(), "failed to load JWT signing keys: %v", err)
+	}
+	user.Init()
 
 	// configure the api here
-	api.ServeError = errors.ServeError
+	api.ServeError = serveError
 
 	// Set your custom logger if needed. Default one is log.Printf
 	// Expected interface func(string, ...interface{})
@@ -48,28 +64,58 @@ func configureAPI(api *operations.AdronmeCodeAPI) http.Handler {
 
 	api.JSONConsumer = runtime.JSONConsumer()
 
+	api.UrlformConsumer = runtime.DiscardConsumer
+
 	api.JSONProducer = runtime.JSONProducer()
 
 	// Applies when the "Authorization" header is set
 	api.BearerAuthAuth = func(token string) (*models.Principal, error) {
 		// Validate token
-		fmt.Println(token)
-		userID, err := auth.ValidateAccessToken(token)
+		claims, err := auth.ParseAccessToken(token)
+		if err != nil {
+			return nil, fmt.Errorf("invalid token: %w", err)
+		}
+
+		// Denylisted on logout, session revoke or password reset (one Redis lookup);
+		// when the lookup fails the token is refused rather than trusted
+		revoked, err := user.IsAccessTokenRevoked(context.Background(), claims.ID)
 		if err != nil {
 			return nil, fmt.Errorf("invalid token: %w", err)
 		}
+		if revoked {
+			return nil, errors.New("invalid token: revoked")
+		}
 
 		// Return a Principal object representing the logged-in user
-		return &models.Principal{
-			UserID: userID,
-		}, nil
+		principal := &models.Principal{
+			UserID:    claims.UserID,
+			SessionID: claims.SessionID,
+			UserAccess: &models.UserAccess{
+				UserID:   claims.UserID,
+				BaseRole: auth.BaseRole(claims.Roles),
+				MemberOf: claims.Roles,
+			},
+		}
+		// Support agent acting as the user (read-only, see roleAuthorizer)
+		if claims.Act != nil {
+			principal.ImpersonatorID = claims.Act.Subject
+		}
+		return principal, nil
 	}
 
-	// Set your custom authorizer if needed. Default one is security.Authorized()
-	// Expected interface runtime.Authorizer
-	//
-	// Example:
-	// api.APIAuthorizer = security.Authorized()
+	// X-API-Key: back-office integrations, same Principal shape as bearer auth
+	api.APIKeyAuth = func(token string) (*models.Principal, error) {
+		requestID := uuid.New().String()
+		ctx := logging.WithRequestID(context.Background(), requestID)
+		principal, err := user.NewUser(requestID, "en", requestID, "My-Service").AuthenticateAPIKey(ctx, token)
+		if err != nil {
+			return nil, errors.New("invalid api key")
+		}
+		return principal, nil
+	}
+
+	// Role checks per operation (see Auth/rbac.go)
+	api.APIAuthorizer = roleAuthorizer{}
 
 	if api.CartAddItemToCartHandler == nil {
 		api.CartAddItemToCartHandler = cart.AddItemToCartHandlerFunc(func(params cart.AddItemToCartParams, principal *models.Principal) middleware.Responder {
@@ -182,17 +228,128 @@ func configureAPI(api *operations.AdronmeCodeAPI) http.Handler {
 	api.UsersLoginUserHandler = users.LoginUserHandlerFunc(handlers.LoginUser)
 
 	api.SystemGetHealthHandler = system.GetHealthHandlerFunc(handlers.GetHealth)
+	api.SystemGetJWKSHandler = system.GetJWKSHandlerFunc(handlers.GetJWKS)
+
+	api.SystemSmsDeliveryStatusHandler = system.SmsDeliveryStatusHandlerFunc(handlers.SmsDeliveryStatus)
 
 	api.UsersLogoutUserHandler = users.LogoutUserHandlerFunc(handlers.LogoutUser)
 
+	api.UsersListUserSessionsHandler = users.ListUserSessionsHandlerFunc(handlers.ListUserSessions)
+
+	api.UsersRevokeUserSessionHandler = users.RevokeUserSessionHandlerFunc(handlers.RevokeUserSession)
+
+	api.UsersVerifyEmailHandler = users.VerifyEmailHandlerFunc(handlers.VerifyEmail)
+
+	api.UsersSendEmailVerificationHandler = users.SendEmailVerificationHandlerFunc(handlers.SendEmailVerification)
+
+	api.UsersSendPhoneVerificationHandler = users.SendPhoneVerificationHandlerFunc(handlers.SendPhoneVerification)
+
+	api.UsersVerifyPhoneHandler = users.VerifyPhoneHandlerFunc(handlers.VerifyPhone)
+
+	api.UsersUpdateUserProfileHandler = users.UpdateUserProfileHandlerFunc(handlers.UpdateUserProfile)
+
+	api.UsersConfirmEmailChangeHandler = users.ConfirmEmailChangeHandlerFunc(handlers.ConfirmEmailChange)
+
+	api.UsersConfirmPhoneChangeHandler = users.ConfirmPhoneChangeHandlerFunc(handlers.ConfirmPhoneChange)
+
+	api.UsersRequestDataExportHandler = users.RequestDataExportHandlerFunc(handlers.RequestDataExport)
+
+	api.UsersRequestErasureHandler = users.RequestErasureHandlerFunc(handlers.RequestErasure)
+
+	api.UsersGetPrivacyJobHandler = users.GetPrivacyJobHandlerFunc(handlers.GetPrivacyJob)
+
+	api.UsersBeginPasskeyRegistrationHandler = users.BeginPasskeyRegistrationHandlerFunc(handlers.BeginPasskeyRegistration)
+
+	api.UsersRegisterPasskeyHandler = users.RegisterPasskeyHandlerFunc(handlers.RegisterPasskey)
+
+	api.UsersListPasskeysHandler = users.ListPasskeysHandlerFunc(handlers.ListPasskeys)
+
+	api.UsersDeletePasskeyHandler = users.DeletePasskeyHandlerFunc(handlers.DeletePasskey)
+
+	api.AdminUsersUnlockUserHandler = admin_users.UnlockUserHandlerFunc(handlers.UnlockUser)
+
+	api.AdminUsersImpersonateUserHandler = admin_users.ImpersonateUserHandlerFunc(handlers.ImpersonateUser)
+
+	api.AdminUsersListUsersHandler = admin_users.ListUsersHandlerFunc(handlers.ListUsers)
+
+	api.AdminUsersGetUserHandler = admin_users.GetUserHandlerFunc(handlers.GetUser)
+
+	api.AdminUsersUpdateUserHandler = admin_users.UpdateUserHandlerFunc(handlers.UpdateUser)
+
+	api.AdminUsersDisableUserHandler = admin_users.DisableUserHandlerFunc(handlers.DisableUser)
+
+	api.AdminUsersEnableUserHandler = admin_users.EnableUserHandlerFunc(handlers.EnableUser)
+
+	api.AdminUsersDeleteUserHandler = admin_users.DeleteUserHandlerFunc(handlers.DeleteUser)
+
+	api.UsersVerifyMFAHandler = users.VerifyMFAHandlerFunc(handlers.VerifyMFA)
+
+	api.UsersEnrollMFAHandler = users.EnrollMFAHandlerFunc(handlers.EnrollMFA)
+
+	api.UsersStartMFAEnrollmentHandler = users.StartMFAEnrollmentHandlerFunc(handlers.StartMFAEnrollment)
+
+	api.UsersConfirmMFAHandler = users.ConfirmMFAHandlerFunc(handlers.ConfirmMFA)
+
+	api.UsersDisableMFAHandler = users.DisableMFAHandlerFunc(handlers.DisableMFA)
+
+	api.AdminAPIKeysListAPIKeysHandler = admin_api_keys.ListAPIKeysHandlerFunc(handlers.ListAPIKeys)
+
+	api.AdminAPIKeysCreateAPIKeyHandler = admin_api_keys.CreateAPIKeyHandlerFunc(handlers.CreateAPIKey)
+
+	api.AdminAPIKeysRevokeAPIKeyHandler = admin_api_keys.RevokeAPIKeyHandlerFunc(handlers.RevokeAPIKey)
+
 	api.UsersIdentifyUserHandler = users.IdentifyUserHandlerFunc(handlers.IdentifyUser)
 
+	api.UsersOTPLoginHandler = users.OTPLoginHandlerFunc(handlers.SendOTP)
+
+	api.UsersResendOTPHandler = users.ResendOTPHandlerFunc(handlers.ResendOTP)
+
+	api.UsersVerifyOTPHandler = users.VerifyOTPHandlerFunc(handlers.VerifyOTP)
+
 	api.UsersForgetPasswordHandler = users.ForgetPasswordHandlerFunc(handlers.ForgetPassword)
-	if api.UsersResetPasswordHandler == nil {
-		api.UsersResetPasswordHandler = users.ResetPasswordHandlerFunc(func(params users.ResetPasswordParams) middleware.Responder {
-			return middleware.NotImplemented("operation users.ResetPassword has not yet been implemented")
-		})
-	}
+
+	api.UsersResetPasswordHandler = users.ResetPasswordHandlerFunc(handlers.ResetPassword)
+
+	api.UsersSendMagicLinkHandler = users.SendMagicLinkHandlerFunc(handlers.SendMagicLink)
+
+	api.UsersVerifyMagicLinkHandler = users.VerifyMagicLinkHandlerFunc(handlers.VerifyMagicLink)
+
+	api.UsersBeginPasskeyLoginHandler = users.BeginPasskeyLoginHandlerFunc(handlers.BeginPasskeyLogin)
+
+	api.UsersLoginWithPasskeyHandler = users.LoginWithPasskeyHandlerFunc(handlers.LoginWithPasskey)
+
+	api.ProductsListProductsHandler = products.ListProductsHandlerFunc(handlers.ListProducts)
+
+	api.ProductsGetProductHandler = products.GetProductHandlerFunc(handlers.GetProduct)
+
+	api.AdminProductsCreateProductHandler = admin_products.CreateProductHandlerFunc(handlers.CreateProduct)
+
+	api.AdminProductsUpdateProductHandler = admin_products.UpdateProductHandlerFunc(handlers.UpdateProduct)
+
+	api.AdminProductsDeleteProductHandler = admin_products.DeleteProductHandlerFunc(handlers.DeleteProduct)
+
+	api.AdminProductsCreateVariantHandler = admin_products.CreateVariantHandlerFunc(handlers.CreateVariant)
+
+	api.AdminProductsUpdateVariantHandler = admin_products.UpdateVariantHandlerFunc(handlers.UpdateVariant)
+
+	api.AdminProductsDeleteVariantHandler = admin_products.DeleteVariantHandlerFunc(handlers.DeleteVariant)
+
+	api.CategoriesListCategoriesHandler = categories.ListCategoriesHandlerFunc(handlers.ListCategories)
+
+	api.AdminCategoriesCreateCategoryHandler = admin_categories.CreateCategoryHandlerFunc(handlers.CreateCategory)
+
+	api.AdminCategoriesUpdateCategoryHandler = admin_categories.UpdateCategoryHandlerFunc(handlers.UpdateCategory)
+
+	api.AdminCategoriesDeleteCategoryHandler = admin_categories.DeleteCategoryHandlerFunc(handlers.DeleteCategory)
+
+	api.CategoriesListCategoryAttributesHandler = categories.ListCategoryAttributesHandlerFunc(handlers.ListCategoryAttributes)
+
+	api.AdminCategoriesCreateCategoryAttributeHandler = admin_categories.CreateCategoryAttributeHandlerFunc(handlers.CreateCategoryAttribute)
+
+	api.AdminCategoriesUpdateCategoryAttributeHandler = admin_categories.UpdateCategoryAttributeHandlerFunc(handlers.UpdateCategoryAttribute)
+
+	api.AdminCategoriesDeleteCategoryAttributeHandler = admin_categories.DeleteCategoryAttributeHandlerFunc(handlers.DeleteCategoryAttribute)
+
 	if api.ShippingTrackShipmentHandler == nil {
 		api.ShippingTrackShipmentHandler = shipping.TrackShipmentHandlerFunc(func(params shipping.TrackShipmentParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation shipping.TrackShipment has not yet been implemented")
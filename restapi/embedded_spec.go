@@ -24,6 +24,196 @@ func init() {
     "version": "1.0.0"
   },
   "paths": {
+    "/.well-known/jwks.json": {
+      "get": {
+        "description": "JSON Web Key Set with every key that currently validates access and refresh tokens. Retired keys are dropped from the set once they stop validating.\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "System"
+        ],
+        "summary": "Public keys used to sign tokens",
+        "operationId": "getJWKS",
+        "responses": {
+          "200": {
+            "description": "Active signing keys",
+            "schema": {
+              "$ref": "#/definitions/JWKSet"
+            }
+          }
+        }
+      }
+    },
+    "/api-keys": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminAPIKeys"
+        ],
+        "summary": "List API keys",
+        "operationId": "listAPIKeys",
+        "responses": {
+          "200": {
+            "description": "API keys, newest first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/APIKey"
+              }
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      },
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminAPIKeys"
+        ],
+        "summary": "Issue an API key",
+        "operationId": "createAPIKey",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/APIKeyCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "API key created",
+            "schema": {
+              "$ref": "#/definitions/APIKeyCreated"
+            }
+          },
+          "400": {
+            "description": "Invalid name, scope or expiry",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/api-keys/{id}": {
+      "delete": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminAPIKeys"
+        ],
+        "summary": "Revoke an API key",
+        "operationId": "revokeAPIKey",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "API key revoked"
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "API key not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/auth/confirm-email-change": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Switch to a new email with the token from the link sent to it",
+        "operationId": "confirmEmailChange",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VerifyEmailRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Email changed",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid or expired token",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Email already in use",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
     "/auth/forgot-password": {
       "post": {
         "description": "Sends a reset password link to the user's email",
@@ -121,6 +311,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "429": {
+            "description": "Too many requests from this client",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         }
       }
@@ -155,11 +351,23 @@ func init() {
               "$ref": "#/definitions/AuthResponse"
             }
           },
+          "202": {
+            "description": "Password accepted, second factor required",
+            "schema": {
+              "$ref": "#/definitions/MFAChallenge"
+            }
+          },
           "401": {
             "description": "Invalid credentials",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "429": {
+            "description": "Too many failed attempts, account or client temporarily locked",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         }
       }
@@ -187,8 +395,9 @@ func init() {
         }
       }
     },
-    "/auth/otp/resend": {
+    "/auth/magic-link": {
       "post": {
+        "description": "Emails a short-lived, single-use sign-in link. The response is the same whether or not the account exists.",
         "consumes": [
           "application/json"
         ],
@@ -198,25 +407,41 @@ func init() {
         "tags": [
           "Users"
         ],
-        "summary": "Resend OTP",
+        "summary": "Send a magic sign-in link",
+        "operationId": "sendMagicLink",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SendOTPRequest"
+              "$ref": "#/definitions/MagicLinkRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "OTP resent"
+            "description": "Link sent (if the account exists)",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid email or device id",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many links requested for this email or from this client",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         }
       }
     },
-    "/auth/otp/send": {
+    "/auth/magic-link/verify": {
       "post": {
         "consumes": [
           "application/json"
@@ -227,27 +452,39 @@ func init() {
         "tags": [
           "Users"
         ],
-        "summary": "Send OTP to email or phone",
-        "operationId": "OTPLogin",
+        "summary": "Sign in with a magic link",
+        "operationId": "verifyMagicLink",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SendOTPRequest"
+              "$ref": "#/definitions/MagicLinkVerifyRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "OTP sent response",
+            "description": "Signed in",
             "schema": {
-              "$ref": "#/definitions/GenericResponse"
+              "$ref": "#/definitions/AuthResponse"
             }
           },
-          "400": {
-            "description": "Invalid identifier",
+          "202": {
+            "description": "Link accepted, second factor required",
+            "schema": {
+              "$ref": "#/definitions/MFAChallenge"
+            }
+          },
+          "401": {
+            "description": "Link invalid, expired or already used",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Link was requested from another device",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -255,7 +492,7 @@ func init() {
         }
       }
     },
-    "/auth/otp/verify": {
+    "/auth/mfa/enroll": {
       "post": {
         "consumes": [
           "application/json"
@@ -266,24 +503,33 @@ func init() {
         "tags": [
           "Users"
         ],
-        "summary": "Verify OTP and login user",
-        "operationId": "VerifyOTP",
+        "summary": "Enrol an authenticator during a login that requires MFA",
+        "operationId": "enrollMFA",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/VerifyOTPRequest"
+              "$ref": "#/definitions/MFAEnrollRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful login"
+            "description": "Enrolment started, confirm it via /auth/mfa/verify",
+            "schema": {
+              "$ref": "#/definitions/MFAEnrollment"
+            }
+          },
+          "400": {
+            "description": "MFA already enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
-            "description": "Invalid OTP",
+            "description": "Invalid or expired token",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -291,7 +537,7 @@ func init() {
         }
       }
     },
-    "/auth/refresh-token": {
+    "/auth/mfa/verify": {
       "post": {
         "consumes": [
           "application/json"
@@ -302,27 +548,33 @@ func init() {
         "tags": [
           "Users"
         ],
-        "summary": "Refresh authentication token",
-        "operationId": "refreshToken",
+        "summary": "Complete a login with an authenticator or recovery code",
+        "operationId": "verifyMFA",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/RefreshTokenRequest"
+              "$ref": "#/definitions/MFAVerifyRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Token refreshed successfully",
+            "description": "Login successful",
             "schema": {
               "$ref": "#/definitions/AuthResponse"
             }
           },
-          "400": {
-            "description": "Invalid refresh token",
+          "401": {
+            "description": "Invalid or expired token or code",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many wrong codes, log in again",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -330,7 +582,7 @@ func init() {
         }
       }
     },
-    "/auth/register": {
+    "/auth/otp/resend": {
       "post": {
         "consumes": [
           "application/json"
@@ -341,27 +593,33 @@ func init() {
         "tags": [
           "Users"
         ],
-        "summary": "Register a new user",
-        "operationId": "registerUser",
+        "summary": "Resend OTP",
+        "operationId": "resendOTP",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/RegisterRequest"
+              "$ref": "#/definitions/SendOTPRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "User registered successfully",
+          "200": {
+            "description": "OTP resent",
             "schema": {
-              "$ref": "#/definitions/AuthResponse"
+              "$ref": "#/definitions/GenericResponse"
             }
           },
           "400": {
-            "description": "Validation error",
+            "description": "Invalid identifier",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "An OTP was sent recently, retry after the cooldown",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -369,281 +627,376 @@ func init() {
         }
       }
     },
-    "/auth/reset-password": {
+    "/auth/otp/send": {
       "post": {
         "consumes": [
           "application/json"
         ],
+        "produces": [
+          "application/json"
+        ],
         "tags": [
           "Users"
         ],
-        "summary": "Reset password",
-        "operationId": "resetPassword",
+        "summary": "Send OTP to email or phone",
+        "operationId": "OTPLogin",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ResetPasswordRequest"
+              "$ref": "#/definitions/SendOTPRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Password reset successful"
+            "description": "OTP sent response",
+            "schema": {
+              "$ref": "#/definitions/GenericResponse"
+            }
           },
           "400": {
-            "description": "Invalid or expired token",
+            "description": "Invalid identifier",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          }
+          },
+          "429": {
+            "description": "A code was sent recently or too many requests from this client",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
       }
     },
-    "/cart": {
-      "get": {
+    "/auth/otp/verify": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "Cart"
+          "Users"
+        ],
+        "summary": "Verify OTP and login user",
+        "operationId": "VerifyOTP",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VerifyOTPRequest"
+            }
+          }
         ],
-        "summary": "Get current user's cart",
-        "operationId": "getCart",
         "responses": {
           "200": {
-            "description": "Current shopping cart",
+            "description": "Successful login",
             "schema": {
-              "$ref": "#/definitions/Cart"
+              "$ref": "#/definitions/VerifyOTPResponse"
+            }
+          },
+          "202": {
+            "description": "Code accepted, second factor required",
+            "schema": {
+              "$ref": "#/definitions/MFAChallenge"
+            }
+          },
+          "400": {
+            "description": "Invalid identifier",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
-            "description": "Unauthorized",
+            "description": "Invalid or expired OTP",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many wrong attempts, request a new OTP",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
-      },
-      "put": {
+        }
+      }
+    },
+    "/auth/passkey/login": {
+      "post": {
+        "description": "Signs in with a passkey. Without mfaToken the authenticator must verify the user (biometric or PIN), which also satisfies MFA.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "Cart"
+          "Users"
         ],
-        "summary": "Update item quantity in cart",
-        "operationId": "updateCartItem",
+        "summary": "Finish a passkey login",
+        "operationId": "loginWithPasskey",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/CartItemUpdateRequest"
+              "$ref": "#/definitions/PasskeyLoginRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Cart updated",
+            "description": "Login successful",
             "schema": {
-              "$ref": "#/definitions/Cart"
+              "$ref": "#/definitions/AuthResponse"
+            }
+          },
+          "401": {
+            "description": "Passkey not recognised, assertion rejected or ceremony expired",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "security": [
+        }
+      }
+    },
+    "/auth/passkey/options": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Start a passkey login",
+        "operationId": "beginPasskeyLogin",
+        "parameters": [
           {
-            "bearerAuth": []
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PasskeyLoginOptionsRequest"
+            }
           }
-        ]
-      },
+        ],
+        "responses": {
+          "200": {
+            "description": "Request options for navigator.credentials.get()",
+            "schema": {
+              "$ref": "#/definitions/PasskeyOptions"
+            }
+          },
+          "401": {
+            "description": "Invalid or expired mfaToken",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many requests from this client",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/refresh-token": {
       "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "Cart"
+          "Users"
         ],
-        "summary": "Add item to cart",
-        "operationId": "addItemToCart",
+        "summary": "Refresh authentication token",
+        "operationId": "refreshToken",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/CartItemRequest"
+              "$ref": "#/definitions/RefreshTokenRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Item added to cart",
+            "description": "Token refreshed successfully",
             "schema": {
-              "$ref": "#/definitions/Cart"
+              "$ref": "#/definitions/AuthResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid refresh token",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "security": [
+        }
+      }
+    },
+    "/auth/register": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Register a new user",
+        "operationId": "registerUser",
+        "parameters": [
           {
-            "bearerAuth": []
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RegisterRequest"
+            }
           }
-        ]
-      },
-      "delete": {
-        "tags": [
-          "Cart"
         ],
-        "summary": "Clear cart",
-        "operationId": "clearCart",
         "responses": {
-          "204": {
-            "description": "Cart cleared"
+          "201": {
+            "description": "User registered successfully",
+            "schema": {
+              "$ref": "#/definitions/AuthResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Email or phone already in use",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many wrong attempts, request a new code",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        },
-        "security": [
+        }
+      }
+    },
+    "/auth/reset-password": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Reset password",
+        "operationId": "resetPassword",
+        "parameters": [
           {
-            "bearerAuth": []
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ResetPasswordRequest"
+            }
           }
-        ]
+        ],
+        "responses": {
+          "200": {
+            "description": "Password reset successful",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid or expired token, or password rejected by policy",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
       }
     },
-    "/health": {
-      "get": {
-        "description": "Returns the overall system health status along with the health, uptime, and latency of individual dependencies like Redis, MongoDB, PostgreSQL, OpenSearch, and MinIO.\n",
+    "/auth/verify-email": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "System"
+          "Users"
         ],
-        "summary": "Health check endpoint",
-        "operationId": "getHealth",
-        "responses": {
-          "200": {
-            "description": "Adornme Service health details",
+        "summary": "Confirm an email address with the link token",
+        "operationId": "verifyEmail",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "dependencies": {
-                  "type": "object",
-                  "additionalProperties": {
-                    "type": "object",
-                    "properties": {
-                      "errorMessage": {
-                        "description": "Error while checking dependencies",
-                        "type": "string",
-                        "example": "errorMessage"
-                      },
-                      "lastChecked": {
-                        "description": "Time when the dependency was last checked",
-                        "type": "string",
-                        "format": "date-time",
-                        "example": "2025-11-09T17:25:30Z"
-                      },
-                      "latencyMs": {
-                        "description": "Response latency in milliseconds",
-                        "type": "number",
-                        "format": "float",
-                        "example": 12.34
-                      },
-                      "status": {
-                        "description": "Health status of the dependency",
-                        "type": "string",
-                        "example": "healthy"
-                      },
-                      "uptime": {
-                        "description": "How long the dependency has been up",
-                        "type": "string",
-                        "example": "48h12m45s"
-                      }
-                    }
-                  },
-                  "example": {
-                    "minio": {
-                      "lastChecked": "2025-11-09T17:25:30Z",
-                      "latencyMs": 7.3,
-                      "status": "healthy",
-                      "uptime": "60h08m17s"
-                    },
-                    "mongodb": {
-                      "lastChecked": "2025-11-09T17:25:30Z",
-                      "latencyMs": 5.1,
-                      "status": "healthy",
-                      "uptime": "35h59m11s"
-                    },
-                    "opensearch": {
-                      "lastChecked": "2025-11-09T17:25:30Z",
-                      "latencyMs": 4.6,
-                      "status": "healthy",
-                      "uptime": "71h44m21s"
-                    },
-                    "postgres": {
-                      "lastChecked": "2025-11-09T17:25:30Z",
-                      "latencyMs": 3.8,
-                      "status": "healthy",
-                      "uptime": "72h14m03s"
-                    },
-                    "redis": {
-                      "lastChecked": "2025-11-09T17:25:30Z",
-                      "latencyMs": 2.4,
-                      "status": "healthy",
-                      "uptime": "36h42m01s"
-                    }
-                  }
-                },
-                "description": {
-                  "description": "Human-readable summary of the system health",
-                  "type": "string",
-                  "example": "All dependencies are healthy and running smoothly"
-                },
-                "status": {
-                  "description": "Overall system status (ok, degraded, or down)",
-                  "type": "string",
-                  "example": "ok"
-                },
-                "timestamp": {
-                  "description": "UTC timestamp when the health check was performed",
-                  "type": "string",
-                  "format": "date-time",
-                  "example": "2025-11-09T17:27:57Z"
-                },
-                "uptime": {
-                  "description": "Application uptime since last start",
-                  "type": "string",
-                  "example": "72h35m10s"
-                }
-              }
+              "$ref": "#/definitions/VerifyEmailRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Email verified",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid or expired token",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         }
       }
     },
-    "/orders": {
+    "/cart": {
       "get": {
         "tags": [
-          "Orders"
-        ],
-        "summary": "List user's orders",
-        "operationId": "listOrders",
-        "parameters": [
-          {
-            "type": "integer",
-            "default": 1,
-            "name": "page",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "default": 10,
-            "name": "limit",
-            "in": "query"
-          }
+          "Cart"
         ],
+        "summary": "Get current user's cart",
+        "operationId": "getCart",
         "responses": {
           "200": {
-            "description": "Paginated list of orders",
+            "description": "Current shopping cart",
             "schema": {
-              "$ref": "#/definitions/OrderListResponse"
+              "$ref": "#/definitions/Cart"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
@@ -653,33 +1006,27 @@ func init() {
           }
         ]
       },
-      "post": {
+      "put": {
         "tags": [
-          "Orders"
+          "Cart"
         ],
-        "summary": "Place an order from cart",
-        "operationId": "placeOrder",
+        "summary": "Update item quantity in cart",
+        "operationId": "updateCartItem",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/OrderCreateRequest"
+              "$ref": "#/definitions/CartItemUpdateRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Order placed successfully",
-            "schema": {
-              "$ref": "#/definitions/Order"
-            }
-          },
-          "400": {
-            "description": "Invalid order request",
+          "200": {
+            "description": "Cart updated",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Cart"
             }
           }
         },
@@ -688,34 +1035,28 @@ func init() {
             "bearerAuth": []
           }
         ]
-      }
-    },
-    "/orders/{id}": {
-      "get": {
+      },
+      "post": {
         "tags": [
-          "Orders"
+          "Cart"
         ],
-        "summary": "Get order details",
-        "operationId": "getOrder",
+        "summary": "Add item to cart",
+        "operationId": "addItemToCart",
         "parameters": [
           {
-            "type": "integer",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CartItemRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Order details",
-            "schema": {
-              "$ref": "#/definitions/Order"
-            }
-          },
-          "404": {
-            "description": "Order not found",
+            "description": "Item added to cart",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Cart"
             }
           }
         },
@@ -724,34 +1065,97 @@ func init() {
             "bearerAuth": []
           }
         ]
+      },
+      "delete": {
+        "tags": [
+          "Cart"
+        ],
+        "summary": "Clear cart",
+        "operationId": "clearCart",
+        "responses": {
+          "204": {
+            "description": "Cart cleared"
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "/payments/initiate": {
+    "/categories": {
+      "get": {
+        "description": "All categories as a tree, top level first, siblings ordered by position. Served from cache.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Categories"
+        ],
+        "summary": "Category tree",
+        "operationId": "listCategories",
+        "responses": {
+          "200": {
+            "description": "Top-level categories with their subcategories",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/CategoryNode"
+              }
+            }
+          }
+        }
+      },
       "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "Payments"
+          "AdminCategories"
         ],
-        "summary": "Initiate a new payment",
-        "operationId": "initiatePayment",
+        "summary": "Create a category",
+        "operationId": "createCategory",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/PaymentInitiateRequest"
+              "$ref": "#/definitions/CategoryCreateRequest"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Payment initiated",
+            "description": "Category created",
             "schema": {
-              "$ref": "#/definitions/PaymentInitiateResponse"
+              "$ref": "#/definitions/Category"
             }
           },
           "400": {
-            "description": "Invalid request",
+            "description": "Validation error or unknown parent",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Slug already in use",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -760,34 +1164,75 @@ func init() {
         "security": [
           {
             "bearerAuth": []
+          },
+          {
+            "apiKey": []
           }
         ]
       }
     },
-    "/payments/{id}": {
-      "get": {
-        "tags": [
-          "Payments"
+    "/categories/{id}": {
+      "put": {
+        "consumes": [
+          "application/json"
         ],
-        "summary": "Get payment details",
-        "operationId": "getPayment",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminCategories"
+        ],
+        "summary": "Update or move a category",
+        "operationId": "updateCategory",
         "parameters": [
           {
-            "type": "string",
+            "type": "integer",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CategoryUpdateRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Payment details",
+            "description": "Category updated",
             "schema": {
-              "$ref": "#/definitions/Payment"
+              "$ref": "#/definitions/Category"
+            }
+          },
+          "400": {
+            "description": "Validation error, unknown parent or move into its own subtree",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "404": {
-            "description": "Payment not found",
+            "description": "Category not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Slug already in use",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -796,141 +1241,195 @@ func init() {
         "security": [
           {
             "bearerAuth": []
+          },
+          {
+            "apiKey": []
           }
         ]
-      }
-    },
-    "/payments/{id}/confirm": {
-      "post": {
+      },
+      "delete": {
+        "description": "Only empty categories can be deleted: no subcategories and no live products.",
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "Payments"
+          "AdminCategories"
         ],
-        "summary": "Confirm a payment",
-        "operationId": "confirmPayment",
+        "summary": "Delete a category",
+        "operationId": "deleteCategory",
         "parameters": [
           {
-            "type": "string",
+            "type": "integer",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/PaymentConfirmRequest"
-            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Payment confirmed",
+          "204": {
+            "description": "Category deleted"
+          },
+          "401": {
+            "description": "Unauthorized",
             "schema": {
-              "$ref": "#/definitions/Payment"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "400": {
-            "description": "Invalid confirmation data",
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Category not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Category still has subcategories or products",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
       }
     },
-    "/payments/{id}/refund": {
-      "post": {
+    "/categories/{id}/attributes": {
+      "get": {
+        "description": "Attributes products in the category can have: its own and those inherited from its parents, ordered by position.",
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "Payments"
+          "Categories"
         ],
-        "summary": "Refund a payment",
-        "operationId": "refundPayment",
+        "summary": "Attributes of a category",
+        "operationId": "listCategoryAttributes",
         "parameters": [
           {
-            "type": "string",
+            "type": "integer",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/RefundRequest"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Refund processed",
-            "schema": {
-              "$ref": "#/definitions/Payment"
-            }
-          },
-          "400": {
-            "description": "Invalid refund request",
+            "description": "Attribute definitions",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/AttributeDefinition"
+              }
             }
           },
           "404": {
-            "description": "Payment not found",
+            "description": "Category not found",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
-      }
-    },
-    "/products": {
+        }
+      },
       "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "AdminProducts"
+          "AdminCategories"
         ],
-        "summary": "Create a new product",
-        "operationId": "createProduct",
+        "summary": "Define an attribute on a category",
+        "operationId": "createCategoryAttribute",
         "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ProductCreateRequest"
+              "$ref": "#/definitions/AttributeDefinitionCreateRequest"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Product created successfully"
+            "description": "Attribute defined",
+            "schema": {
+              "$ref": "#/definitions/AttributeDefinition"
+            }
           },
           "400": {
-            "description": "Validation error"
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
-            "description": "Unauthorized"
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Category not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The category already defines this code",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "security": [
           {
             "bearerAuth": []
+          },
+          {
+            "apiKey": []
           }
         ]
       }
     },
-    "/products/{id}": {
+    "/categories/{id}/attributes/{code}": {
       "put": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "AdminProducts"
+          "AdminCategories"
         ],
-        "summary": "Update a product",
-        "operationId": "updateProduct",
+        "summary": "Update an attribute definition",
+        "operationId": "updateCategoryAttribute",
         "parameters": [
           {
             "type": "integer",
@@ -938,71 +1437,257 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "name": "code",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ProductUpdateRequest"
+              "$ref": "#/definitions/AttributeDefinitionUpdateRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Product updated"
+            "description": "Attribute updated",
+            "schema": {
+              "$ref": "#/definitions/AttributeDefinition"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Category or attribute not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "security": [
           {
             "bearerAuth": []
+          },
+          {
+            "apiKey": []
           }
         ]
       },
       "delete": {
+        "description": "Products keep their stored values until they are next saved, when values no longer defined are dropped.",
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "AdminProducts"
+          "AdminCategories"
         ],
-        "summary": "Delete a product",
-        "operationId": "deleteProduct",
+        "summary": "Remove an attribute definition",
+        "operationId": "deleteCategoryAttribute",
         "parameters": [
           {
             "type": "integer",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "code",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "204": {
-            "description": "Product deleted"
+            "description": "Attribute removed"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Category or attribute not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "security": [
           {
             "bearerAuth": []
+          },
+          {
+            "apiKey": []
           }
         ]
       }
     },
-    "/shipping/addresses": {
+    "/health": {
       "get": {
+        "description": "Returns the overall system health status along with the health, uptime, and latency of individual dependencies like Redis, MongoDB, PostgreSQL, OpenSearch, and MinIO.\n",
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "Shipping"
+          "System"
         ],
-        "summary": "Get all addresses for logged-in user",
-        "operationId": "listShippingAddresses",
+        "summary": "Health check endpoint",
+        "operationId": "getHealth",
         "responses": {
           "200": {
-            "description": "List of user addresses",
+            "description": "Adornme Service health details",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Address"
+              "type": "object",
+              "properties": {
+                "dependencies": {
+                  "type": "object",
+                  "additionalProperties": {
+                    "type": "object",
+                    "properties": {
+                      "errorMessage": {
+                        "description": "Error while checking dependencies",
+                        "type": "string",
+                        "example": "errorMessage"
+                      },
+                      "lastChecked": {
+                        "description": "Time when the dependency was last checked",
+                        "type": "string",
+                        "format": "date-time",
+                        "example": "2025-11-09T17:25:30Z"
+                      },
+                      "latencyMs": {
+                        "description": "Response latency in milliseconds",
+                        "type": "number",
+                        "format": "float",
+                        "example": 12.34
+                      },
+                      "status": {
+                        "description": "Health status of the dependency",
+                        "type": "string",
+                        "example": "healthy"
+                      },
+                      "uptime": {
+                        "description": "How long the dependency has been up",
+                        "type": "string",
+                        "example": "48h12m45s"
+                      }
+                    }
+                  },
+                  "example": {
+                    "minio": {
+                      "lastChecked": "2025-11-09T17:25:30Z",
+                      "latencyMs": 7.3,
+                      "status": "healthy",
+                      "uptime": "60h08m17s"
+                    },
+                    "mongodb": {
+                      "lastChecked": "2025-11-09T17:25:30Z",
+                      "latencyMs": 5.1,
+                      "status": "healthy",
+                      "uptime": "35h59m11s"
+                    },
+                    "opensearch": {
+                      "lastChecked": "2025-11-09T17:25:30Z",
+                      "latencyMs": 4.6,
+                      "status": "healthy",
+                      "uptime": "71h44m21s"
+                    },
+                    "postgres": {
+                      "lastChecked": "2025-11-09T17:25:30Z",
+                      "latencyMs": 3.8,
+                      "status": "healthy",
+                      "uptime": "72h14m03s"
+                    },
+                    "redis": {
+                      "lastChecked": "2025-11-09T17:25:30Z",
+                      "latencyMs": 2.4,
+                      "status": "healthy",
+                      "uptime": "36h42m01s"
+                    }
+                  }
+                },
+                "description": {
+                  "description": "Human-readable summary of the system health",
+                  "type": "string",
+                  "example": "All dependencies are healthy and running smoothly"
+                },
+                "status": {
+                  "description": "Overall system status (ok, degraded, or down)",
+                  "type": "string",
+                  "example": "ok"
+                },
+                "timestamp": {
+                  "description": "UTC timestamp when the health check was performed",
+                  "type": "string",
+                  "format": "date-time",
+                  "example": "2025-11-09T17:27:57Z"
+                },
+                "uptime": {
+                  "description": "Application uptime since last start",
+                  "type": "string",
+                  "example": "72h35m10s"
+                }
               }
             }
+          }
+        }
+      }
+    },
+    "/orders": {
+      "get": {
+        "tags": [
+          "Orders"
+        ],
+        "summary": "List user's orders",
+        "operationId": "listOrders",
+        "parameters": [
+          {
+            "type": "integer",
+            "default": 1,
+            "name": "page",
+            "in": "query"
           },
-          "401": {
-            "description": "Unauthorized"
+          {
+            "type": "integer",
+            "default": 10,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Paginated list of orders",
+            "schema": {
+              "$ref": "#/definitions/OrderListResponse"
+            }
           }
         },
         "security": [
@@ -1013,29 +1698,35 @@ func init() {
       },
       "post": {
         "tags": [
-          "Shipping"
+          "Orders"
         ],
-        "summary": "Add a new shipping address",
-        "operationId": "addShippingAddress",
+        "summary": "Place an order from cart",
+        "operationId": "placeOrder",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/AddressCreateRequest"
+              "$ref": "#/definitions/OrderCreateRequest"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Address added successfully",
+            "description": "Order placed successfully",
             "schema": {
-              "$ref": "#/definitions/Address"
+              "$ref": "#/definitions/Order"
             }
           },
           "400": {
-            "description": "Validation error",
+            "description": "Invalid order request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Contact not verified",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1048,38 +1739,30 @@ func init() {
         ]
       }
     },
-    "/shipping/addresses/{id}": {
-      "put": {
+    "/orders/{id}": {
+      "get": {
         "tags": [
-          "Shipping"
+          "Orders"
         ],
-        "summary": "Update a shipping address",
-        "operationId": "updateShippingAddress",
+        "summary": "Get order details",
+        "operationId": "getOrder",
         "parameters": [
           {
             "type": "integer",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/AddressUpdateRequest"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Address updated",
+            "description": "Order details",
             "schema": {
-              "$ref": "#/definitions/Address"
+              "$ref": "#/definitions/Order"
             }
           },
           "404": {
-            "description": "Address not found",
+            "description": "Order not found",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1090,27 +1773,40 @@ func init() {
             "bearerAuth": []
           }
         ]
-      },
-      "delete": {
+      }
+    },
+    "/payments/initiate": {
+      "post": {
         "tags": [
-          "Shipping"
+          "Payments"
         ],
-        "summary": "Delete a shipping address",
-        "operationId": "deleteShippingAddress",
+        "summary": "Initiate a new payment",
+        "operationId": "initiatePayment",
         "parameters": [
           {
-            "type": "integer",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PaymentInitiateRequest"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Address deleted"
+          "201": {
+            "description": "Payment initiated",
+            "schema": {
+              "$ref": "#/definitions/PaymentInitiateResponse"
+            }
           },
-          "404": {
-            "description": "Address not found",
+          "400": {
+            "description": "Invalid request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Contact not verified",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1123,50 +1819,30 @@ func init() {
         ]
       }
     },
-    "/shipping/options": {
-      "get": {
-        "tags": [
-          "Shipping"
-        ],
-        "summary": "Get available shipping options",
-        "operationId": "listShippingOptions",
-        "responses": {
-          "200": {
-            "description": "List of shipping options",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ShippingOption"
-              }
-            }
-          }
-        }
-      }
-    },
-    "/shipping/track/{orderId}": {
+    "/payments/{id}": {
       "get": {
         "tags": [
-          "Shipping"
+          "Payments"
         ],
-        "summary": "Track shipment for an order",
-        "operationId": "trackShipment",
+        "summary": "Get payment details",
+        "operationId": "getPayment",
         "parameters": [
           {
-            "type": "integer",
-            "name": "orderId",
+            "type": "string",
+            "name": "id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Shipment tracking details",
+            "description": "Payment details",
             "schema": {
-              "$ref": "#/definitions/Tracking"
+              "$ref": "#/definitions/Payment"
             }
           },
           "404": {
-            "description": "Order or shipment not found",
+            "description": "Payment not found",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1179,30 +1855,86 @@ func init() {
         ]
       }
     },
-    "/users": {
-      "get": {
+    "/payments/{id}/confirm": {
+      "post": {
         "tags": [
-          "AdminUsers"
+          "Payments"
         ],
-        "summary": "List all users",
-        "operationId": "listUsers",
-        "parameters": [
+        "summary": "Confirm a payment",
+        "operationId": "confirmPayment",
+        "parameters": [
           {
-            "type": "integer",
-            "default": 1,
-            "name": "page",
-            "in": "query"
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "integer",
-            "default": 20,
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PaymentConfirmRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Payment confirmed",
+            "schema": {
+              "$ref": "#/definitions/Payment"
+            }
+          },
+          "400": {
+            "description": "Invalid confirmation data",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/payments/{id}/refund": {
+      "post": {
+        "tags": [
+          "Payments"
+        ],
+        "summary": "Refund a payment",
+        "operationId": "refundPayment",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RefundRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "List of users"
+            "description": "Refund processed",
+            "schema": {
+              "$ref": "#/definitions/Payment"
+            }
+          },
+          "400": {
+            "description": "Invalid refund request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Payment not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "security": [
@@ -1212,37 +1944,97 @@ func init() {
         ]
       }
     },
-    "/users/me": {
+    "/products": {
       "get": {
+        "description": "Filter by attribute with attr.\u003ccode\u003e=\u003cvalue\u003e query parameters, e.g. attr.metal=gold\u0026attr.stone=diamond. Repeat a parameter to match any of several values; numeric attributes also take a min..max range with either end optional.",
         "produces": [
           "application/json"
         ],
         "tags": [
-          "Users"
+          "Products"
+        ],
+        "summary": "List products",
+        "operationId": "listProducts",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "default": 1,
+            "description": "Page number",
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 20,
+            "description": "Number of items per page",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Search term matched against name and description",
+            "name": "search",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Filter products by category ID, including its subcategories",
+            "name": "categoryId",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "number",
+            "description": "Lowest price, inclusive",
+            "name": "minPrice",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "number",
+            "description": "Highest price, inclusive",
+            "name": "maxPrice",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only products with stock left",
+            "name": "inStock",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "newest",
+              "price_asc",
+              "price_desc",
+              "popularity"
+            ],
+            "type": "string",
+            "default": "newest",
+            "description": "Sort order",
+            "name": "sort",
+            "in": "query"
+          }
         ],
-        "summary": "Get logged-in user profile",
-        "operationId": "getUserProfile",
         "responses": {
           "200": {
-            "description": "User profile details",
+            "description": "A paginated list of products",
             "schema": {
-              "$ref": "#/definitions/User"
+              "$ref": "#/definitions/ProductListResponse"
             }
           },
-          "401": {
-            "description": "Unauthorized",
+          "400": {
+            "description": "Invalid query parameters or attr filter",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
+        }
       },
-      "put": {
+      "post": {
         "consumes": [
           "application/json"
         ],
@@ -1250,25 +2042,25 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "Users"
+          "AdminProducts"
         ],
-        "summary": "Update logged-in user profile",
-        "operationId": "updateUserProfile",
+        "summary": "Create a new product",
+        "operationId": "createProduct",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/UserUpdateRequest"
+              "$ref": "#/definitions/ProductCreateRequest"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Profile updated successfully",
+          "201": {
+            "description": "Product created successfully",
             "schema": {
-              "$ref": "#/definitions/User"
+              "$ref": "#/definitions/Product"
             }
           },
           "400": {
@@ -1282,22 +2074,34 @@ func init() {
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "security": [
           {
             "bearerAuth": []
+          },
+          {
+            "apiKey": []
           }
         ]
       }
     },
-    "/users/{id}": {
+    "/products/{id}": {
       "get": {
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "AdminUsers"
+          "Products"
         ],
-        "summary": "Get user details",
-        "operationId": "getUser",
+        "summary": "Get product by ID",
+        "operationId": "getProduct",
         "parameters": [
           {
             "type": "integer",
@@ -1308,21 +2112,31 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "User details"
-          }
-        },
-        "security": [
-          {
-            "bearerAuth": []
+            "description": "Product details",
+            "schema": {
+              "$ref": "#/definitions/Product"
+            }
+          },
+          "404": {
+            "description": "Product not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        ]
+        }
       },
       "put": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "AdminUsers"
+          "AdminProducts"
         ],
-        "summary": "Update user info",
-        "operationId": "updateUser",
+        "summary": "Update a product",
+        "operationId": "updateProduct",
         "parameters": [
           {
             "type": "integer",
@@ -1335,27 +2149,67 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/UserUpdateRequest"
+              "$ref": "#/definitions/ProductUpdateRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "User updated"
+            "description": "Product updated",
+            "schema": {
+              "$ref": "#/definitions/Product"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Product not found or archived",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Product was changed since the given version; reload and retry",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "security": [
           {
             "bearerAuth": []
+          },
+          {
+            "apiKey": []
           }
         ]
       },
       "delete": {
+        "description": "Archives the product. It disappears from listings but still resolves by id for existing orders.",
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "AdminUsers"
+          "AdminProducts"
         ],
-        "summary": "Delete a user",
-        "operationId": "deleteUser",
+        "summary": "Delete a product",
+        "operationId": "deleteProduct",
         "parameters": [
           {
             "type": "integer",
@@ -1366,993 +2220,7235 @@ func init() {
         ],
         "responses": {
           "204": {
-            "description": "User deleted"
+            "description": "Product deleted"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Product not found or already archived",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "security": [
           {
             "bearerAuth": []
+          },
+          {
+            "apiKey": []
           }
         ]
       }
-    }
-  },
-  "definitions": {
-    "Address": {
-      "description": "Represents a user shipping address.",
-      "type": "object",
-      "required": [
-        "id",
-        "userId",
-        "addressLine1",
-        "city",
-        "state",
-        "zip",
-        "country"
-      ],
-      "properties": {
-        "addressLine1": {
-          "type": "string"
-        },
-        "addressLine2": {
-          "type": "string"
-        },
-        "city": {
-          "type": "string"
-        },
-        "country": {
-          "type": "string"
-        },
-        "createdAt": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "id": {
-          "type": "integer"
-        },
-        "state": {
-          "type": "string"
-        },
-        "updatedAt": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "userId": {
-          "type": "integer"
-        },
-        "zip": {
-          "type": "string"
-        }
-      }
     },
-    "AddressCreateRequest": {
-      "description": "Payload to add a new address.",
-      "type": "object",
-      "required": [
-        "addressLine1",
-        "city",
-        "state",
-        "zip",
-        "country"
-      ],
-      "properties": {
-        "addressLine1": {
-          "type": "string"
-        },
-        "addressLine2": {
-          "type": "string"
-        },
-        "city": {
-          "type": "string"
-        },
-        "country": {
-          "type": "string"
-        },
-        "state": {
-          "type": "string"
+    "/products/{id}/variants": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminProducts"
+        ],
+        "summary": "Add a variant to a product",
+        "operationId": "createVariant",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VariantCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Variant created",
+            "schema": {
+              "$ref": "#/definitions/ProductVariant"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Product not found or archived",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "SKU or barcode already in use, or the product already has a variant with these options",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "zip": {
-          "type": "string"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
       }
     },
-    "AddressUpdateRequest": {
-      "description": "Payload to update an existing address.",
-      "type": "object",
-      "properties": {
-        "addressLine1": {
-          "type": "string"
-        },
-        "addressLine2": {
-          "type": "string"
-        },
-        "city": {
-          "type": "string"
-        },
-        "country": {
-          "type": "string"
+    "/products/{id}/variants/{variantId}": {
+      "put": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminProducts"
+        ],
+        "summary": "Update a product variant",
+        "operationId": "updateVariant",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "name": "variantId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VariantUpdateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Variant updated",
+            "schema": {
+              "$ref": "#/definitions/ProductVariant"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Product or variant not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "SKU or barcode already in use, or the product already has a variant with these options",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "state": {
-          "type": "string"
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
+      },
+      "delete": {
+        "description": "Archives the variant. It leaves the product but stays resolvable for existing carts and orders.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminProducts"
+        ],
+        "summary": "Delete a product variant",
+        "operationId": "deleteVariant",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "name": "variantId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Variant deleted"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Product or variant not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "zip": {
-          "type": "string"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
       }
     },
-    "AuthResponse": {
-      "description": "Response containing JWT token and user details after login.",
-      "type": "object",
-      "required": [
-        "token",
-        "user"
-      ],
-      "properties": {
-        "refreshToken": {
-          "type": "string"
+    "/shipping/addresses": {
+      "get": {
+        "tags": [
+          "Shipping"
+        ],
+        "summary": "Get all addresses for logged-in user",
+        "operationId": "listShippingAddresses",
+        "responses": {
+          "200": {
+            "description": "List of user addresses",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Address"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized"
+          }
         },
-        "token": {
-          "type": "string",
-          "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      },
+      "post": {
+        "tags": [
+          "Shipping"
+        ],
+        "summary": "Add a new shipping address",
+        "operationId": "addShippingAddress",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AddressCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Address added successfully",
+            "schema": {
+              "$ref": "#/definitions/Address"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "user": {
-          "$ref": "#/definitions/User"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "Cart": {
-      "description": "Shopping cart belonging to a user.",
-      "type": "object",
-      "properties": {
-        "items": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/CartItem"
+    "/shipping/addresses/{id}": {
+      "put": {
+        "tags": [
+          "Shipping"
+        ],
+        "summary": "Update a shipping address",
+        "operationId": "updateShippingAddress",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AddressUpdateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Address updated",
+            "schema": {
+              "$ref": "#/definitions/Address"
+            }
+          },
+          "404": {
+            "description": "Address not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "totalPrice": {
-          "type": "number",
-          "format": "float",
-          "example": 2999.5
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      },
+      "delete": {
+        "tags": [
+          "Shipping"
+        ],
+        "summary": "Delete a shipping address",
+        "operationId": "deleteShippingAddress",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Address deleted"
+          },
+          "404": {
+            "description": "Address not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "CartItem": {
-      "description": "An item inside the shopping cart.",
-      "type": "object",
-      "properties": {
-        "name": {
-          "type": "string",
-          "example": "Gold Ring"
-        },
-        "price": {
-          "type": "number",
-          "format": "float",
-          "example": 1499.75
-        },
-        "productId": {
-          "type": "integer",
-          "example": 101
-        },
-        "quantity": {
-          "type": "integer",
-          "example": 2
-        },
-        "subtotal": {
-          "type": "number",
-          "format": "float",
-          "example": 2999.5
+    "/shipping/options": {
+      "get": {
+        "tags": [
+          "Shipping"
+        ],
+        "summary": "Get available shipping options",
+        "operationId": "listShippingOptions",
+        "responses": {
+          "200": {
+            "description": "List of shipping options",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ShippingOption"
+              }
+            }
+          }
         }
       }
     },
-    "CartItemRequest": {
-      "description": "Payload to add a product to cart.",
-      "type": "object",
-      "required": [
-        "productId",
-        "quantity"
-      ],
-      "properties": {
-        "productId": {
-          "type": "integer"
+    "/shipping/track/{orderId}": {
+      "get": {
+        "tags": [
+          "Shipping"
+        ],
+        "summary": "Track shipment for an order",
+        "operationId": "trackShipment",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "orderId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shipment tracking details",
+            "schema": {
+              "$ref": "#/definitions/Tracking"
+            }
+          },
+          "404": {
+            "description": "Order or shipment not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "quantity": {
-          "type": "integer",
-          "minimum": 1
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "CartItemUpdateRequest": {
-      "description": "Payload to update cart item quantity.",
-      "type": "object",
-      "required": [
-        "productId",
-        "quantity"
-      ],
-      "properties": {
-        "productId": {
-          "type": "integer"
+    "/users": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminUsers"
+        ],
+        "summary": "List users with filters",
+        "operationId": "listUsers",
+        "parameters": [
+          {
+            "type": "integer",
+            "default": 1,
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Email contains (case-insensitive)",
+            "name": "email",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Phone contains",
+            "name": "phone",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Created at or after",
+            "name": "createdFrom",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Created before",
+            "name": "createdTo",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Has (or lacks) a verified email or phone",
+            "name": "verified",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "disabled",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "admin",
+              "catalog-manager",
+              "support",
+              "customer"
+            ],
+            "type": "string",
+            "name": "role",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "List of users",
+            "schema": {
+              "$ref": "#/definitions/UserListResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid filter",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "quantity": {
-          "type": "integer",
-          "minimum": 1
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
       }
     },
-    "ErrorResponse": {
-      "description": "Standard error response.",
-      "type": "object",
-      "required": [
-        "error",
-        "message"
-      ],
-      "properties": {
-        "error": {
-          "type": "string",
-          "example": "BadRequest"
+    "/users/me": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Get logged-in user profile",
+        "operationId": "getUserProfile",
+        "responses": {
+          "200": {
+            "description": "User profile details",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "message": {
-          "type": "string",
-          "example": "Invalid request parameters"
-        }
-      }
-    },
-    "ForgotPasswordRequest": {
-      "description": "Request to initiate password reset.",
-      "type": "object",
-      "required": [
-        "email"
-      ],
-      "properties": {
-        "email": {
-          "type": "string",
-          "format": "email",
-          "example": "paras@example.com"
-        }
-      }
-    },
-    "GenericResponse": {
-      "type": "object",
-      "properties": {
-        "message": {
-          "type": "string",
-          "example": "OTP sent if account exists"
-        }
-      }
-    },
-    "LoginRequest": {
-      "description": "Payload to authenticate a user.",
-      "type": "object",
-      "required": [
-        "email",
-        "password"
-      ],
-      "properties": {
-        "email": {
-          "type": "string",
-          "format": "email",
-          "example": "paras@example.com"
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      },
+      "put": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Update logged-in user profile",
+        "operationId": "updateUserProfile",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/UserUpdateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Profile updated successfully",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Current password is incorrect",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Email or phone already in use",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "password": {
-          "type": "string",
-          "example": "strongPassword123"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "Order": {
-      "description": "Represents a purchase order.",
-      "type": "object",
-      "properties": {
-        "createdAt": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "id": {
-          "type": "integer",
-          "example": 5001
-        },
-        "items": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/CartItem"
+    "/users/me/data-export": {
+      "post": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Start an export of all personal data held about the logged-in user",
+        "operationId": "requestDataExport",
+        "responses": {
+          "202": {
+            "description": "Export job queued (or the one already running)",
+            "schema": {
+              "$ref": "#/definitions/PrivacyJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "status": {
-          "type": "string",
-          "enum": [
-            "pending",
-            "paid",
-            "shipped",
-            "delivered",
-            "cancelled"
-          ],
-          "example": "pending"
-        },
-        "totalPrice": {
-          "type": "number",
-          "format": "float",
-          "example": 2999.5
-        },
-        "updatedAt": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "userId": {
-          "type": "integer",
-          "example": 101
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "OrderCreateRequest": {
-      "description": "Request to create a new order.",
-      "type": "object",
-      "required": [
-        "shippingAddressId",
-        "paymentMethod"
-      ],
-      "properties": {
-        "paymentMethod": {
-          "type": "string",
-          "enum": [
-            "cod",
-            "card",
-            "netbanking",
-            "upi"
-          ],
-          "example": "upi"
+    "/users/me/erasure": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Erase the logged-in user's personal data from every store; orders and payments are kept without personal data",
+        "operationId": "requestErasure",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ErasureRequest"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Erasure job queued (or the one already running)",
+            "schema": {
+              "$ref": "#/definitions/PrivacyJob"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Current password is incorrect",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "shippingAddressId": {
-          "type": "integer",
-          "example": 2001
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "OrderListResponse": {
-      "description": "Paginated list of orders.",
-      "type": "object",
-      "properties": {
-        "items": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Order"
+    "/users/me/mfa": {
+      "post": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Start TOTP enrolment for the current user",
+        "operationId": "startMFAEnrollment",
+        "responses": {
+          "200": {
+            "description": "Enrolment started, confirm it with a code",
+            "schema": {
+              "$ref": "#/definitions/MFAEnrollment"
+            }
+          },
+          "400": {
+            "description": "MFA already enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "limit": {
-          "type": "integer"
-        },
-        "page": {
-          "type": "integer"
-        },
-        "total": {
-          "type": "integer"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "Payment": {
-      "description": "Represents a payment transaction.",
-      "type": "object",
-      "properties": {
-        "amount": {
-          "type": "number",
-          "format": "float"
-        },
-        "createdAt": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "currency": {
-          "type": "string"
-        },
-        "id": {
-          "type": "string"
-        },
-        "method": {
-          "type": "string"
-        },
-        "orderId": {
-          "type": "integer"
-        },
-        "status": {
-          "type": "string"
-        },
-        "transactionId": {
-          "type": "string"
-        },
-        "updatedAt": {
-          "type": "string",
-          "format": "date-time"
+    "/users/me/mfa/confirm": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Turn MFA on with a first authenticator code",
+        "operationId": "confirmMFA",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MFACodeRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "MFA enabled",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid code or nothing to confirm",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "userId": {
-          "type": "integer"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "PaymentConfirmRequest": {
-      "description": "Request to confirm a payment.",
-      "type": "object",
-      "required": [
-        "transactionId",
-        "status"
-      ],
-      "properties": {
-        "signature": {
-          "type": "string"
+    "/users/me/mfa/disable": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Turn MFA off (not allowed for staff accounts)",
+        "operationId": "disableMFA",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MFACodeRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "MFA disabled",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid code or MFA not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "MFA is mandatory for this account",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "status": {
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/users/me/passkeys": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "List passkeys",
+        "operationId": "listPasskeys",
+        "responses": {
+          "200": {
+            "description": "Passkeys of the logged-in user",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Passkey"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      },
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Finish passkey registration",
+        "operationId": "registerPasskey",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PasskeyRegistrationRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Passkey registered",
+            "schema": {
+              "$ref": "#/definitions/Passkey"
+            }
+          },
+          "400": {
+            "description": "Attestation rejected or ceremony expired",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "This authenticator is already registered",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/users/me/passkeys/options": {
+      "post": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Start passkey registration",
+        "operationId": "beginPasskeyRegistration",
+        "responses": {
+          "200": {
+            "description": "Creation options for navigator.credentials.create()",
+            "schema": {
+              "$ref": "#/definitions/PasskeyOptions"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/users/me/passkeys/{id}": {
+      "delete": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Remove a passkey",
+        "operationId": "deletePasskey",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Passkey removed"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Passkey not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/users/me/phone/confirm": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Switch to a new phone with the code sent to it",
+        "operationId": "confirmPhoneChange",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VerifyPhoneRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Phone changed",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "400": {
+            "description": "Invalid or expired code, or no pending change",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Phone already in use",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many wrong attempts, request a new code",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/users/me/privacy-jobs/{id}": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Progress of a data export or erasure job",
+        "operationId": "getPrivacyJob",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Job status",
+            "schema": {
+              "$ref": "#/definitions/PrivacyJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Job not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/users/me/sessions": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "List active sessions (devices) of the logged-in user",
+        "operationId": "listUserSessions",
+        "responses": {
+          "200": {
+            "description": "Active sessions",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Session"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/users/me/sessions/{id}": {
+      "delete": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Sign out a single session (device)",
+        "operationId": "revokeUserSession",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Session revoked"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Session not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/users/me/verify/email": {
+      "post": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Send a verification link to the user's email",
+        "operationId": "sendEmailVerification",
+        "responses": {
+          "200": {
+            "description": "Verification link sent",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "No email on the account or already verified",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/users/me/verify/phone": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Confirm the user's phone with the code sent by SMS",
+        "operationId": "verifyPhone",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VerifyPhoneRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Phone verified",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid or expired code",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many wrong attempts, request a new code",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/users/me/verify/phone/send": {
+      "post": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Send a verification code to the user's phone",
+        "operationId": "sendPhoneVerification",
+        "responses": {
+          "200": {
+            "description": "Verification code sent",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "No phone on the account or already verified",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "A code was sent recently, retry after the cooldown",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/users/{id}": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminUsers"
+        ],
+        "summary": "Get user details",
+        "operationId": "getUser",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User details",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "User not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
+      },
+      "put": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminUsers"
+        ],
+        "summary": "Update user info and roles",
+        "operationId": "updateUser",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AdminUserUpdateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User updated",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "400": {
+            "description": "Invalid input",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "User not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Email or phone already in use",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
+      },
+      "delete": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminUsers"
+        ],
+        "summary": "Delete a user (anonymised; order history is kept)",
+        "operationId": "deleteUser",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "User deleted"
+          },
+          "400": {
+            "description": "Cannot delete your own account",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "User not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
+      }
+    },
+    "/users/{id}/disable": {
+      "post": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminUsers"
+        ],
+        "summary": "Disable an account and sign it out everywhere",
+        "operationId": "disableUser",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Account disabled",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "Cannot disable your own account",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "User not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Account already disabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
+      }
+    },
+    "/users/{id}/enable": {
+      "post": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminUsers"
+        ],
+        "summary": "Re-enable a disabled account",
+        "operationId": "enableUser",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Account enabled",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "User not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Account is not disabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
+      }
+    },
+    "/users/{id}/impersonate": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminUsers"
+        ],
+        "summary": "Issue a read-only token to act as a customer",
+        "operationId": "impersonateUser",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ImpersonationRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Impersonation token issued",
+            "schema": {
+              "$ref": "#/definitions/ImpersonationResponse"
+            }
+          },
+          "400": {
+            "description": "Missing reason or target cannot be impersonated",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "User not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/users/{id}/unlock": {
+      "post": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminUsers"
+        ],
+        "summary": "Clear failed-login counters and lift an account lockout",
+        "operationId": "unlockUser",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Account unlocked",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "User not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
+      }
+    },
+    "/webhooks/sms/{provider}": {
+      "post": {
+        "description": "Delivery reports pushed by the SMS gateway. Each provider authenticates its own way (Twilio signs the request, MSG91 callbacks carry the shared token in the query string).\n",
+        "consumes": [
+          "application/json",
+          "application/x-www-form-urlencoded"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "System"
+        ],
+        "summary": "SMS delivery-status callback",
+        "operationId": "smsDeliveryStatus",
+        "parameters": [
+          {
+            "enum": [
+              "msg91",
+              "twilio",
+              "fake"
+            ],
+            "type": "string",
+            "name": "provider",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Report accepted"
+          },
+          "400": {
+            "description": "Malformed or unauthenticated callback",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Provider is not the active one",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "APIKey": {
+      "description": "Machine credential for back-office integrations. The secret is never returned after creation.",
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "prefix",
+        "scopes"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "createdBy": {
+          "description": "Admin who created the key",
+          "type": "integer"
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "example": 7
+        },
+        "lastUsedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "name": {
+          "type": "string",
+          "example": "erp-sync"
+        },
+        "prefix": {
+          "description": "Public part of the key, shown to tell keys apart",
+          "type": "string",
+          "example": "ak_3f9c2a1b"
+        },
+        "revokedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "scopes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "products:write"
+          ]
+        }
+      }
+    },
+    "APIKeyCreateRequest": {
+      "description": "Payload to issue an API key.",
+      "type": "object",
+      "required": [
+        "name",
+        "scopes"
+      ],
+      "properties": {
+        "expiresInDays": {
+          "description": "Days until the key expires; omit for no expiry",
+          "type": "integer",
+          "example": 90
+        },
+        "name": {
+          "type": "string",
+          "example": "erp-sync"
+        },
+        "scopes": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "users:read",
+              "users:write",
+              "products:write"
+            ]
+          },
+          "example": [
+            "products:write"
+          ]
+        }
+      }
+    },
+    "APIKeyCreated": {
+      "description": "A new API key. key is shown only in this response.",
+      "type": "object",
+      "required": [
+        "key",
+        "apiKey"
+      ],
+      "properties": {
+        "apiKey": {
+          "$ref": "#/definitions/APIKey"
+        },
+        "key": {
+          "description": "Full secret to send in X-API-Key",
+          "type": "string",
+          "example": "ak_3f9c2a1b.6d1f..."
+        }
+      }
+    },
+    "Address": {
+      "description": "Represents a user shipping address.",
+      "type": "object",
+      "required": [
+        "id",
+        "userId",
+        "addressLine1",
+        "city",
+        "state",
+        "zip",
+        "country"
+      ],
+      "properties": {
+        "addressLine1": {
+          "type": "string"
+        },
+        "addressLine2": {
+          "type": "string"
+        },
+        "city": {
+          "type": "string"
+        },
+        "country": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer"
+        },
+        "state": {
+          "type": "string"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "userId": {
+          "type": "integer"
+        },
+        "zip": {
+          "type": "string"
+        }
+      }
+    },
+    "AddressCreateRequest": {
+      "description": "Payload to add a new address.",
+      "type": "object",
+      "required": [
+        "addressLine1",
+        "city",
+        "state",
+        "zip",
+        "country"
+      ],
+      "properties": {
+        "addressLine1": {
+          "type": "string"
+        },
+        "addressLine2": {
+          "type": "string"
+        },
+        "city": {
+          "type": "string"
+        },
+        "country": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "zip": {
+          "type": "string"
+        }
+      }
+    },
+    "AddressUpdateRequest": {
+      "description": "Payload to update an existing address.",
+      "type": "object",
+      "properties": {
+        "addressLine1": {
+          "type": "string"
+        },
+        "addressLine2": {
+          "type": "string"
+        },
+        "city": {
+          "type": "string"
+        },
+        "country": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "zip": {
+          "type": "string"
+        }
+      }
+    },
+    "AdminUserUpdateRequest": {
+      "description": "Admin changes to a user. Omitted fields are left unchanged; roles replaces the whole set.",
+      "type": "object",
+      "properties": {
+        "email": {
+          "type": "string",
+          "format": "email",
+          "example": "paras@example.com"
+        },
+        "name": {
+          "type": "string",
+          "example": "Paras Jain"
+        },
+        "phone": {
+          "type": "string",
+          "example": "+919876543210"
+        },
+        "roles": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "admin",
+              "catalog-manager",
+              "support",
+              "customer"
+            ]
+          },
+          "example": [
+            "customer"
+          ]
+        }
+      }
+    },
+    "AttributeDefinition": {
+      "description": "A typed product attribute of a category. Subcategories inherit it; a subcategory may redefine the same code.",
+      "type": "object",
+      "required": [
+        "id",
+        "categoryId",
+        "code",
+        "label",
+        "type",
+        "required"
+      ],
+      "properties": {
+        "categoryId": {
+          "description": "Category the attribute is defined on",
+          "type": "integer",
+          "example": 5
+        },
+        "code": {
+          "description": "Key in product attributes and in attr.\u003ccode\u003e filters",
+          "type": "string",
+          "example": "metal"
+        },
+        "id": {
+          "type": "integer",
+          "example": 31
+        },
+        "label": {
+          "type": "string",
+          "example": "Metal"
+        },
+        "max": {
+          "description": "Highest value of a number attribute",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "min": {
+          "description": "Lowest value of a number attribute",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "position": {
+          "type": "integer",
+          "example": 0
+        },
+        "required": {
+          "description": "Products in the category must set it",
+          "type": "boolean"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "enum",
+            "number",
+            "boolean"
+          ],
+          "example": "enum"
+        },
+        "unit": {
+          "description": "Unit of a number attribute",
+          "type": "string",
+          "example": "ct"
+        },
+        "values": {
+          "description": "Allowed values of an enum attribute",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "gold",
+            "silver",
+            "platinum"
+          ]
+        }
+      }
+    },
+    "AttributeDefinitionCreateRequest": {
+      "description": "Request to define an attribute on a category.",
+      "type": "object",
+      "required": [
+        "code",
+        "label",
+        "type"
+      ],
+      "properties": {
+        "code": {
+          "type": "string",
+          "maxLength": 50,
+          "minLength": 1,
+          "pattern": "^[a-z][a-z0-9_]*$"
+        },
+        "label": {
+          "type": "string",
+          "maxLength": 100,
+          "minLength": 1
+        },
+        "max": {
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "min": {
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "position": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "required": {
+          "type": "boolean"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "enum",
+            "number",
+            "boolean"
+          ]
+        },
+        "unit": {
+          "type": "string",
+          "maxLength": 20
+        },
+        "values": {
+          "description": "Required for enum attributes",
+          "type": "array",
+          "maxItems": 100,
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "AttributeDefinitionUpdateRequest": {
+      "description": "Changes to an attribute definition. Omitted fields are left unchanged; values replaces the whole list. The code and type cannot change.",
+      "type": "object",
+      "properties": {
+        "label": {
+          "type": "string",
+          "maxLength": 100,
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "max": {
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "min": {
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "position": {
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "required": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "unit": {
+          "type": "string",
+          "maxLength": 20,
+          "x-nullable": true
+        },
+        "values": {
+          "type": "array",
+          "maxItems": 100,
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "AuthResponse": {
+      "description": "Response containing JWT token and user details after login.",
+      "type": "object",
+      "required": [
+        "token",
+        "user"
+      ],
+      "properties": {
+        "refreshToken": {
+          "type": "string"
+        },
+        "token": {
+          "type": "string",
+          "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+        },
+        "user": {
+          "$ref": "#/definitions/User"
+        }
+      }
+    },
+    "Cart": {
+      "description": "Shopping cart belonging to a user.",
+      "type": "object",
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CartItem"
+          }
+        },
+        "totalPrice": {
+          "type": "number",
+          "format": "float",
+          "example": 2999.5
+        }
+      }
+    },
+    "CartItem": {
+      "description": "An item inside the shopping cart.",
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "example": "Gold Ring"
+        },
+        "options": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VariantOption"
+          }
+        },
+        "price": {
+          "type": "number",
+          "format": "float",
+          "example": 1499.75
+        },
+        "productId": {
+          "type": "integer",
+          "example": 101
+        },
+        "quantity": {
+          "type": "integer",
+          "example": 2
+        },
+        "sku": {
+          "type": "string",
+          "example": "RING-101-18K-Y-7"
+        },
+        "skuId": {
+          "type": "integer",
+          "example": 7001
+        },
+        "subtotal": {
+          "type": "number",
+          "format": "float",
+          "example": 2999.5
+        }
+      }
+    },
+    "CartItemRequest": {
+      "description": "Payload to add a product variant to cart.",
+      "type": "object",
+      "required": [
+        "skuId",
+        "quantity"
+      ],
+      "properties": {
+        "quantity": {
+          "type": "integer",
+          "minimum": 1
+        },
+        "skuId": {
+          "description": "id of the product variant",
+          "type": "integer",
+          "minimum": 1
+        }
+      }
+    },
+    "CartItemUpdateRequest": {
+      "description": "Payload to update cart item quantity.",
+      "type": "object",
+      "required": [
+        "skuId",
+        "quantity"
+      ],
+      "properties": {
+        "quantity": {
+          "type": "integer",
+          "minimum": 1
+        },
+        "skuId": {
+          "description": "id of the product variant",
+          "type": "integer",
+          "minimum": 1
+        }
+      }
+    },
+    "Category": {
+      "description": "A product category.",
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "slug"
+      ],
+      "properties": {
+        "breadcrumbs": {
+          "description": "Path from the top level, ending with this category",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CategoryRef"
+          }
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "example": 12
+        },
+        "name": {
+          "type": "string",
+          "example": "Engagement Rings"
+        },
+        "parentId": {
+          "description": "null for a top-level category",
+          "type": "integer",
+          "x-nullable": true,
+          "example": 7
+        },
+        "position": {
+          "description": "Order among siblings, ascending",
+          "type": "integer",
+          "example": 0
+        },
+        "slug": {
+          "type": "string",
+          "example": "engagement-rings"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "CategoryCreateRequest": {
+      "description": "Request to create a category.",
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "maxLength": 100,
+          "minLength": 1,
+          "example": "Engagement Rings"
+        },
+        "parentId": {
+          "description": "Omit for a top-level category",
+          "type": "integer",
+          "minimum": 1,
+          "example": 7
+        },
+        "position": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "slug": {
+          "description": "Derived from name when omitted",
+          "type": "string",
+          "maxLength": 100,
+          "pattern": "^[a-z0-9]+(-[a-z0-9]+)*$",
+          "example": "engagement-rings"
+        }
+      }
+    },
+    "CategoryNode": {
+      "description": "A category with its subcategories, for navigation menus.",
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "slug"
+      ],
+      "properties": {
+        "children": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CategoryNode"
+          }
+        },
+        "id": {
+          "type": "integer",
+          "example": 7
+        },
+        "name": {
+          "type": "string",
+          "example": "Rings"
+        },
+        "position": {
+          "type": "integer",
+          "example": 0
+        },
+        "slug": {
+          "type": "string",
+          "example": "rings"
+        }
+      }
+    },
+    "CategoryRef": {
+      "description": "A category on the path from the top level to a product's category.",
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "slug"
+      ],
+      "properties": {
+        "id": {
+          "type": "integer",
+          "example": 7
+        },
+        "name": {
+          "type": "string",
+          "example": "Rings"
+        },
+        "slug": {
+          "type": "string",
+          "example": "rings"
+        }
+      }
+    },
+    "CategoryUpdateRequest": {
+      "description": "Changes to a category. Omitted fields are left unchanged.",
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "maxLength": 100,
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "parentId": {
+          "description": "New parent; 0 moves the category to the top level. It may not be moved under itself or a descendant.",
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "position": {
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "slug": {
+          "type": "string",
+          "maxLength": 100,
+          "pattern": "^[a-z0-9]+(-[a-z0-9]+)*$",
+          "x-nullable": true,
+          "example": "engagement-rings"
+        }
+      }
+    },
+    "ErasureRequest": {
+      "description": "Confirms a request to erase the account and its personal data.",
+      "type": "object",
+      "properties": {
+        "currentPassword": {
+          "description": "Required when the account has a password",
+          "type": "string"
+        }
+      }
+    },
+    "ErrorResponse": {
+      "description": "Standard error response.",
+      "type": "object",
+      "required": [
+        "error",
+        "message"
+      ],
+      "properties": {
+        "error": {
+          "type": "string",
+          "example": "BadRequest"
+        },
+        "message": {
+          "type": "string",
+          "example": "Invalid request parameters"
+        }
+      }
+    },
+    "Facet": {
+      "description": "Value counts of one attribute over the filtered listing. A facet's own attr filter is left out of its counts, so other values stay selectable.",
+      "type": "object",
+      "required": [
+        "name",
+        "values"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "example": "metal"
+        },
+        "values": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FacetValue"
+          }
+        }
+      }
+    },
+    "FacetValue": {
+      "description": "One attribute value and how many matching products have it.",
+      "type": "object",
+      "required": [
+        "value",
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "type": "integer",
+          "example": 42
+        },
+        "value": {
+          "type": "string",
+          "example": "gold"
+        }
+      }
+    },
+    "ForgotPasswordRequest": {
+      "description": "Request to initiate password reset.",
+      "type": "object",
+      "required": [
+        "email"
+      ],
+      "properties": {
+        "email": {
+          "type": "string",
+          "format": "email",
+          "example": "paras@example.com"
+        }
+      }
+    },
+    "GenericResponse": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "string",
+          "example": "OTP sent if account exists"
+        }
+      }
+    },
+    "ImpersonationRequest": {
+      "description": "Why the agent needs to act as the customer; kept in the audit log.",
+      "type": "object",
+      "required": [
+        "reason"
+      ],
+      "properties": {
+        "reason": {
+          "type": "string",
+          "example": "ticket #4821: order not visible"
+        }
+      }
+    },
+    "ImpersonationResponse": {
+      "description": "Short-lived, read-only access token for the target user.",
+      "type": "object",
+      "required": [
+        "token",
+        "expiresIn"
+      ],
+      "properties": {
+        "expiresIn": {
+          "description": "Seconds until token expires",
+          "type": "integer",
+          "example": 900
+        },
+        "token": {
+          "type": "string"
+        },
+        "user": {
+          "$ref": "#/definitions/User"
+        }
+      }
+    },
+    "JSONWebKey": {
+      "description": "Public half of a token signing key (RFC 7517).",
+      "type": "object",
+      "required": [
+        "kty",
+        "kid"
+      ],
+      "properties": {
+        "alg": {
+          "type": "string",
+          "example": "EdDSA"
+        },
+        "crv": {
+          "type": "string",
+          "example": "Ed25519"
+        },
+        "e": {
+          "description": "RSA public exponent (base64url)",
+          "type": "string"
+        },
+        "kid": {
+          "type": "string",
+          "example": "2026-01"
+        },
+        "kty": {
+          "type": "string",
+          "example": "OKP"
+        },
+        "n": {
+          "description": "RSA modulus (base64url)",
+          "type": "string"
+        },
+        "use": {
+          "type": "string",
+          "example": "sig"
+        },
+        "x": {
+          "description": "Ed25519 public key (base64url)",
+          "type": "string"
+        }
+      }
+    },
+    "JWKSet": {
+      "description": "Keys that currently validate access and refresh tokens.",
+      "type": "object",
+      "properties": {
+        "keys": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/JSONWebKey"
+          }
+        }
+      }
+    },
+    "LoginRequest": {
+      "description": "Payload to authenticate a user.",
+      "type": "object",
+      "required": [
+        "email",
+        "password"
+      ],
+      "properties": {
+        "email": {
+          "type": "string",
+          "format": "email",
+          "example": "paras@example.com"
+        },
+        "password": {
+          "type": "string",
+          "example": "strongPassword123"
+        }
+      }
+    },
+    "MFAChallenge": {
+      "description": "Returned instead of tokens when the login needs a second factor.",
+      "type": "object",
+      "required": [
+        "mfaToken"
+      ],
+      "properties": {
+        "enrollRequired": {
+          "description": "True when the account must enrol an authenticator before verifying",
+          "type": "boolean"
+        },
+        "expiresIn": {
+          "description": "Seconds until mfaToken expires",
+          "type": "integer",
+          "example": 300
+        },
+        "methods": {
+          "description": "Second factors the account can use: totp at /auth/mfa/verify, passkey at /auth/passkey/login",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "totp",
+              "passkey"
+            ]
+          }
+        },
+        "mfaToken": {
+          "description": "Short-lived token for /auth/mfa/verify (and /auth/mfa/enroll)",
+          "type": "string"
+        }
+      }
+    },
+    "MFACodeRequest": {
+      "description": "A current authenticator code.",
+      "type": "object",
+      "required": [
+        "code"
+      ],
+      "properties": {
+        "code": {
+          "type": "string",
+          "example": "123456"
+        }
+      }
+    },
+    "MFAEnrollRequest": {
+      "description": "Pending login token of an account that must enrol MFA.",
+      "type": "object",
+      "required": [
+        "mfaToken"
+      ],
+      "properties": {
+        "mfaToken": {
+          "type": "string"
+        }
+      }
+    },
+    "MFAEnrollment": {
+      "description": "New TOTP secret; recovery codes are shown only once.",
+      "type": "object",
+      "required": [
+        "secret",
+        "otpauthUri",
+        "recoveryCodes"
+      ],
+      "properties": {
+        "otpauthUri": {
+          "description": "Provisioning URI to render as a QR code",
+          "type": "string",
+          "example": "otpauth://totp/Adornme:paras%40example.com?secret=JBSWY3DPEHPK3PXP\u0026issuer=Adornme"
+        },
+        "recoveryCodes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secret": {
+          "description": "Base32 secret for manual entry",
+          "type": "string",
+          "example": "JBSWY3DPEHPK3PXP"
+        }
+      }
+    },
+    "MFAVerifyRequest": {
+      "description": "Second login step: the pending token plus an authenticator or recovery code.",
+      "type": "object",
+      "required": [
+        "mfaToken",
+        "code"
+      ],
+      "properties": {
+        "code": {
+          "description": "6-digit TOTP code or a recovery code",
+          "type": "string",
+          "example": "123456"
+        },
+        "mfaToken": {
+          "type": "string"
+        }
+      }
+    },
+    "MagicLinkRequest": {
+      "description": "Request a passwordless sign-in link by email.",
+      "type": "object",
+      "required": [
+        "email",
+        "deviceId"
+      ],
+      "properties": {
+        "deviceId": {
+          "description": "Random id the client generates and keeps (e.g. in local storage); the link only signs in on the device that sent it",
+          "type": "string",
+          "maxLength": 128,
+          "minLength": 16,
+          "example": "6f1c2a9e4b7d4e0f9a3b"
+        },
+        "email": {
+          "type": "string",
+          "format": "email",
+          "example": "paras@example.com"
+        }
+      }
+    },
+    "MagicLinkVerifyRequest": {
+      "description": "Token from the sign-in link, with the id of the device that requested it.",
+      "type": "object",
+      "required": [
+        "token",
+        "deviceId"
+      ],
+      "properties": {
+        "deviceId": {
+          "description": "Random id the client generates and keeps (e.g. in local storage); the link only signs in on the device that sent it",
+          "type": "string",
+          "maxLength": 128,
+          "minLength": 16,
+          "example": "6f1c2a9e4b7d4e0f9a3b"
+        },
+        "token": {
+          "type": "string",
+          "example": "eyJhbGciOiJFZERTQSIs..."
+        }
+      }
+    },
+    "Order": {
+      "description": "Represents a purchase order.",
+      "type": "object",
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "example": 5001
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CartItem"
+          }
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "paid",
+            "shipped",
+            "delivered",
+            "cancelled"
+          ],
+          "example": "pending"
+        },
+        "totalPrice": {
+          "type": "number",
+          "format": "float",
+          "example": 2999.5
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "userId": {
+          "type": "integer",
+          "example": 101
+        }
+      }
+    },
+    "OrderCreateRequest": {
+      "description": "Request to create a new order.",
+      "type": "object",
+      "required": [
+        "shippingAddressId",
+        "paymentMethod"
+      ],
+      "properties": {
+        "paymentMethod": {
+          "type": "string",
+          "enum": [
+            "cod",
+            "card",
+            "netbanking",
+            "upi"
+          ],
+          "example": "upi"
+        },
+        "shippingAddressId": {
+          "type": "integer",
+          "example": 2001
+        }
+      }
+    },
+    "OrderListResponse": {
+      "description": "Paginated list of orders.",
+      "type": "object",
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Order"
+          }
+        },
+        "limit": {
+          "type": "integer"
+        },
+        "page": {
+          "type": "integer"
+        },
+        "total": {
+          "type": "integer"
+        }
+      }
+    },
+    "Passkey": {
+      "description": "A registered passkey.",
+      "type": "object",
+      "required": [
+        "id",
+        "name"
+      ],
+      "properties": {
+        "backedUp": {
+          "description": "Synced to the platform's cloud keychain",
+          "type": "boolean"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "example": 12
+        },
+        "lastUsedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "name": {
+          "type": "string",
+          "example": "Pixel 8"
+        },
+        "transports": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "PasskeyAssertion": {
+      "description": "Result of navigator.credentials.get(), as serialised by PublicKeyCredential.toJSON().",
+      "type": "object",
+      "required": [
+        "id",
+        "response"
+      ],
+      "properties": {
+        "id": {
+          "description": "Credential id, base64url",
+          "type": "string"
+        },
+        "rawId": {
+          "description": "base64url",
+          "type": "string"
+        },
+        "response": {
+          "$ref": "#/definitions/PasskeyAssertionResponse"
+        },
+        "type": {
+          "type": "string",
+          "example": "public-key"
+        }
+      }
+    },
+    "PasskeyAssertionResponse": {
+      "type": "object",
+      "required": [
+        "clientDataJSON",
+        "authenticatorData",
+        "signature"
+      ],
+      "properties": {
+        "authenticatorData": {
+          "description": "base64url",
+          "type": "string"
+        },
+        "clientDataJSON": {
+          "description": "base64url",
+          "type": "string"
+        },
+        "signature": {
+          "description": "base64url",
+          "type": "string"
+        },
+        "userHandle": {
+          "description": "base64url",
+          "type": "string"
+        }
+      }
+    },
+    "PasskeyAttestation": {
+      "description": "Result of navigator.credentials.create(), as serialised by PublicKeyCredential.toJSON().",
+      "type": "object",
+      "required": [
+        "id",
+        "response"
+      ],
+      "properties": {
+        "id": {
+          "description": "Credential id, base64url",
+          "type": "string"
+        },
+        "rawId": {
+          "description": "base64url",
+          "type": "string"
+        },
+        "response": {
+          "$ref": "#/definitions/PasskeyAttestationResponse"
+        },
+        "type": {
+          "type": "string",
+          "example": "public-key"
+        }
+      }
+    },
+    "PasskeyAttestationResponse": {
+      "type": "object",
+      "required": [
+        "clientDataJSON",
+        "attestationObject"
+      ],
+      "properties": {
+        "attestationObject": {
+          "description": "base64url",
+          "type": "string"
+        },
+        "clientDataJSON": {
+          "description": "base64url",
+          "type": "string"
+        },
+        "transports": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "internal",
+            "hybrid"
+          ]
+        }
+      }
+    },
+    "PasskeyLoginOptionsRequest": {
+      "description": "Starts a passkey login. With mfaToken the passkey is the second factor of a password or OTP login; with email only that account's passkeys are offered; with neither any discoverable passkey may be used.",
+      "type": "object",
+      "properties": {
+        "email": {
+          "type": "string",
+          "format": "email",
+          "example": "paras@example.com"
+        },
+        "mfaToken": {
+          "description": "From an MFAChallenge",
+          "type": "string"
+        }
+      }
+    },
+    "PasskeyLoginRequest": {
+      "description": "Completes a passkey login started with beginPasskeyLogin.",
+      "type": "object",
+      "required": [
+        "credential"
+      ],
+      "properties": {
+        "credential": {
+          "$ref": "#/definitions/PasskeyAssertion"
+        },
+        "mfaToken": {
+          "description": "Same mfaToken as for beginPasskeyLogin, when the passkey is the second factor",
+          "type": "string"
+        }
+      }
+    },
+    "PasskeyOptions": {
+      "description": "WebAuthn options; pass publicKey to PublicKeyCredential.parseCreationOptionsFromJSON or parseRequestOptionsFromJSON.",
+      "type": "object",
+      "properties": {
+        "publicKey": {
+          "description": "PublicKeyCredentialCreationOptionsJSON or PublicKeyCredentialRequestOptionsJSON",
+          "type": "object"
+        }
+      }
+    },
+    "PasskeyRegistrationRequest": {
+      "description": "Registers the credential created with the options from beginPasskeyRegistration.",
+      "type": "object",
+      "required": [
+        "credential"
+      ],
+      "properties": {
+        "credential": {
+          "$ref": "#/definitions/PasskeyAttestation"
+        },
+        "name": {
+          "description": "Label shown in the passkey list",
+          "type": "string",
+          "maxLength": 64,
+          "example": "Pixel 8"
+        }
+      }
+    },
+    "Payment": {
+      "description": "Represents a payment transaction.",
+      "type": "object",
+      "properties": {
+        "amount": {
+          "type": "number",
+          "format": "float"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "currency": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
+        },
+        "orderId": {
+          "type": "integer"
+        },
+        "status": {
+          "type": "string"
+        },
+        "transactionId": {
+          "type": "string"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "userId": {
+          "type": "integer"
+        }
+      }
+    },
+    "PaymentConfirmRequest": {
+      "description": "Request to confirm a payment.",
+      "type": "object",
+      "required": [
+        "transactionId",
+        "status"
+      ],
+      "properties": {
+        "signature": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "transactionId": {
+          "type": "string"
+        }
+      }
+    },
+    "PaymentInitiateRequest": {
+      "description": "Request to initiate a payment.",
+      "type": "object",
+      "required": [
+        "orderId",
+        "method"
+      ],
+      "properties": {
+        "method": {
+          "type": "string"
+        },
+        "orderId": {
+          "type": "integer"
+        }
+      }
+    },
+    "PaymentInitiateResponse": {
+      "description": "Response after payment initiation.",
+      "type": "object",
+      "properties": {
+        "gatewayUrl": {
+          "type": "string"
+        },
+        "paymentId": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "Principal": {
+      "type": "object",
+      "properties": {
+        "authEmail": {
+          "type": "string"
+        },
+        "authId": {
+          "type": "string"
+        },
+        "authName": {
+          "type": "string"
+        },
+        "crn": {
+          "description": "Workaround for AT event tracker",
+          "type": "string"
+        },
+        "impersonatorId": {
+          "description": "ID of the support agent acting as this user (act claim); requests are read-only",
+          "type": "string"
+        },
+        "region": {
+          "description": "Workaround for API's not having region in their context",
+          "type": "string"
+        },
+        "sessionId": {
+          "description": "ID of the session the access token was issued for",
+          "type": "string"
+        },
+        "token": {
+          "description": "OAuth2 Token",
+          "$ref": "#/definitions/Token"
+        },
+        "userAccess": {
+          "description": "User Access",
+          "$ref": "#/definitions/UserAccess"
+        },
+        "userEmail": {
+          "description": "Email of User",
+          "type": "string"
+        },
+        "userID": {
+          "description": "ID of User",
+          "type": "string"
+        },
+        "userInfo": {
+          "description": "Object that keeps track of user authentication info",
+          "$ref": "#/definitions/UserAuthenticationInfo"
+        }
+      }
+    },
+    "PrivacyJob": {
+      "description": "An asynchronous data export or erasure job.",
+      "type": "object",
+      "required": [
+        "id",
+        "kind",
+        "status",
+        "progress"
+      ],
+      "properties": {
+        "completedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "downloadUrl": {
+          "description": "Signed link to the ZIP archive; export jobs only, until expiresAt",
+          "type": "string"
+        },
+        "error": {
+          "description": "Why the job failed",
+          "type": "string"
+        },
+        "expiresAt": {
+          "description": "When the download link stops being issued",
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "example": "3b1f7a52-8c0e-4d8e-9a3f-1f2e5c6d7b80"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "export",
+            "erasure"
+          ]
+        },
+        "progress": {
+          "description": "Percent done",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 100,
+          "minimum": 0
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "queued",
+            "running",
+            "completed",
+            "failed"
+          ]
+        },
+        "step": {
+          "description": "Store being processed",
+          "type": "string",
+          "example": "orders"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "Product": {
+      "description": "Represents a product available in the catalog.",
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "price",
+        "stock",
+        "categoryId",
+        "currency",
+        "version"
+      ],
+      "properties": {
+        "archivedAt": {
+          "description": "Set once the product is deleted; archived products are hidden from listProducts but still resolve by id",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "attributes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProductAttribute"
+          }
+        },
+        "breadcrumbs": {
+          "description": "Path from the top-level category down to categoryId",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CategoryRef"
+          }
+        },
+        "categoryId": {
+          "type": "integer",
+          "example": 5
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "currency": {
+          "type": "string",
+          "example": "INR"
+        },
+        "description": {
+          "type": "string",
+          "example": "22K pure gold necklace with intricate design"
+        },
+        "id": {
+          "type": "integer",
+          "example": 101
+        },
+        "images": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "https://cdn.adornme.com/products/101-1.jpg",
+            "https://cdn.adornme.com/products/101-2.jpg"
+          ]
+        },
+        "name": {
+          "type": "string",
+          "example": "Gold Necklace"
+        },
+        "options": {
+          "description": "Option axes the product varies along",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProductOption"
+          }
+        },
+        "price": {
+          "description": "Lowest variant price when the product has variants",
+          "type": "number",
+          "format": "float",
+          "example": 14999.99
+        },
+        "stock": {
+          "description": "Total stock of all variants when the product has variants",
+          "type": "integer",
+          "example": 20
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "variants": {
+          "description": "The variant matrix: one SKU per offered combination of option values",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProductVariant"
+          }
+        },
+        "version": {
+          "description": "Increases with every change; send it back with updateProduct",
+          "type": "integer",
+          "example": 3
+        }
+      }
+    },
+    "ProductAttribute": {
+      "description": "A product's value for one attribute of its category.",
+      "type": "object",
+      "required": [
+        "name",
+        "value"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "example": "metal"
+        },
+        "value": {
+          "description": "Numbers and booleans are sent as text, e.g. \"0.75\" or \"true\"",
+          "type": "string",
+          "example": "gold"
+        }
+      }
+    },
+    "ProductCreateRequest": {
+      "description": "Request to create a new product.",
+      "type": "object",
+      "required": [
+        "name",
+        "price",
+        "stock",
+        "categoryId"
+      ],
+      "properties": {
+        "attributes": {
+          "description": "Values for the attributes of the category and its parents",
+          "type": "array",
+          "maxItems": 50,
+          "items": {
+            "$ref": "#/definitions/ProductAttribute"
+          }
+        },
+        "categoryId": {
+          "type": "integer",
+          "minimum": 1
+        },
+        "currency": {
+          "description": "ISO 4217 code; INR when omitted",
+          "type": "string",
+          "pattern": "^[A-Z]{3}$",
+          "example": "INR"
+        },
+        "description": {
+          "type": "string",
+          "maxLength": 5000
+        },
+        "images": {
+          "description": "Absolute http(s) URLs, at most 10; the first is the cover",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string",
+          "maxLength": 200,
+          "minLength": 1
+        },
+        "options": {
+          "description": "Option axes, such as Size, Metal and Purity",
+          "type": "array",
+          "maxItems": 5,
+          "items": {
+            "$ref": "#/definitions/ProductOption"
+          }
+        },
+        "price": {
+          "type": "number",
+          "format": "float",
+          "minimum": 0,
+          "exclusiveMinimum": true
+        },
+        "stock": {
+          "type": "integer",
+          "minimum": 0
+        }
+      }
+    },
+    "ProductListResponse": {
+      "description": "Paginated list of products.",
+      "type": "object",
+      "properties": {
+        "facets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Facet"
+          }
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Product"
+          }
+        },
+        "limit": {
+          "type": "integer",
+          "example": 20
+        },
+        "page": {
+          "type": "integer",
+          "example": 1
+        },
+        "total": {
+          "type": "integer",
+          "example": 100
+        },
+        "totalPages": {
+          "type": "integer",
+          "example": 5
+        }
+      }
+    },
+    "ProductOption": {
+      "description": "One axis a product varies along, such as ring size or metal purity, with the values it is offered in.",
+      "type": "object",
+      "required": [
+        "name",
+        "values"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "maxLength": 50,
+          "minLength": 1,
+          "example": "Purity"
+        },
+        "values": {
+          "type": "array",
+          "maxItems": 50,
+          "minItems": 1,
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "14K",
+            "18K",
+            "22K"
+          ]
+        }
+      }
+    },
+    "ProductUpdateRequest": {
+      "description": "Changes to a product. Omitted fields are left unchanged; images replaces the whole list. version must match the stored one.",
+      "type": "object",
+      "required": [
+        "version"
+      ],
+      "properties": {
+        "attributes": {
+          "description": "Replaces all attribute values",
+          "type": "array",
+          "maxItems": 50,
+          "items": {
+            "$ref": "#/definitions/ProductAttribute"
+          }
+        },
+        "categoryId": {
+          "type": "integer",
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "currency": {
+          "type": "string",
+          "pattern": "^[A-Z]{3}$",
+          "x-nullable": true
+        },
+        "description": {
+          "type": "string",
+          "maxLength": 5000,
+          "x-nullable": true
+        },
+        "images": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string",
+          "maxLength": 200,
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "options": {
+          "description": "Replaces the option axes; every live variant must still fit them",
+          "type": "array",
+          "maxItems": 5,
+          "items": {
+            "$ref": "#/definitions/ProductOption"
+          }
+        },
+        "price": {
+          "description": "Only for products without variants",
+          "type": "number",
+          "format": "float",
+          "minimum": 0,
+          "exclusiveMinimum": true,
+          "x-nullable": true
+        },
+        "stock": {
+          "description": "Only for products without variants",
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "version": {
+          "description": "version of the product this change is based on",
+          "type": "integer",
+          "example": 3
+        }
+      }
+    },
+    "ProductVariant": {
+      "description": "A sellable SKU: one combination of option values with its own price, weight and stock.",
+      "type": "object",
+      "required": [
+        "id",
+        "productId",
+        "sku",
+        "options",
+        "price",
+        "stock"
+      ],
+      "properties": {
+        "barcode": {
+          "type": "string",
+          "example": "8901234567890"
+        },
+        "id": {
+          "type": "integer",
+          "example": 7001
+        },
+        "options": {
+          "description": "One value per product option, in the product's option order",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VariantOption"
+          }
+        },
+        "price": {
+          "type": "number",
+          "format": "float",
+          "example": 18999
+        },
+        "productId": {
+          "type": "integer",
+          "example": 101
+        },
+        "sku": {
+          "type": "string",
+          "example": "RING-101-18K-Y-7"
+        },
+        "stock": {
+          "type": "integer",
+          "example": 4
+        },
+        "weight": {
+          "description": "Metal weight in grams",
+          "type": "number",
+          "format": "float",
+          "example": 3.25
+        }
+      }
+    },
+    "RefreshTokenRequest": {
+      "description": "Payload to refresh authentication token.",
+      "type": "object",
+      "required": [
+        "refreshToken"
+      ],
+      "properties": {
+        "refreshToken": {
+          "type": "string",
+          "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+        }
+      }
+    },
+    "RefundRequest": {
+      "description": "Request to process a refund.",
+      "type": "object",
+      "required": [
+        "amount",
+        "reason"
+      ],
+      "properties": {
+        "amount": {
+          "type": "number",
+          "format": "float"
+        },
+        "reason": {
+          "type": "string"
+        }
+      }
+    },
+    "RegisterRequest": {
+      "description": "Payload to register a new user. Either email and password, or phone and the code sent to it by sendOTP for a passwordless account.",
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "email": {
+          "type": "string",
+          "format": "email",
+          "example": "paras@example.com"
+        },
+        "name": {
+          "type": "string",
+          "example": "Paras Jain"
+        },
+        "otp": {
+          "description": "Code sent to the phone by sendOTP; registers without a password",
+          "type": "string",
+          "example": "123456"
+        },
+        "password": {
+          "type": "string",
+          "example": "strongPassword123"
+        },
+        "phone": {
+          "description": "Mobile number; national numbers are read in the default region and stored in E.164",
+          "type": "string",
+          "example": "+919876543210"
+        }
+      }
+    },
+    "ResetPasswordRequest": {
+      "description": "Request to reset password with token.",
+      "type": "object",
+      "required": [
+        "token",
+        "newPassword"
+      ],
+      "properties": {
+        "newPassword": {
+          "type": "string",
+          "example": "strongNewPassword123"
+        },
+        "token": {
+          "type": "string",
+          "example": "reset_token_xyz"
+        }
+      }
+    },
+    "SendOTPRequest": {
+      "type": "object",
+      "required": [
+        "identifier"
+      ],
+      "properties": {
+        "identifier": {
+          "description": "Email or phone number",
+          "type": "string",
+          "example": "+919968177234"
+        }
+      }
+    },
+    "Session": {
+      "description": "A signed-in device (one per refresh token).",
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "current": {
+          "description": "True for the session making this request",
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string",
+          "example": "6f1c2b9e-3d4a-4f7e-9a51-0c2d8e7b1a44"
+        },
+        "ipAddress": {
+          "type": "string",
+          "example": "203.0.113.24"
+        },
+        "lastUsedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "userAgent": {
+          "type": "string",
+          "example": "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X)"
+        }
+      }
+    },
+    "ShippingOption": {
+      "description": "Represents available shipping option.",
+      "type": "object",
+      "properties": {
+        "cost": {
+          "type": "number",
+          "format": "float"
+        },
+        "estimatedDays": {
+          "type": "integer"
+        },
+        "id": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "SuccessResponse": {
+      "description": "Standard success response.",
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "message": {
+          "type": "string",
+          "example": "Operation successful"
+        }
+      }
+    },
+    "Token": {
+      "type": "object",
+      "required": [
+        "accessToken",
+        "type",
+        "refreshToken",
+        "expires"
+      ],
+      "properties": {
+        "accessToken": {
+          "description": "AccessToken",
+          "type": "string"
+        },
+        "expires": {
+          "description": "Token Expires",
+          "type": "string",
+          "format": "date-time"
+        },
+        "refreshToken": {
+          "description": "Refresh Token",
+          "type": "string"
+        },
+        "type": {
+          "description": "Token Type",
+          "type": "string"
+        }
+      }
+    },
+    "Tracking": {
+      "description": "Represents order tracking details.",
+      "type": "object",
+      "properties": {
+        "carrier": {
+          "type": "string"
+        },
+        "estimatedDelivery": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "orderId": {
+          "type": "integer"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "dispatched",
+            "in_transit",
+            "delivered",
+            "cancelled"
+          ]
+        },
+        "trackingNumber": {
+          "type": "string"
+        }
+      }
+    },
+    "User": {
+      "description": "Represents a registered user in the system.",
+      "type": "object",
+      "required": [
+        "id",
+        "email",
+        "name"
+      ],
+      "properties": {
+        "createdAt": {
+          "description": "User creation timestamp",
+          "type": "string",
+          "format": "date-time"
+        },
+        "disabled": {
+          "description": "True while an admin has the account disabled",
+          "type": "boolean"
+        },
+        "email": {
+          "description": "User email address",
+          "type": "string",
+          "format": "email",
+          "example": "paras@example.com"
+        },
+        "emailVerified": {
+          "description": "True once the email has been confirmed",
+          "type": "boolean"
+        },
+        "id": {
+          "description": "Unique user identifier",
+          "type": "integer",
+          "example": 101
+        },
+        "name": {
+          "description": "Full name of the user",
+          "type": "string",
+          "example": "Paras Jain"
+        },
+        "password": {
+          "description": "Password of user",
+          "type": "string"
+        },
+        "pendingEmail": {
+          "description": "New email waiting for confirmation from the link sent to it",
+          "type": "string",
+          "format": "email"
+        },
+        "pendingPhone": {
+          "description": "New phone waiting for confirmation with the code sent to it",
+          "type": "string"
+        },
+        "phone": {
+          "description": "Mobile number in E.164",
+          "type": "string",
+          "example": "+919876543210"
+        },
+        "phoneCountry": {
+          "description": "ISO 3166-1 region of the phone number",
+          "type": "string",
+          "example": "IN"
+        },
+        "phoneVerified": {
+          "description": "True once the phone has been confirmed",
+          "type": "boolean"
+        },
+        "roles": {
+          "description": "Roles granted to the user (admin views only)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "customer"
+          ]
+        },
+        "updatedAt": {
+          "description": "Last update timestamp",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "UserAccess": {
+      "description": "Keeps tracks of individual user's access and roles",
+      "type": "object",
+      "properties": {
+        "_id": {
+          "description": "User ID",
+          "type": "string",
+          "x-go-custom-tag": "datastore:\"id\"",
+          "x-go-name": "UserID"
+        },
+        "_rev": {
+          "type": "string",
+          "x-go-custom-tag": "datastore:\"-\""
+        },
+        "baseRole": {
+          "description": "Base Role for this User",
+          "type": "string",
+          "default": "customer",
+          "enum": [
+            "admin",
+            "catalog-manager",
+            "support",
+            "customer"
+          ],
+          "x-go-custom-tag": "datastore:\"baseRole\"",
+          "x-isnullable": false
+        },
+        "memberOf": {
+          "description": "Roles granted to this User",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "datastore:\"memberOf\""
+        },
+        "notes": {
+          "description": "Notes for development (not used internally)",
+          "type": "string",
+          "x-go-custom-tag": "datastore:\"notes,noindex,omitempty\""
+        }
+      }
+    },
+    "UserAuthenticationInfo": {
+      "type": "object",
+      "properties": {
+        "credentialType": {
+          "description": "Workaround for AT event tracker",
+          "type": "string"
+        }
+      }
+    },
+    "UserListResponse": {
+      "description": "Paginated list of users.",
+      "type": "object",
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/User"
+          }
+        },
+        "limit": {
+          "type": "integer",
+          "example": 20
+        },
+        "page": {
+          "type": "integer",
+          "example": 1
+        },
+        "total": {
+          "type": "integer",
+          "example": 100
+        }
+      }
+    },
+    "UserUpdateRequest": {
+      "description": "Partial update of the own profile. A new email or phone only takes effect once confirmed; a new password needs the current one.",
+      "type": "object",
+      "properties": {
+        "currentPassword": {
+          "type": "string"
+        },
+        "email": {
+          "type": "string",
+          "format": "email",
+          "example": "paras@example.com"
+        },
+        "name": {
+          "type": "string",
+          "example": "Paras Jain"
+        },
+        "newPassword": {
           "type": "string"
         },
-        "transactionId": {
-          "type": "string"
+        "phone": {
+          "type": "string",
+          "example": "+919876543210"
+        }
+      }
+    },
+    "VariantCreateRequest": {
+      "description": "Request to add a variant to a product.",
+      "type": "object",
+      "required": [
+        "sku",
+        "options",
+        "price",
+        "stock"
+      ],
+      "properties": {
+        "barcode": {
+          "description": "GTIN (EAN-8, UPC-A, EAN-13 or GTIN-14)",
+          "type": "string",
+          "pattern": "^[0-9]{8,14}$"
+        },
+        "options": {
+          "description": "Exactly one value for each of the product options",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VariantOption"
+          }
+        },
+        "price": {
+          "type": "number",
+          "format": "float",
+          "minimum": 0,
+          "exclusiveMinimum": true
+        },
+        "sku": {
+          "type": "string",
+          "maxLength": 64,
+          "minLength": 1,
+          "pattern": "^[A-Za-z0-9][A-Za-z0-9._-]*$"
+        },
+        "stock": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "weight": {
+          "description": "Metal weight in grams",
+          "type": "number",
+          "format": "float",
+          "minimum": 0
+        }
+      }
+    },
+    "VariantOption": {
+      "description": "The value a variant takes on one option axis.",
+      "type": "object",
+      "required": [
+        "name",
+        "value"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "example": "Purity"
+        },
+        "value": {
+          "type": "string",
+          "example": "18K"
+        }
+      }
+    },
+    "VariantUpdateRequest": {
+      "description": "Changes to a variant. Omitted fields are left unchanged; options replaces the whole combination.",
+      "type": "object",
+      "properties": {
+        "barcode": {
+          "description": "GTIN; an empty string clears it",
+          "type": "string",
+          "pattern": "^([0-9]{8,14})?$",
+          "x-nullable": true
+        },
+        "options": {
+          "description": "Exactly one value for each of the product options",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VariantOption"
+          }
+        },
+        "price": {
+          "type": "number",
+          "format": "float",
+          "minimum": 0,
+          "exclusiveMinimum": true,
+          "x-nullable": true
+        },
+        "sku": {
+          "type": "string",
+          "maxLength": 64,
+          "minLength": 1,
+          "pattern": "^[A-Za-z0-9][A-Za-z0-9._-]*$",
+          "x-nullable": true
+        },
+        "stock": {
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "weight": {
+          "description": "Metal weight in grams",
+          "type": "number",
+          "format": "float",
+          "minimum": 0,
+          "x-nullable": true
+        }
+      }
+    },
+    "VerifyEmailRequest": {
+      "description": "Token from the email verification link.",
+      "type": "object",
+      "required": [
+        "token"
+      ],
+      "properties": {
+        "token": {
+          "type": "string",
+          "example": "verify_token_xyz"
+        }
+      }
+    },
+    "VerifyOTPRequest": {
+      "type": "object",
+      "required": [
+        "identifier",
+        "otp"
+      ],
+      "properties": {
+        "identifier": {
+          "type": "string",
+          "example": "+919968177234"
+        },
+        "otp": {
+          "type": "string",
+          "example": "123456"
+        }
+      }
+    },
+    "VerifyOTPResponse": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "string",
+          "example": "Login successful"
+        },
+        "newUser": {
+          "description": "True when this login created the account",
+          "type": "boolean"
+        },
+        "refreshToken": {
+          "type": "string",
+          "example": "refresh-token-here"
+        },
+        "token": {
+          "type": "string",
+          "example": "jwt-token-here"
+        },
+        "user": {
+          "$ref": "#/definitions/User"
+        }
+      }
+    },
+    "VerifyPhoneRequest": {
+      "description": "Code sent to the phone by SMS.",
+      "type": "object",
+      "required": [
+        "code"
+      ],
+      "properties": {
+        "code": {
+          "type": "string",
+          "example": "123456"
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "apiKey": {
+      "description": "Back-office API key (admin operations only)",
+      "type": "apiKey",
+      "name": "X-API-Key",
+      "in": "header"
+    },
+    "basicAuth": {
+      "type": "basic"
+    },
+    "bearerAuth": {
+      "description": "Bearer token for authentication",
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "Adornme",
+    "version": "1.0.0"
+  },
+  "paths": {
+    "/.well-known/jwks.json": {
+      "get": {
+        "description": "JSON Web Key Set with every key that currently validates access and refresh tokens. Retired keys are dropped from the set once they stop validating.\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "System"
+        ],
+        "summary": "Public keys used to sign tokens",
+        "operationId": "getJWKS",
+        "responses": {
+          "200": {
+            "description": "Active signing keys",
+            "schema": {
+              "$ref": "#/definitions/JWKSet"
+            }
+          }
+        }
+      }
+    },
+    "/api-keys": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminAPIKeys"
+        ],
+        "summary": "List API keys",
+        "operationId": "listAPIKeys",
+        "responses": {
+          "200": {
+            "description": "API keys, newest first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/APIKey"
+              }
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      },
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminAPIKeys"
+        ],
+        "summary": "Issue an API key",
+        "operationId": "createAPIKey",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/APIKeyCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "API key created",
+            "schema": {
+              "$ref": "#/definitions/APIKeyCreated"
+            }
+          },
+          "400": {
+            "description": "Invalid name, scope or expiry",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/api-keys/{id}": {
+      "delete": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminAPIKeys"
+        ],
+        "summary": "Revoke an API key",
+        "operationId": "revokeAPIKey",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "API key revoked"
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "API key not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/auth/confirm-email-change": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Switch to a new email with the token from the link sent to it",
+        "operationId": "confirmEmailChange",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VerifyEmailRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Email changed",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid or expired token",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Email already in use",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/forgot-password": {
+      "post": {
+        "description": "Sends a reset password link to the user's email",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Request password reset",
+        "operationId": "forgetPassword",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "email"
+              ],
+              "properties": {
+                "email": {
+                  "type": "string",
+                  "example": "user@example.com"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Reset link sent (if email exists)",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "message": {
+                  "type": "string",
+                  "example": "If the email exists, a reset link has been sent"
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Invalid refresh token",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/identify": {
+      "post": {
+        "description": "Determines whether a user exists and their verification status",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Identify user by email or phone",
+        "operationId": "identifyUser",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "identifier"
+              ],
+              "properties": {
+                "identifier": {
+                  "type": "string",
+                  "example": "user@example.com"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Identification result"
+          },
+          "400": {
+            "description": "Invalid identifier",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many requests from this client",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/login": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Login user",
+        "operationId": "loginUser",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/LoginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Login successful",
+            "schema": {
+              "$ref": "#/definitions/AuthResponse"
+            }
+          },
+          "202": {
+            "description": "Password accepted, second factor required",
+            "schema": {
+              "$ref": "#/definitions/MFAChallenge"
+            }
+          },
+          "401": {
+            "description": "Invalid credentials",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many failed attempts, account or client temporarily locked",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/logout": {
+      "post": {
+        "tags": [
+          "Users"
+        ],
+        "summary": "Logout user",
+        "operationId": "logoutUser",
+        "responses": {
+          "200": {
+            "description": "Logout successful",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/magic-link": {
+      "post": {
+        "description": "Emails a short-lived, single-use sign-in link. The response is the same whether or not the account exists.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Send a magic sign-in link",
+        "operationId": "sendMagicLink",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MagicLinkRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Link sent (if the account exists)",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid email or device id",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many links requested for this email or from this client",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/magic-link/verify": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Sign in with a magic link",
+        "operationId": "verifyMagicLink",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MagicLinkVerifyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Signed in",
+            "schema": {
+              "$ref": "#/definitions/AuthResponse"
+            }
+          },
+          "202": {
+            "description": "Link accepted, second factor required",
+            "schema": {
+              "$ref": "#/definitions/MFAChallenge"
+            }
+          },
+          "401": {
+            "description": "Link invalid, expired or already used",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Link was requested from another device",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/mfa/enroll": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Enrol an authenticator during a login that requires MFA",
+        "operationId": "enrollMFA",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MFAEnrollRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Enrolment started, confirm it via /auth/mfa/verify",
+            "schema": {
+              "$ref": "#/definitions/MFAEnrollment"
+            }
+          },
+          "400": {
+            "description": "MFA already enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Invalid or expired token",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/mfa/verify": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Complete a login with an authenticator or recovery code",
+        "operationId": "verifyMFA",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MFAVerifyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Login successful",
+            "schema": {
+              "$ref": "#/definitions/AuthResponse"
+            }
+          },
+          "401": {
+            "description": "Invalid or expired token or code",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many wrong codes, log in again",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/otp/resend": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Resend OTP",
+        "operationId": "resendOTP",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SendOTPRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OTP resent",
+            "schema": {
+              "$ref": "#/definitions/GenericResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid identifier",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "An OTP was sent recently, retry after the cooldown",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/otp/send": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Send OTP to email or phone",
+        "operationId": "OTPLogin",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SendOTPRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OTP sent response",
+            "schema": {
+              "$ref": "#/definitions/GenericResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid identifier",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "A code was sent recently or too many requests from this client",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/otp/verify": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Verify OTP and login user",
+        "operationId": "VerifyOTP",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VerifyOTPRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful login",
+            "schema": {
+              "$ref": "#/definitions/VerifyOTPResponse"
+            }
+          },
+          "202": {
+            "description": "Code accepted, second factor required",
+            "schema": {
+              "$ref": "#/definitions/MFAChallenge"
+            }
+          },
+          "400": {
+            "description": "Invalid identifier",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Invalid or expired OTP",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many wrong attempts, request a new OTP",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/passkey/login": {
+      "post": {
+        "description": "Signs in with a passkey. Without mfaToken the authenticator must verify the user (biometric or PIN), which also satisfies MFA.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Finish a passkey login",
+        "operationId": "loginWithPasskey",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PasskeyLoginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Login successful",
+            "schema": {
+              "$ref": "#/definitions/AuthResponse"
+            }
+          },
+          "401": {
+            "description": "Passkey not recognised, assertion rejected or ceremony expired",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/passkey/options": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Start a passkey login",
+        "operationId": "beginPasskeyLogin",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PasskeyLoginOptionsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Request options for navigator.credentials.get()",
+            "schema": {
+              "$ref": "#/definitions/PasskeyOptions"
+            }
+          },
+          "401": {
+            "description": "Invalid or expired mfaToken",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many requests from this client",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/refresh-token": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Refresh authentication token",
+        "operationId": "refreshToken",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RefreshTokenRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Token refreshed successfully",
+            "schema": {
+              "$ref": "#/definitions/AuthResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid refresh token",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/register": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Register a new user",
+        "operationId": "registerUser",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RegisterRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "User registered successfully",
+            "schema": {
+              "$ref": "#/definitions/AuthResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Email or phone already in use",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many wrong attempts, request a new code",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/reset-password": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Reset password",
+        "operationId": "resetPassword",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ResetPasswordRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Password reset successful",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid or expired token, or password rejected by policy",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/auth/verify-email": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Confirm an email address with the link token",
+        "operationId": "verifyEmail",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VerifyEmailRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Email verified",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid or expired token",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/cart": {
+      "get": {
+        "tags": [
+          "Cart"
+        ],
+        "summary": "Get current user's cart",
+        "operationId": "getCart",
+        "responses": {
+          "200": {
+            "description": "Current shopping cart",
+            "schema": {
+              "$ref": "#/definitions/Cart"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      },
+      "put": {
+        "tags": [
+          "Cart"
+        ],
+        "summary": "Update item quantity in cart",
+        "operationId": "updateCartItem",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CartItemUpdateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Cart updated",
+            "schema": {
+              "$ref": "#/definitions/Cart"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      },
+      "post": {
+        "tags": [
+          "Cart"
+        ],
+        "summary": "Add item to cart",
+        "operationId": "addItemToCart",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CartItemRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Item added to cart",
+            "schema": {
+              "$ref": "#/definitions/Cart"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      },
+      "delete": {
+        "tags": [
+          "Cart"
+        ],
+        "summary": "Clear cart",
+        "operationId": "clearCart",
+        "responses": {
+          "204": {
+            "description": "Cart cleared"
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/categories": {
+      "get": {
+        "description": "All categories as a tree, top level first, siblings ordered by position. Served from cache.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Categories"
+        ],
+        "summary": "Category tree",
+        "operationId": "listCategories",
+        "responses": {
+          "200": {
+            "description": "Top-level categories with their subcategories",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/CategoryNode"
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminCategories"
+        ],
+        "summary": "Create a category",
+        "operationId": "createCategory",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CategoryCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Category created",
+            "schema": {
+              "$ref": "#/definitions/Category"
+            }
+          },
+          "400": {
+            "description": "Validation error or unknown parent",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Slug already in use",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
+      }
+    },
+    "/categories/{id}": {
+      "put": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminCategories"
+        ],
+        "summary": "Update or move a category",
+        "operationId": "updateCategory",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CategoryUpdateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Category updated",
+            "schema": {
+              "$ref": "#/definitions/Category"
+            }
+          },
+          "400": {
+            "description": "Validation error, unknown parent or move into its own subtree",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Category not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Slug already in use",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
+      },
+      "delete": {
+        "description": "Only empty categories can be deleted: no subcategories and no live products.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminCategories"
+        ],
+        "summary": "Delete a category",
+        "operationId": "deleteCategory",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Category deleted"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Category not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Category still has subcategories or products",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
+      }
+    },
+    "/categories/{id}/attributes": {
+      "get": {
+        "description": "Attributes products in the category can have: its own and those inherited from its parents, ordered by position.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Categories"
+        ],
+        "summary": "Attributes of a category",
+        "operationId": "listCategoryAttributes",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Attribute definitions",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/AttributeDefinition"
+              }
+            }
+          },
+          "404": {
+            "description": "Category not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
+      },
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminCategories"
+        ],
+        "summary": "Define an attribute on a category",
+        "operationId": "createCategoryAttribute",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AttributeDefinitionCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Attribute defined",
+            "schema": {
+              "$ref": "#/definitions/AttributeDefinition"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Category not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The category already defines this code",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
       }
     },
-    "PaymentInitiateRequest": {
-      "description": "Request to initiate a payment.",
-      "type": "object",
-      "required": [
-        "orderId",
-        "method"
-      ],
-      "properties": {
-        "method": {
-          "type": "string"
+    "/categories/{id}/attributes/{code}": {
+      "put": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminCategories"
+        ],
+        "summary": "Update an attribute definition",
+        "operationId": "updateCategoryAttribute",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "code",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AttributeDefinitionUpdateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Attribute updated",
+            "schema": {
+              "$ref": "#/definitions/AttributeDefinition"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Category or attribute not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "orderId": {
-          "type": "integer"
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
+      },
+      "delete": {
+        "description": "Products keep their stored values until they are next saved, when values no longer defined are dropped.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminCategories"
+        ],
+        "summary": "Remove an attribute definition",
+        "operationId": "deleteCategoryAttribute",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "code",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Attribute removed"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Category or attribute not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
+      }
+    },
+    "/health": {
+      "get": {
+        "description": "Returns the overall system health status along with the health, uptime, and latency of individual dependencies like Redis, MongoDB, PostgreSQL, OpenSearch, and MinIO.\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "System"
+        ],
+        "summary": "Health check endpoint",
+        "operationId": "getHealth",
+        "responses": {
+          "200": {
+            "description": "Adornme Service health details",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "dependencies": {
+                  "type": "object",
+                  "additionalProperties": {
+                    "$ref": "#/definitions/DependenciesAnon"
+                  },
+                  "example": {
+                    "minio": {
+                      "lastChecked": "2025-11-09T17:25:30Z",
+                      "latencyMs": 7.3,
+                      "status": "healthy",
+                      "uptime": "60h08m17s"
+                    },
+                    "mongodb": {
+                      "lastChecked": "2025-11-09T17:25:30Z",
+                      "latencyMs": 5.1,
+                      "status": "healthy",
+                      "uptime": "35h59m11s"
+                    },
+                    "opensearch": {
+                      "lastChecked": "2025-11-09T17:25:30Z",
+                      "latencyMs": 4.6,
+                      "status": "healthy",
+                      "uptime": "71h44m21s"
+                    },
+                    "postgres": {
+                      "lastChecked": "2025-11-09T17:25:30Z",
+                      "latencyMs": 3.8,
+                      "status": "healthy",
+                      "uptime": "72h14m03s"
+                    },
+                    "redis": {
+                      "lastChecked": "2025-11-09T17:25:30Z",
+                      "latencyMs": 2.4,
+                      "status": "healthy",
+                      "uptime": "36h42m01s"
+                    }
+                  }
+                },
+                "description": {
+                  "description": "Human-readable summary of the system health",
+                  "type": "string",
+                  "example": "All dependencies are healthy and running smoothly"
+                },
+                "status": {
+                  "description": "Overall system status (ok, degraded, or down)",
+                  "type": "string",
+                  "example": "ok"
+                },
+                "timestamp": {
+                  "description": "UTC timestamp when the health check was performed",
+                  "type": "string",
+                  "format": "date-time",
+                  "example": "2025-11-09T17:27:57Z"
+                },
+                "uptime": {
+                  "description": "Application uptime since last start",
+                  "type": "string",
+                  "example": "72h35m10s"
+                }
+              }
+            }
+          }
         }
       }
     },
-    "PaymentInitiateResponse": {
-      "description": "Response after payment initiation.",
-      "type": "object",
-      "properties": {
-        "gatewayUrl": {
-          "type": "string"
+    "/orders": {
+      "get": {
+        "tags": [
+          "Orders"
+        ],
+        "summary": "List user's orders",
+        "operationId": "listOrders",
+        "parameters": [
+          {
+            "type": "integer",
+            "default": 1,
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "default": 10,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Paginated list of orders",
+            "schema": {
+              "$ref": "#/definitions/OrderListResponse"
+            }
+          }
         },
-        "paymentId": {
-          "type": "string"
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      },
+      "post": {
+        "tags": [
+          "Orders"
+        ],
+        "summary": "Place an order from cart",
+        "operationId": "placeOrder",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/OrderCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Order placed successfully",
+            "schema": {
+              "$ref": "#/definitions/Order"
+            }
+          },
+          "400": {
+            "description": "Invalid order request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Contact not verified",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "status": {
-          "type": "string"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "Principal": {
-      "type": "object",
-      "properties": {
-        "authEmail": {
-          "type": "string"
-        },
-        "authId": {
-          "type": "string"
-        },
-        "authName": {
-          "type": "string"
-        },
-        "crn": {
-          "description": "Workaround for AT event tracker",
-          "type": "string"
-        },
-        "region": {
-          "description": "Workaround for API's not having region in their context",
-          "type": "string"
-        },
-        "token": {
-          "description": "OAuth2 Token",
-          "$ref": "#/definitions/Token"
-        },
-        "userAccess": {
-          "description": "User Access",
-          "$ref": "#/definitions/UserAccess"
-        },
-        "userEmail": {
-          "description": "Email of User",
-          "type": "string"
-        },
-        "userID": {
-          "description": "ID of User",
-          "type": "string"
+    "/orders/{id}": {
+      "get": {
+        "tags": [
+          "Orders"
+        ],
+        "summary": "Get order details",
+        "operationId": "getOrder",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Order details",
+            "schema": {
+              "$ref": "#/definitions/Order"
+            }
+          },
+          "404": {
+            "description": "Order not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "userInfo": {
-          "description": "Object that keeps track of user authentication info",
-          "$ref": "#/definitions/UserAuthenticationInfo"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "Product": {
-      "description": "Represents a product available in the catalog.",
-      "type": "object",
-      "required": [
-        "id",
-        "name",
-        "price",
-        "stock",
-        "categoryId"
-      ],
-      "properties": {
-        "categoryId": {
-          "type": "integer",
-          "example": 5
-        },
-        "createdAt": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "description": {
-          "type": "string",
-          "example": "22K pure gold necklace with intricate design"
-        },
-        "id": {
-          "type": "integer",
-          "example": 101
+    "/payments/initiate": {
+      "post": {
+        "tags": [
+          "Payments"
+        ],
+        "summary": "Initiate a new payment",
+        "operationId": "initiatePayment",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PaymentInitiateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Payment initiated",
+            "schema": {
+              "$ref": "#/definitions/PaymentInitiateResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Contact not verified",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "images": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/payments/{id}": {
+      "get": {
+        "tags": [
+          "Payments"
+        ],
+        "summary": "Get payment details",
+        "operationId": "getPayment",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Payment details",
+            "schema": {
+              "$ref": "#/definitions/Payment"
+            }
           },
-          "example": [
-            "https://cdn.adornme.com/products/101-1.jpg",
-            "https://cdn.adornme.com/products/101-2.jpg"
-          ]
-        },
-        "name": {
-          "type": "string",
-          "example": "Gold Necklace"
-        },
-        "price": {
-          "type": "number",
-          "format": "float",
-          "example": 14999.99
-        },
-        "stock": {
-          "type": "integer",
-          "example": 20
+          "404": {
+            "description": "Payment not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "updatedAt": {
-          "type": "string",
-          "format": "date-time"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "ProductCreateRequest": {
-      "description": "Request to create a new product.",
-      "type": "object",
-      "required": [
-        "name",
-        "price",
-        "stock",
-        "categoryId"
-      ],
-      "properties": {
-        "categoryId": {
-          "type": "integer"
-        },
-        "description": {
-          "type": "string"
-        },
-        "images": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/payments/{id}/confirm": {
+      "post": {
+        "tags": [
+          "Payments"
+        ],
+        "summary": "Confirm a payment",
+        "operationId": "confirmPayment",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PaymentConfirmRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Payment confirmed",
+            "schema": {
+              "$ref": "#/definitions/Payment"
+            }
+          },
+          "400": {
+            "description": "Invalid confirmation data",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        },
-        "name": {
-          "type": "string"
-        },
-        "price": {
-          "type": "number",
-          "format": "float"
-        },
-        "stock": {
-          "type": "integer"
         }
       }
     },
-    "ProductListResponse": {
-      "description": "Paginated list of products.",
-      "type": "object",
-      "properties": {
-        "items": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Product"
+    "/payments/{id}/refund": {
+      "post": {
+        "tags": [
+          "Payments"
+        ],
+        "summary": "Refund a payment",
+        "operationId": "refundPayment",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RefundRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Refund processed",
+            "schema": {
+              "$ref": "#/definitions/Payment"
+            }
+          },
+          "400": {
+            "description": "Invalid refund request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Payment not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "limit": {
-          "type": "integer",
-          "example": 20
-        },
-        "page": {
-          "type": "integer",
-          "example": 1
-        },
-        "total": {
-          "type": "integer",
-          "example": 100
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "ProductUpdateRequest": {
-      "description": "Request to update product details.",
-      "type": "object",
-      "properties": {
-        "categoryId": {
-          "type": "integer"
-        },
-        "description": {
-          "type": "string"
-        },
-        "images": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/products": {
+      "get": {
+        "description": "Filter by attribute with attr.\u003ccode\u003e=\u003cvalue\u003e query parameters, e.g. attr.metal=gold\u0026attr.stone=diamond. Repeat a parameter to match any of several values; numeric attributes also take a min..max range with either end optional.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Products"
+        ],
+        "summary": "List products",
+        "operationId": "listProducts",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "default": 1,
+            "description": "Page number",
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 20,
+            "description": "Number of items per page",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Search term matched against name and description",
+            "name": "search",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Filter products by category ID, including its subcategories",
+            "name": "categoryId",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "number",
+            "description": "Lowest price, inclusive",
+            "name": "minPrice",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "number",
+            "description": "Highest price, inclusive",
+            "name": "maxPrice",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only products with stock left",
+            "name": "inStock",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "newest",
+              "price_asc",
+              "price_desc",
+              "popularity"
+            ],
+            "type": "string",
+            "default": "newest",
+            "description": "Sort order",
+            "name": "sort",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A paginated list of products",
+            "schema": {
+              "$ref": "#/definitions/ProductListResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid query parameters or attr filter",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminProducts"
+        ],
+        "summary": "Create a new product",
+        "operationId": "createProduct",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ProductCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Product created successfully",
+            "schema": {
+              "$ref": "#/definitions/Product"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "name": {
-          "type": "string"
-        },
-        "price": {
-          "type": "number",
-          "format": "float"
-        },
-        "stock": {
-          "type": "integer"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
       }
     },
-    "RefreshTokenRequest": {
-      "description": "Payload to refresh authentication token.",
-      "type": "object",
-      "required": [
-        "refreshToken"
-      ],
-      "properties": {
-        "refreshToken": {
-          "type": "string",
-          "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+    "/products/{id}": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Products"
+        ],
+        "summary": "Get product by ID",
+        "operationId": "getProduct",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Product details",
+            "schema": {
+              "$ref": "#/definitions/Product"
+            }
+          },
+          "404": {
+            "description": "Product not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         }
-      }
-    },
-    "RefundRequest": {
-      "description": "Request to process a refund.",
-      "type": "object",
-      "required": [
-        "amount",
-        "reason"
-      ],
-      "properties": {
-        "amount": {
-          "type": "number",
-          "format": "float"
+      },
+      "put": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminProducts"
+        ],
+        "summary": "Update a product",
+        "operationId": "updateProduct",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ProductUpdateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Product updated",
+            "schema": {
+              "$ref": "#/definitions/Product"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Product not found or archived",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Product was changed since the given version; reload and retry",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "reason": {
-          "type": "string"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
+      },
+      "delete": {
+        "description": "Archives the product. It disappears from listings but still resolves by id for existing orders.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminProducts"
+        ],
+        "summary": "Delete a product",
+        "operationId": "deleteProduct",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Product deleted"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Product not found or already archived",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
       }
     },
-    "RegisterRequest": {
-      "description": "Payload to register a new user.",
-      "type": "object",
-      "required": [
-        "name",
-        "email",
-        "password"
-      ],
-      "properties": {
-        "email": {
-          "type": "string",
-          "format": "email",
-          "example": "paras@example.com"
-        },
-        "name": {
-          "type": "string",
-          "example": "Paras Jain"
-        },
-        "password": {
-          "type": "string",
-          "example": "strongPassword123"
+    "/products/{id}/variants": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminProducts"
+        ],
+        "summary": "Add a variant to a product",
+        "operationId": "createVariant",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VariantCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Variant created",
+            "schema": {
+              "$ref": "#/definitions/ProductVariant"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Product not found or archived",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "SKU or barcode already in use, or the product already has a variant with these options",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "phone": {
-          "type": "string",
-          "example": 919876543210
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
       }
     },
-    "ResetPasswordRequest": {
-      "description": "Request to reset password with token.",
-      "type": "object",
-      "required": [
-        "token",
-        "newPassword"
-      ],
-      "properties": {
-        "newPassword": {
-          "type": "string",
-          "example": "strongNewPassword123"
+    "/products/{id}/variants/{variantId}": {
+      "put": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminProducts"
+        ],
+        "summary": "Update a product variant",
+        "operationId": "updateVariant",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "name": "variantId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VariantUpdateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Variant updated",
+            "schema": {
+              "$ref": "#/definitions/ProductVariant"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Product or variant not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "SKU or barcode already in use, or the product already has a variant with these options",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "token": {
-          "type": "string",
-          "example": "reset_token_xyz"
-        }
-      }
-    },
-    "SendOTPRequest": {
-      "type": "object",
-      "required": [
-        "identifier"
-      ],
-      "properties": {
-        "identifier": {
-          "description": "Email or phone number",
-          "type": "string",
-          "example": "+919968177234"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
+      },
+      "delete": {
+        "description": "Archives the variant. It leaves the product but stays resolvable for existing carts and orders.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminProducts"
+        ],
+        "summary": "Delete a product variant",
+        "operationId": "deleteVariant",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "name": "variantId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Variant deleted"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Product or variant not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
       }
     },
-    "ShippingOption": {
-      "description": "Represents available shipping option.",
-      "type": "object",
-      "properties": {
-        "cost": {
-          "type": "number",
-          "format": "float"
-        },
-        "estimatedDays": {
-          "type": "integer"
+    "/shipping/addresses": {
+      "get": {
+        "tags": [
+          "Shipping"
+        ],
+        "summary": "Get all addresses for logged-in user",
+        "operationId": "listShippingAddresses",
+        "responses": {
+          "200": {
+            "description": "List of user addresses",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Address"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized"
+          }
         },
-        "id": {
-          "type": "integer"
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      },
+      "post": {
+        "tags": [
+          "Shipping"
+        ],
+        "summary": "Add a new shipping address",
+        "operationId": "addShippingAddress",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AddressCreateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Address added successfully",
+            "schema": {
+              "$ref": "#/definitions/Address"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "name": {
-          "type": "string"
-        }
-      }
-    },
-    "SuccessResponse": {
-      "description": "Standard success response.",
-      "type": "object",
-      "required": [
-        "message"
-      ],
-      "properties": {
-        "message": {
-          "type": "string",
-          "example": "Operation successful"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "Token": {
-      "type": "object",
-      "required": [
-        "accessToken",
-        "type",
-        "refreshToken",
-        "expires"
-      ],
-      "properties": {
-        "accessToken": {
-          "description": "AccessToken",
-          "type": "string"
-        },
-        "expires": {
-          "description": "Token Expires",
-          "type": "string",
-          "format": "date-time"
+    "/shipping/addresses/{id}": {
+      "put": {
+        "tags": [
+          "Shipping"
+        ],
+        "summary": "Update a shipping address",
+        "operationId": "updateShippingAddress",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AddressUpdateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Address updated",
+            "schema": {
+              "$ref": "#/definitions/Address"
+            }
+          },
+          "404": {
+            "description": "Address not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "refreshToken": {
-          "description": "Refresh Token",
-          "type": "string"
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      },
+      "delete": {
+        "tags": [
+          "Shipping"
+        ],
+        "summary": "Delete a shipping address",
+        "operationId": "deleteShippingAddress",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Address deleted"
+          },
+          "404": {
+            "description": "Address not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "type": {
-          "description": "Token Type",
-          "type": "string"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "Tracking": {
-      "description": "Represents order tracking details.",
-      "type": "object",
-      "properties": {
-        "carrier": {
-          "type": "string"
-        },
-        "estimatedDelivery": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "orderId": {
-          "type": "integer"
-        },
-        "status": {
-          "type": "string",
-          "enum": [
-            "pending",
-            "dispatched",
-            "in_transit",
-            "delivered",
-            "cancelled"
-          ]
-        },
-        "trackingNumber": {
-          "type": "string"
+    "/shipping/options": {
+      "get": {
+        "tags": [
+          "Shipping"
+        ],
+        "summary": "Get available shipping options",
+        "operationId": "listShippingOptions",
+        "responses": {
+          "200": {
+            "description": "List of shipping options",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ShippingOption"
+              }
+            }
+          }
         }
       }
     },
-    "User": {
-      "description": "Represents a registered user in the system.",
-      "type": "object",
-      "required": [
-        "id",
-        "email",
-        "name"
-      ],
-      "properties": {
-        "createdAt": {
-          "description": "User creation timestamp",
-          "type": "string",
-          "format": "date-time"
-        },
-        "email": {
-          "description": "User email address",
-          "type": "string",
-          "format": "email",
-          "example": "paras@example.com"
-        },
-        "id": {
-          "description": "Unique user identifier",
-          "type": "integer",
-          "example": 101
-        },
-        "name": {
-          "description": "Full name of the user",
-          "type": "string",
-          "example": "Paras Jain"
-        },
-        "password": {
-          "description": "Password of user",
-          "type": "string"
+    "/shipping/track/{orderId}": {
+      "get": {
+        "tags": [
+          "Shipping"
+        ],
+        "summary": "Track shipment for an order",
+        "operationId": "trackShipment",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "orderId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shipment tracking details",
+            "schema": {
+              "$ref": "#/definitions/Tracking"
+            }
+          },
+          "404": {
+            "description": "Order or shipment not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "phone": {
-          "description": "User phone number",
-          "type": "string",
-          "example": 919876543210
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/users": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "AdminUsers"
+        ],
+        "summary": "List users with filters",
+        "operationId": "listUsers",
+        "parameters": [
+          {
+            "type": "integer",
+            "default": 1,
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Email contains (case-insensitive)",
+            "name": "email",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Phone contains",
+            "name": "phone",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Created at or after",
+            "name": "createdFrom",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Created before",
+            "name": "createdTo",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Has (or lacks) a verified email or phone",
+            "name": "verified",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "disabled",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "admin",
+              "catalog-manager",
+              "support",
+              "customer"
+            ],
+            "type": "string",
+            "name": "role",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "List of users",
+            "schema": {
+              "$ref": "#/definitions/UserListResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid filter",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "updatedAt": {
-          "description": "Last update timestamp",
-          "type": "string",
-          "format": "date-time"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
       }
     },
-    "UserAccess": {
-      "description": "Keeps tracks of individual user's access and roles",
-      "type": "object",
-      "properties": {
-        "_id": {
-          "description": "User ID",
-          "type": "string",
-          "x-go-custom-tag": "datastore:\"id\"",
-          "x-go-name": "UserID"
-        },
-        "_rev": {
-          "type": "string",
-          "x-go-custom-tag": "datastore:\"-\""
-        },
-        "baseRole": {
-          "description": "Base Role for this User",
-          "type": "string",
-          "default": "user-readonly",
-          "enum": [
-            "user-admin",
-            "user-readonly",
-            "service-admin",
-            "service-readonly",
-            "disabled",
-            "pending"
-          ],
-          "x-go-custom-tag": "datastore:\"baseRole\"",
-          "x-isnullable": false
+    "/users/me": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Get logged-in user profile",
+        "operationId": "getUserProfile",
+        "responses": {
+          "200": {
+            "description": "User profile details",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "memberOf": {
-          "description": "Member of (Tenants)",
-          "type": "array",
-          "items": {
-            "type": "string"
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      },
+      "put": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Update logged-in user profile",
+        "operationId": "updateUserProfile",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/UserUpdateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Profile updated successfully",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
           },
-          "x-go-custom-tag": "datastore:\"memberOf\""
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Current password is incorrect",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Email or phone already in use",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "notes": {
-          "description": "Notes for development (not used internally)",
-          "type": "string",
-          "x-go-custom-tag": "datastore:\"notes,noindex,omitempty\""
-        }
-      }
-    },
-    "UserAuthenticationInfo": {
-      "type": "object",
-      "properties": {
-        "credentialType": {
-          "description": "Workaround for AT event tracker",
-          "type": "string"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "UserUpdateRequest": {
-      "description": "Payload to update user profile.",
-      "type": "object",
-      "properties": {
-        "name": {
-          "type": "string",
-          "example": "Paras Jain"
+    "/users/me/data-export": {
+      "post": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Start an export of all personal data held about the logged-in user",
+        "operationId": "requestDataExport",
+        "responses": {
+          "202": {
+            "description": "Export job queued (or the one already running)",
+            "schema": {
+              "$ref": "#/definitions/PrivacyJob"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "phone": {
-          "type": "string",
-          "example": 919876543210
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "VerifyOTPRequest": {
-      "type": "object",
-      "required": [
-        "identifier",
-        "otp"
-      ],
-      "properties": {
-        "identifier": {
-          "type": "string",
-          "example": "+919968177234"
+    "/users/me/erasure": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Erase the logged-in user's personal data from every store; orders and payments are kept without personal data",
+        "operationId": "requestErasure",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ErasureRequest"
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Erasure job queued (or the one already running)",
+            "schema": {
+              "$ref": "#/definitions/PrivacyJob"
+            }
+          },
+          "400": {
+            "description": "Validation error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Current password is incorrect",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "otp": {
-          "type": "string",
-          "example": "123456"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "VerifyOTPResponse": {
-      "type": "object",
-      "properties": {
-        "message": {
-          "type": "string",
-          "example": "Login successful"
+    "/users/me/mfa": {
+      "post": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Start TOTP enrolment for the current user",
+        "operationId": "startMFAEnrollment",
+        "responses": {
+          "200": {
+            "description": "Enrolment started, confirm it with a code",
+            "schema": {
+              "$ref": "#/definitions/MFAEnrollment"
+            }
+          },
+          "400": {
+            "description": "MFA already enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "token": {
-          "type": "string",
-          "example": "jwt-token-here"
-        }
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
-    }
-  },
-  "securityDefinitions": {
-    "apiKey": {
-      "type": "apiKey",
-      "name": "x-api-key",
-      "in": "header"
-    },
-    "basicAuth": {
-      "type": "basic"
     },
-    "bearerAuth": {
-      "description": "Bearer token for authentication",
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "Adornme",
-    "version": "1.0.0"
-  },
-  "paths": {
-    "/auth/forgot-password": {
+    "/users/me/mfa/confirm": {
       "post": {
-        "description": "Sends a reset password link to the user's email",
         "consumes": [
           "application/json"
         ],
@@ -2362,52 +9458,47 @@ func init() {
         "tags": [
           "Users"
         ],
-        "summary": "Request password reset",
-        "operationId": "forgetPassword",
+        "summary": "Turn MFA on with a first authenticator code",
+        "operationId": "confirmMFA",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "required": [
-                "email"
-              ],
-              "properties": {
-                "email": {
-                  "type": "string",
-                  "example": "user@example.com"
-                }
-              }
+              "$ref": "#/definitions/MFACodeRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Reset link sent (if email exists)",
+            "description": "MFA enabled",
             "schema": {
-              "type": "object",
-              "properties": {
-                "message": {
-                  "type": "string",
-                  "example": "If the email exists, a reset link has been sent"
-                }
-              }
+              "$ref": "#/definitions/SuccessResponse"
             }
           },
           "400": {
-            "description": "Invalid refresh token",
+            "description": "Invalid code or nothing to confirm",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "/auth/identify": {
+    "/users/me/mfa/disable": {
       "post": {
-        "description": "Determines whether a user exists and their verification status",
         "consumes": [
           "application/json"
         ],
@@ -2417,41 +9508,84 @@ func init() {
         "tags": [
           "Users"
         ],
-        "summary": "Identify user by email or phone",
-        "operationId": "identifyUser",
+        "summary": "Turn MFA off (not allowed for staff accounts)",
+        "operationId": "disableMFA",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "required": [
-                "identifier"
-              ],
-              "properties": {
-                "identifier": {
-                  "type": "string",
-                  "example": "user@example.com"
-                }
-              }
+              "$ref": "#/definitions/MFACodeRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Identification result"
+            "description": "MFA disabled",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
           },
           "400": {
-            "description": "Invalid identifier",
+            "description": "Invalid code or MFA not enabled",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "MFA is mandatory for this account",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "/auth/login": {
+    "/users/me/passkeys": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "List passkeys",
+        "operationId": "listPasskeys",
+        "responses": {
+          "200": {
+            "description": "Passkeys of the logged-in user",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Passkey"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      },
       "post": {
         "consumes": [
           "application/json"
@@ -2462,46 +9596,66 @@ func init() {
         "tags": [
           "Users"
         ],
-        "summary": "Login user",
-        "operationId": "loginUser",
+        "summary": "Finish passkey registration",
+        "operationId": "registerPasskey",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/LoginRequest"
+              "$ref": "#/definitions/PasskeyRegistrationRequest"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Login successful",
+          "201": {
+            "description": "Passkey registered",
             "schema": {
-              "$ref": "#/definitions/AuthResponse"
+              "$ref": "#/definitions/Passkey"
+            }
+          },
+          "400": {
+            "description": "Attestation rejected or ceremony expired",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
-            "description": "Invalid credentials",
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "This authenticator is already registered",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "/auth/logout": {
+    "/users/me/passkeys/options": {
       "post": {
+        "produces": [
+          "application/json"
+        ],
         "tags": [
           "Users"
         ],
-        "summary": "Logout user",
-        "operationId": "logoutUser",
+        "summary": "Start passkey registration",
+        "operationId": "beginPasskeyRegistration",
         "responses": {
           "200": {
-            "description": "Logout successful",
+            "description": "Creation options for navigator.credentials.create()",
             "schema": {
-              "$ref": "#/definitions/SuccessResponse"
+              "$ref": "#/definitions/PasskeyOptions"
             }
           },
           "401": {
@@ -2510,10 +9664,58 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "/auth/otp/resend": {
+    "/users/me/passkeys/{id}": {
+      "delete": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Users"
+        ],
+        "summary": "Remove a passkey",
+        "operationId": "deletePasskey",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Passkey removed"
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Passkey not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
+      }
+    },
+    "/users/me/phone/confirm": {
       "post": {
         "consumes": [
           "application/json"
@@ -2524,229 +9726,262 @@ func init() {
         "tags": [
           "Users"
         ],
-        "summary": "Resend OTP",
+        "summary": "Switch to a new phone with the code sent to it",
+        "operationId": "confirmPhoneChange",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SendOTPRequest"
+              "$ref": "#/definitions/VerifyPhoneRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Phone changed",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "400": {
+            "description": "Invalid or expired code, or no pending change",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Phone already in use",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "Too many wrong attempts, request a new code",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "OTP resent"
+        },
+        "security": [
+          {
+            "bearerAuth": []
           }
-        }
+        ]
       }
     },
-    "/auth/otp/send": {
-      "post": {
-        "consumes": [
-          "application/json"
-        ],
+    "/users/me/privacy-jobs/{id}": {
+      "get": {
         "produces": [
           "application/json"
         ],
         "tags": [
           "Users"
         ],
-        "summary": "Send OTP to email or phone",
-        "operationId": "OTPLogin",
+        "summary": "Progress of a data export or erasure job",
+        "operationId": "getPrivacyJob",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SendOTPRequest"
-            }
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "OTP sent response",
+            "description": "Job status",
             "schema": {
-              "$ref": "#/definitions/GenericResponse"
+              "$ref": "#/definitions/PrivacyJob"
             }
           },
-          "400": {
-            "description": "Invalid identifier",
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Job not found",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "/auth/otp/verify": {
-      "post": {
-        "consumes": [
-          "application/json"
-        ],
+    "/users/me/sessions": {
+      "get": {
         "produces": [
           "application/json"
         ],
         "tags": [
           "Users"
         ],
-        "summary": "Verify OTP and login user",
-        "operationId": "VerifyOTP",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/VerifyOTPRequest"
-            }
-          }
-        ],
+        "summary": "List active sessions (devices) of the logged-in user",
+        "operationId": "listUserSessions",
         "responses": {
           "200": {
-            "description": "Successful login"
+            "description": "Active sessions",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Session"
+              }
+            }
           },
           "401": {
-            "description": "Invalid OTP",
+            "description": "Unauthorized",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "/auth/refresh-token": {
-      "post": {
-        "consumes": [
-          "application/json"
-        ],
+    "/users/me/sessions/{id}": {
+      "delete": {
         "produces": [
           "application/json"
         ],
         "tags": [
           "Users"
         ],
-        "summary": "Refresh authentication token",
-        "operationId": "refreshToken",
+        "summary": "Sign out a single session (device)",
+        "operationId": "revokeUserSession",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/RefreshTokenRequest"
-            }
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Token refreshed successfully",
+          "204": {
+            "description": "Session revoked"
+          },
+          "401": {
+            "description": "Unauthorized",
             "schema": {
-              "$ref": "#/definitions/AuthResponse"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "400": {
-            "description": "Invalid refresh token",
+          "404": {
+            "description": "Session not found",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "/auth/register": {
+    "/users/me/verify/email": {
       "post": {
-        "consumes": [
-          "application/json"
-        ],
         "produces": [
           "application/json"
         ],
         "tags": [
           "Users"
         ],
-        "summary": "Register a new user",
-        "operationId": "registerUser",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/RegisterRequest"
-            }
-          }
-        ],
+        "summary": "Send a verification link to the user's email",
+        "operationId": "sendEmailVerification",
         "responses": {
-          "201": {
-            "description": "User registered successfully",
+          "200": {
+            "description": "Verification link sent",
             "schema": {
-              "$ref": "#/definitions/AuthResponse"
+              "$ref": "#/definitions/SuccessResponse"
             }
           },
           "400": {
-            "description": "Validation error",
+            "description": "No email on the account or already verified",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          }
+        ]
       }
     },
-    "/auth/reset-password": {
+    "/users/me/verify/phone": {
       "post": {
         "consumes": [
           "application/json"
         ],
+        "produces": [
+          "application/json"
+        ],
         "tags": [
           "Users"
         ],
-        "summary": "Reset password",
-        "operationId": "resetPassword",
+        "summary": "Confirm the user's phone with the code sent by SMS",
+        "operationId": "verifyPhone",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ResetPasswordRequest"
+              "$ref": "#/definitions/VerifyPhoneRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Password reset successful"
+            "description": "Phone verified",
+            "schema": {
+              "$ref": "#/definitions/SuccessResponse"
+            }
           },
           "400": {
-            "description": "Invalid or expired token",
+            "description": "Invalid or expired code",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          }
-        }
-      }
-    },
-    "/cart": {
-      "get": {
-        "tags": [
-          "Cart"
-        ],
-        "summary": "Get current user's cart",
-        "operationId": "getCart",
-        "responses": {
-          "200": {
-            "description": "Current shopping cart",
-            "schema": {
-              "$ref": "#/definitions/Cart"
-            }
           },
           "401": {
             "description": "Unauthorized",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
+          },
+          "429": {
+            "description": "Too many wrong attempts, request a new code",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
         "security": [
@@ -2754,28 +9989,41 @@ func init() {
             "bearerAuth": []
           }
         ]
-      },
-      "put": {
-        "tags": [
-          "Cart"
+      }
+    },
+    "/users/me/verify/phone/send": {
+      "post": {
+        "produces": [
+          "application/json"
         ],
-        "summary": "Update item quantity in cart",
-        "operationId": "updateCartItem",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/CartItemUpdateRequest"
-            }
-          }
+        "tags": [
+          "Users"
         ],
+        "summary": "Send a verification code to the user's phone",
+        "operationId": "sendPhoneVerification",
         "responses": {
           "200": {
-            "description": "Cart updated",
+            "description": "Verification code sent",
             "schema": {
-              "$ref": "#/definitions/Cart"
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "No phone on the account or already verified",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "429": {
+            "description": "A code was sent recently, retry after the cooldown",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
@@ -2784,197 +10032,159 @@ func init() {
             "bearerAuth": []
           }
         ]
-      },
-      "post": {
+      }
+    },
+    "/users/{id}": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "Cart"
+          "AdminUsers"
         ],
-        "summary": "Add item to cart",
-        "operationId": "addItemToCart",
+        "summary": "Get user details",
+        "operationId": "getUser",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/CartItemRequest"
-            }
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Item added to cart",
+            "description": "User details",
             "schema": {
-              "$ref": "#/definitions/Cart"
+              "$ref": "#/definitions/User"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "User not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "security": [
           {
             "bearerAuth": []
+          },
+          {
+            "apiKey": []
           }
         ]
       },
-      "delete": {
-        "tags": [
-          "Cart"
+      "put": {
+        "consumes": [
+          "application/json"
         ],
-        "summary": "Clear cart",
-        "operationId": "clearCart",
-        "responses": {
-          "204": {
-            "description": "Cart cleared"
-          }
-        },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
-      }
-    },
-    "/health": {
-      "get": {
-        "description": "Returns the overall system health status along with the health, uptime, and latency of individual dependencies like Redis, MongoDB, PostgreSQL, OpenSearch, and MinIO.\n",
         "produces": [
           "application/json"
         ],
         "tags": [
-          "System"
-        ],
-        "summary": "Health check endpoint",
-        "operationId": "getHealth",
-        "responses": {
-          "200": {
-            "description": "Adornme Service health details",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "dependencies": {
-                  "type": "object",
-                  "additionalProperties": {
-                    "$ref": "#/definitions/DependenciesAnon"
-                  },
-                  "example": {
-                    "minio": {
-                      "lastChecked": "2025-11-09T17:25:30Z",
-                      "latencyMs": 7.3,
-                      "status": "healthy",
-                      "uptime": "60h08m17s"
-                    },
-                    "mongodb": {
-                      "lastChecked": "2025-11-09T17:25:30Z",
-                      "latencyMs": 5.1,
-                      "status": "healthy",
-                      "uptime": "35h59m11s"
-                    },
-                    "opensearch": {
-                      "lastChecked": "2025-11-09T17:25:30Z",
-                      "latencyMs": 4.6,
-                      "status": "healthy",
-                      "uptime": "71h44m21s"
-                    },
-                    "postgres": {
-                      "lastChecked": "2025-11-09T17:25:30Z",
-                      "latencyMs": 3.8,
-                      "status": "healthy",
-                      "uptime": "72h14m03s"
-                    },
-                    "redis": {
-                      "lastChecked": "2025-11-09T17:25:30Z",
-                      "latencyMs": 2.4,
-                      "status": "healthy",
-                      "uptime": "36h42m01s"
-                    }
-                  }
-                },
-                "description": {
-                  "description": "Human-readable summary of the system health",
-                  "type": "string",
-                  "example": "All dependencies are healthy and running smoothly"
-                },
-                "status": {
-                  "description": "Overall system status (ok, degraded, or down)",
-                  "type": "string",
-                  "example": "ok"
-                },
-                "timestamp": {
-                  "description": "UTC timestamp when the health check was performed",
-                  "type": "string",
-                  "format": "date-time",
-                  "example": "2025-11-09T17:27:57Z"
-                },
-                "uptime": {
-                  "description": "Application uptime since last start",
-                  "type": "string",
-                  "example": "72h35m10s"
-                }
-              }
-            }
-          }
-        }
-      }
-    },
-    "/orders": {
-      "get": {
-        "tags": [
-          "Orders"
+          "AdminUsers"
         ],
-        "summary": "List user's orders",
-        "operationId": "listOrders",
+        "summary": "Update user info and roles",
+        "operationId": "updateUser",
         "parameters": [
           {
             "type": "integer",
-            "default": 1,
-            "name": "page",
-            "in": "query"
+            "name": "id",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "integer",
-            "default": 10,
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AdminUserUpdateRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Paginated list of orders",
+            "description": "User updated",
             "schema": {
-              "$ref": "#/definitions/OrderListResponse"
+              "$ref": "#/definitions/User"
+            }
+          },
+          "400": {
+            "description": "Invalid input",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "User not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Email or phone already in use",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
         "security": [
           {
             "bearerAuth": []
+          },
+          {
+            "apiKey": []
           }
         ]
       },
-      "post": {
+      "delete": {
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "Orders"
+          "AdminUsers"
         ],
-        "summary": "Place an order from cart",
-        "operationId": "placeOrder",
+        "summary": "Delete a user (anonymised; order history is kept)",
+        "operationId": "deleteUser",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/OrderCreateRequest"
-            }
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Order placed successfully",
+          "204": {
+            "description": "User deleted"
+          },
+          "400": {
+            "description": "Cannot delete your own account",
             "schema": {
-              "$ref": "#/definitions/Order"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "400": {
-            "description": "Invalid order request",
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "User not found",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2983,17 +10193,23 @@ func init() {
         "security": [
           {
             "bearerAuth": []
+          },
+          {
+            "apiKey": []
           }
         ]
       }
     },
-    "/orders/{id}": {
-      "get": {
+    "/users/{id}/disable": {
+      "post": {
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "Orders"
+          "AdminUsers"
         ],
-        "summary": "Get order details",
-        "operationId": "getOrder",
+        "summary": "Disable an account and sign it out everywhere",
+        "operationId": "disableUser",
         "parameters": [
           {
             "type": "integer",
@@ -3004,13 +10220,31 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Order details",
+            "description": "Account disabled",
             "schema": {
-              "$ref": "#/definitions/Order"
+              "$ref": "#/definitions/SuccessResponse"
+            }
+          },
+          "400": {
+            "description": "Cannot disable your own account",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "404": {
-            "description": "Order not found",
+            "description": "User not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Account already disabled",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3019,36 +10253,52 @@ func init() {
         "security": [
           {
             "bearerAuth": []
+          },
+          {
+            "apiKey": []
           }
         ]
       }
     },
-    "/payments/initiate": {
+    "/users/{id}/enable": {
       "post": {
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "Payments"
+          "AdminUsers"
         ],
-        "summary": "Initiate a new payment",
-        "operationId": "initiatePayment",
+        "summary": "Re-enable a disabled account",
+        "operationId": "enableUser",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/PaymentInitiateRequest"
-            }
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Payment initiated",
+          "200": {
+            "description": "Account enabled",
             "schema": {
-              "$ref": "#/definitions/PaymentInitiateResponse"
+              "$ref": "#/definitions/SuccessResponse"
             }
           },
-          "400": {
-            "description": "Invalid request",
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "User not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Account is not disabled",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3057,34 +10307,63 @@ func init() {
         "security": [
           {
             "bearerAuth": []
+          },
+          {
+            "apiKey": []
           }
         ]
       }
     },
-    "/payments/{id}": {
-      "get": {
+    "/users/{id}/impersonate": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "Payments"
+          "AdminUsers"
         ],
-        "summary": "Get payment details",
-        "operationId": "getPayment",
+        "summary": "Issue a read-only token to act as a customer",
+        "operationId": "impersonateUser",
         "parameters": [
           {
-            "type": "string",
+            "type": "integer",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ImpersonationRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Payment details",
+            "description": "Impersonation token issued",
             "schema": {
-              "$ref": "#/definitions/Payment"
+              "$ref": "#/definitions/ImpersonationResponse"
+            }
+          },
+          "400": {
+            "description": "Missing reason or target cannot be impersonated",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "404": {
-            "description": "Payment not found",
+            "description": "User not found",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3097,842 +10376,1154 @@ func init() {
         ]
       }
     },
-    "/payments/{id}/confirm": {
+    "/users/{id}/unlock": {
       "post": {
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "Payments"
+          "AdminUsers"
         ],
-        "summary": "Confirm a payment",
-        "operationId": "confirmPayment",
+        "summary": "Clear failed-login counters and lift an account lockout",
+        "operationId": "unlockUser",
         "parameters": [
           {
-            "type": "string",
+            "type": "integer",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/PaymentConfirmRequest"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Payment confirmed",
+            "description": "Account unlocked",
             "schema": {
-              "$ref": "#/definitions/Payment"
+              "$ref": "#/definitions/SuccessResponse"
             }
           },
-          "400": {
-            "description": "Invalid confirmation data",
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "User not found",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "security": [
+          {
+            "bearerAuth": []
+          },
+          {
+            "apiKey": []
+          }
+        ]
       }
     },
-    "/payments/{id}/refund": {
+    "/webhooks/sms/{provider}": {
       "post": {
+        "description": "Delivery reports pushed by the SMS gateway. Each provider authenticates its own way (Twilio signs the request, MSG91 callbacks carry the shared token in the query string).\n",
+        "consumes": [
+          "application/json",
+          "application/x-www-form-urlencoded"
+        ],
+        "produces": [
+          "application/json"
+        ],
         "tags": [
-          "Payments"
+          "System"
         ],
-        "summary": "Refund a payment",
-        "operationId": "refundPayment",
+        "summary": "SMS delivery-status callback",
+        "operationId": "smsDeliveryStatus",
         "parameters": [
           {
+            "enum": [
+              "msg91",
+              "twilio",
+              "fake"
+            ],
             "type": "string",
-            "name": "id",
+            "name": "provider",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/RefundRequest"
-            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Refund processed",
-            "schema": {
-              "$ref": "#/definitions/Payment"
-            }
+          "204": {
+            "description": "Report accepted"
           },
           "400": {
-            "description": "Invalid refund request",
+            "description": "Malformed or unauthenticated callback",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
           "404": {
-            "description": "Payment not found",
+            "description": "Provider is not the active one",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "APIKey": {
+      "description": "Machine credential for back-office integrations. The secret is never returned after creation.",
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "prefix",
+        "scopes"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "createdBy": {
+          "description": "Admin who created the key",
+          "type": "integer"
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "example": 7
+        },
+        "lastUsedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "name": {
+          "type": "string",
+          "example": "erp-sync"
+        },
+        "prefix": {
+          "description": "Public part of the key, shown to tell keys apart",
+          "type": "string",
+          "example": "ak_3f9c2a1b"
+        },
+        "revokedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "scopes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "products:write"
+          ]
+        }
+      }
+    },
+    "APIKeyCreateRequest": {
+      "description": "Payload to issue an API key.",
+      "type": "object",
+      "required": [
+        "name",
+        "scopes"
+      ],
+      "properties": {
+        "expiresInDays": {
+          "description": "Days until the key expires; omit for no expiry",
+          "type": "integer",
+          "example": 90
+        },
+        "name": {
+          "type": "string",
+          "example": "erp-sync"
+        },
+        "scopes": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "users:read",
+              "users:write",
+              "products:write"
+            ]
+          },
+          "example": [
+            "products:write"
+          ]
+        }
+      }
+    },
+    "APIKeyCreated": {
+      "description": "A new API key. key is shown only in this response.",
+      "type": "object",
+      "required": [
+        "key",
+        "apiKey"
+      ],
+      "properties": {
+        "apiKey": {
+          "$ref": "#/definitions/APIKey"
+        },
+        "key": {
+          "description": "Full secret to send in X-API-Key",
+          "type": "string",
+          "example": "ak_3f9c2a1b.6d1f..."
+        }
+      }
+    },
+    "Address": {
+      "description": "Represents a user shipping address.",
+      "type": "object",
+      "required": [
+        "id",
+        "userId",
+        "addressLine1",
+        "city",
+        "state",
+        "zip",
+        "country"
+      ],
+      "properties": {
+        "addressLine1": {
+          "type": "string"
+        },
+        "addressLine2": {
+          "type": "string"
+        },
+        "city": {
+          "type": "string"
+        },
+        "country": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer"
+        },
+        "state": {
+          "type": "string"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "userId": {
+          "type": "integer"
+        },
+        "zip": {
+          "type": "string"
+        }
+      }
+    },
+    "AddressCreateRequest": {
+      "description": "Payload to add a new address.",
+      "type": "object",
+      "required": [
+        "addressLine1",
+        "city",
+        "state",
+        "zip",
+        "country"
+      ],
+      "properties": {
+        "addressLine1": {
+          "type": "string"
+        },
+        "addressLine2": {
+          "type": "string"
+        },
+        "city": {
+          "type": "string"
+        },
+        "country": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "zip": {
+          "type": "string"
+        }
+      }
+    },
+    "AddressUpdateRequest": {
+      "description": "Payload to update an existing address.",
+      "type": "object",
+      "properties": {
+        "addressLine1": {
+          "type": "string"
+        },
+        "addressLine2": {
+          "type": "string"
+        },
+        "city": {
+          "type": "string"
+        },
+        "country": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
         },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
+        "zip": {
+          "type": "string"
+        }
       }
     },
-    "/products": {
-      "post": {
-        "tags": [
-          "AdminProducts"
-        ],
-        "summary": "Create a new product",
-        "operationId": "createProduct",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ProductCreateRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Product created successfully"
-          },
-          "400": {
-            "description": "Validation error"
-          },
-          "401": {
-            "description": "Unauthorized"
-          }
+    "AdminUserUpdateRequest": {
+      "description": "Admin changes to a user. Omitted fields are left unchanged; roles replaces the whole set.",
+      "type": "object",
+      "properties": {
+        "email": {
+          "type": "string",
+          "format": "email",
+          "example": "paras@example.com"
         },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
+        "name": {
+          "type": "string",
+          "example": "Paras Jain"
+        },
+        "phone": {
+          "type": "string",
+          "example": "+919876543210"
+        },
+        "roles": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "admin",
+              "catalog-manager",
+              "support",
+              "customer"
+            ]
+          },
+          "example": [
+            "customer"
+          ]
+        }
       }
     },
-    "/products/{id}": {
-      "put": {
-        "tags": [
-          "AdminProducts"
-        ],
-        "summary": "Update a product",
-        "operationId": "updateProduct",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "id",
-            "in": "path",
-            "required": true
+    "AttributeDefinition": {
+      "description": "A typed product attribute of a category. Subcategories inherit it; a subcategory may redefine the same code.",
+      "type": "object",
+      "required": [
+        "id",
+        "categoryId",
+        "code",
+        "label",
+        "type",
+        "required"
+      ],
+      "properties": {
+        "categoryId": {
+          "description": "Category the attribute is defined on",
+          "type": "integer",
+          "example": 5
+        },
+        "code": {
+          "description": "Key in product attributes and in attr.\u003ccode\u003e filters",
+          "type": "string",
+          "example": "metal"
+        },
+        "id": {
+          "type": "integer",
+          "example": 31
+        },
+        "label": {
+          "type": "string",
+          "example": "Metal"
+        },
+        "max": {
+          "description": "Highest value of a number attribute",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "min": {
+          "description": "Lowest value of a number attribute",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "position": {
+          "type": "integer",
+          "example": 0
+        },
+        "required": {
+          "description": "Products in the category must set it",
+          "type": "boolean"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "enum",
+            "number",
+            "boolean"
+          ],
+          "example": "enum"
+        },
+        "unit": {
+          "description": "Unit of a number attribute",
+          "type": "string",
+          "example": "ct"
+        },
+        "values": {
+          "description": "Allowed values of an enum attribute",
+          "type": "array",
+          "items": {
+            "type": "string"
           },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ProductUpdateRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Product updated"
-          }
+          "example": [
+            "gold",
+            "silver",
+            "platinum"
+          ]
+        }
+      }
+    },
+    "AttributeDefinitionCreateRequest": {
+      "description": "Request to define an attribute on a category.",
+      "type": "object",
+      "required": [
+        "code",
+        "label",
+        "type"
+      ],
+      "properties": {
+        "code": {
+          "type": "string",
+          "maxLength": 50,
+          "minLength": 1,
+          "pattern": "^[a-z][a-z0-9_]*$"
         },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
-      },
-      "delete": {
-        "tags": [
-          "AdminProducts"
-        ],
-        "summary": "Delete a product",
-        "operationId": "deleteProduct",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "Product deleted"
-          }
+        "label": {
+          "type": "string",
+          "maxLength": 100,
+          "minLength": 1
         },
-        "security": [
-          {
-            "bearerAuth": []
+        "max": {
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "min": {
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "position": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "required": {
+          "type": "boolean"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "enum",
+            "number",
+            "boolean"
+          ]
+        },
+        "unit": {
+          "type": "string",
+          "maxLength": 20
+        },
+        "values": {
+          "description": "Required for enum attributes",
+          "type": "array",
+          "maxItems": 100,
+          "items": {
+            "type": "string"
           }
-        ]
+        }
       }
     },
-    "/shipping/addresses": {
-      "get": {
-        "tags": [
-          "Shipping"
-        ],
-        "summary": "Get all addresses for logged-in user",
-        "operationId": "listShippingAddresses",
-        "responses": {
-          "200": {
-            "description": "List of user addresses",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Address"
-              }
-            }
-          },
-          "401": {
-            "description": "Unauthorized"
-          }
+    "AttributeDefinitionUpdateRequest": {
+      "description": "Changes to an attribute definition. Omitted fields are left unchanged; values replaces the whole list. The code and type cannot change.",
+      "type": "object",
+      "properties": {
+        "label": {
+          "type": "string",
+          "maxLength": 100,
+          "minLength": 1,
+          "x-nullable": true
         },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
-      },
-      "post": {
-        "tags": [
-          "Shipping"
-        ],
-        "summary": "Add a new shipping address",
-        "operationId": "addShippingAddress",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/AddressCreateRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Address added successfully",
-            "schema": {
-              "$ref": "#/definitions/Address"
-            }
-          },
-          "400": {
-            "description": "Validation error",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        "max": {
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "min": {
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "position": {
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "required": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "unit": {
+          "type": "string",
+          "maxLength": 20,
+          "x-nullable": true
+        },
+        "values": {
+          "type": "array",
+          "maxItems": 100,
+          "items": {
+            "type": "string"
           }
+        }
+      }
+    },
+    "AuthResponse": {
+      "description": "Response containing JWT token and user details after login.",
+      "type": "object",
+      "required": [
+        "token",
+        "user"
+      ],
+      "properties": {
+        "refreshToken": {
+          "type": "string"
         },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
+        "token": {
+          "type": "string",
+          "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+        },
+        "user": {
+          "$ref": "#/definitions/User"
+        }
       }
     },
-    "/shipping/addresses/{id}": {
-      "put": {
-        "tags": [
-          "Shipping"
-        ],
-        "summary": "Update a shipping address",
-        "operationId": "updateShippingAddress",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/AddressUpdateRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Address updated",
-            "schema": {
-              "$ref": "#/definitions/Address"
-            }
-          },
-          "404": {
-            "description": "Address not found",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+    "Cart": {
+      "description": "Shopping cart belonging to a user.",
+      "type": "object",
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CartItem"
           }
         },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
-      },
-      "delete": {
-        "tags": [
-          "Shipping"
-        ],
-        "summary": "Delete a shipping address",
-        "operationId": "deleteShippingAddress",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "Address deleted"
-          },
-          "404": {
-            "description": "Address not found",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+        "totalPrice": {
+          "type": "number",
+          "format": "float",
+          "example": 2999.5
+        }
+      }
+    },
+    "CartItem": {
+      "description": "An item inside the shopping cart.",
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "example": "Gold Ring"
         },
-        "security": [
-          {
-            "bearerAuth": []
+        "options": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VariantOption"
           }
-        ]
+        },
+        "price": {
+          "type": "number",
+          "format": "float",
+          "example": 1499.75
+        },
+        "productId": {
+          "type": "integer",
+          "example": 101
+        },
+        "quantity": {
+          "type": "integer",
+          "example": 2
+        },
+        "sku": {
+          "type": "string",
+          "example": "RING-101-18K-Y-7"
+        },
+        "skuId": {
+          "type": "integer",
+          "example": 7001
+        },
+        "subtotal": {
+          "type": "number",
+          "format": "float",
+          "example": 2999.5
+        }
       }
     },
-    "/shipping/options": {
-      "get": {
-        "tags": [
-          "Shipping"
-        ],
-        "summary": "Get available shipping options",
-        "operationId": "listShippingOptions",
-        "responses": {
-          "200": {
-            "description": "List of shipping options",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ShippingOption"
-              }
-            }
-          }
+    "CartItemRequest": {
+      "description": "Payload to add a product variant to cart.",
+      "type": "object",
+      "required": [
+        "skuId",
+        "quantity"
+      ],
+      "properties": {
+        "quantity": {
+          "type": "integer",
+          "minimum": 1
+        },
+        "skuId": {
+          "description": "id of the product variant",
+          "type": "integer",
+          "minimum": 1
         }
       }
     },
-    "/shipping/track/{orderId}": {
-      "get": {
-        "tags": [
-          "Shipping"
-        ],
-        "summary": "Track shipment for an order",
-        "operationId": "trackShipment",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "orderId",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Shipment tracking details",
-            "schema": {
-              "$ref": "#/definitions/Tracking"
-            }
-          },
-          "404": {
-            "description": "Order or shipment not found",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+    "CartItemUpdateRequest": {
+      "description": "Payload to update cart item quantity.",
+      "type": "object",
+      "required": [
+        "skuId",
+        "quantity"
+      ],
+      "properties": {
+        "quantity": {
+          "type": "integer",
+          "minimum": 1
+        },
+        "skuId": {
+          "description": "id of the product variant",
+          "type": "integer",
+          "minimum": 1
+        }
+      }
+    },
+    "Category": {
+      "description": "A product category.",
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "slug"
+      ],
+      "properties": {
+        "breadcrumbs": {
+          "description": "Path from the top level, ending with this category",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CategoryRef"
           }
         },
-        "security": [
-          {
-            "bearerAuth": []
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "example": 12
+        },
+        "name": {
+          "type": "string",
+          "example": "Engagement Rings"
+        },
+        "parentId": {
+          "description": "null for a top-level category",
+          "type": "integer",
+          "x-nullable": true,
+          "example": 7
+        },
+        "position": {
+          "description": "Order among siblings, ascending",
+          "type": "integer",
+          "example": 0
+        },
+        "slug": {
+          "type": "string",
+          "example": "engagement-rings"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "CategoryCreateRequest": {
+      "description": "Request to create a category.",
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "maxLength": 100,
+          "minLength": 1,
+          "example": "Engagement Rings"
+        },
+        "parentId": {
+          "description": "Omit for a top-level category",
+          "type": "integer",
+          "minimum": 1,
+          "example": 7
+        },
+        "position": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "slug": {
+          "description": "Derived from name when omitted",
+          "type": "string",
+          "maxLength": 100,
+          "pattern": "^[a-z0-9]+(-[a-z0-9]+)*$",
+          "example": "engagement-rings"
+        }
+      }
+    },
+    "CategoryNode": {
+      "description": "A category with its subcategories, for navigation menus.",
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "slug"
+      ],
+      "properties": {
+        "children": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CategoryNode"
           }
-        ]
+        },
+        "id": {
+          "type": "integer",
+          "example": 7
+        },
+        "name": {
+          "type": "string",
+          "example": "Rings"
+        },
+        "position": {
+          "type": "integer",
+          "example": 0
+        },
+        "slug": {
+          "type": "string",
+          "example": "rings"
+        }
       }
     },
-    "/users": {
-      "get": {
-        "tags": [
-          "AdminUsers"
-        ],
-        "summary": "List all users",
-        "operationId": "listUsers",
-        "parameters": [
-          {
-            "type": "integer",
-            "default": 1,
-            "name": "page",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "default": 20,
-            "name": "limit",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "List of users"
-          }
+    "CategoryRef": {
+      "description": "A category on the path from the top level to a product's category.",
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "slug"
+      ],
+      "properties": {
+        "id": {
+          "type": "integer",
+          "example": 7
         },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
+        "name": {
+          "type": "string",
+          "example": "Rings"
+        },
+        "slug": {
+          "type": "string",
+          "example": "rings"
+        }
       }
     },
-    "/users/me": {
-      "get": {
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "Users"
-        ],
-        "summary": "Get logged-in user profile",
-        "operationId": "getUserProfile",
-        "responses": {
-          "200": {
-            "description": "User profile details",
-            "schema": {
-              "$ref": "#/definitions/User"
-            }
-          },
-          "401": {
-            "description": "Unauthorized",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+    "CategoryUpdateRequest": {
+      "description": "Changes to a category. Omitted fields are left unchanged.",
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "maxLength": 100,
+          "minLength": 1,
+          "x-nullable": true
         },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
-      },
-      "put": {
-        "consumes": [
-          "application/json"
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "Users"
-        ],
-        "summary": "Update logged-in user profile",
-        "operationId": "updateUserProfile",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/UserUpdateRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Profile updated successfully",
-            "schema": {
-              "$ref": "#/definitions/User"
-            }
-          },
-          "400": {
-            "description": "Validation error",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+        "parentId": {
+          "description": "New parent; 0 moves the category to the top level. It may not be moved under itself or a descendant.",
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": true
         },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
+        "position": {
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "slug": {
+          "type": "string",
+          "maxLength": 100,
+          "pattern": "^[a-z0-9]+(-[a-z0-9]+)*$",
+          "x-nullable": true,
+          "example": "engagement-rings"
+        }
       }
     },
-    "/users/{id}": {
-      "get": {
-        "tags": [
-          "AdminUsers"
-        ],
-        "summary": "Get user details",
-        "operationId": "getUser",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "User details"
-          }
+    "DependenciesAnon": {
+      "type": "object",
+      "properties": {
+        "errorMessage": {
+          "description": "Error while checking dependencies",
+          "type": "string",
+          "example": "errorMessage"
         },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
-      },
-      "put": {
-        "tags": [
-          "AdminUsers"
-        ],
-        "summary": "Update user info",
-        "operationId": "updateUser",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/UserUpdateRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "User updated"
-          }
+        "lastChecked": {
+          "description": "Time when the dependency was last checked",
+          "type": "string",
+          "format": "date-time",
+          "example": "2025-11-09T17:25:30Z"
         },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
-      },
-      "delete": {
-        "tags": [
-          "AdminUsers"
-        ],
-        "summary": "Delete a user",
-        "operationId": "deleteUser",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "User deleted"
-          }
+        "latencyMs": {
+          "description": "Response latency in milliseconds",
+          "type": "number",
+          "format": "float",
+          "example": 12.34
+        },
+        "status": {
+          "description": "Health status of the dependency",
+          "type": "string",
+          "example": "healthy"
+        },
+        "uptime": {
+          "description": "How long the dependency has been up",
+          "type": "string",
+          "example": "48h12m45s"
+        }
+      }
+    },
+    "ErasureRequest": {
+      "description": "Confirms a request to erase the account and its personal data.",
+      "type": "object",
+      "properties": {
+        "currentPassword": {
+          "description": "Required when the account has a password",
+          "type": "string"
+        }
+      }
+    },
+    "ErrorResponse": {
+      "description": "Standard error response.",
+      "type": "object",
+      "required": [
+        "error",
+        "message"
+      ],
+      "properties": {
+        "error": {
+          "type": "string",
+          "example": "BadRequest"
         },
-        "security": [
-          {
-            "bearerAuth": []
-          }
-        ]
+        "message": {
+          "type": "string",
+          "example": "Invalid request parameters"
+        }
       }
-    }
-  },
-  "definitions": {
-    "Address": {
-      "description": "Represents a user shipping address.",
+    },
+    "Facet": {
+      "description": "Value counts of one attribute over the filtered listing. A facet's own attr filter is left out of its counts, so other values stay selectable.",
       "type": "object",
       "required": [
-        "id",
-        "userId",
-        "addressLine1",
-        "city",
-        "state",
-        "zip",
-        "country"
+        "name",
+        "values"
       ],
       "properties": {
-        "addressLine1": {
-          "type": "string"
-        },
-        "addressLine2": {
-          "type": "string"
-        },
-        "city": {
-          "type": "string"
-        },
-        "country": {
-          "type": "string"
-        },
-        "createdAt": {
+        "name": {
           "type": "string",
-          "format": "date-time"
-        },
-        "id": {
-          "type": "integer"
+          "example": "metal"
         },
-        "state": {
-          "type": "string"
+        "values": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FacetValue"
+          }
+        }
+      }
+    },
+    "FacetValue": {
+      "description": "One attribute value and how many matching products have it.",
+      "type": "object",
+      "required": [
+        "value",
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "type": "integer",
+          "example": 42
         },
-        "updatedAt": {
+        "value": {
           "type": "string",
-          "format": "date-time"
-        },
-        "userId": {
-          "type": "integer"
-        },
-        "zip": {
-          "type": "string"
+          "example": "gold"
         }
       }
     },
-    "AddressCreateRequest": {
-      "description": "Payload to add a new address.",
+    "ForgotPasswordRequest": {
+      "description": "Request to initiate password reset.",
       "type": "object",
       "required": [
-        "addressLine1",
-        "city",
-        "state",
-        "zip",
-        "country"
+        "email"
       ],
       "properties": {
-        "addressLine1": {
-          "type": "string"
-        },
-        "addressLine2": {
-          "type": "string"
-        },
-        "city": {
-          "type": "string"
-        },
-        "country": {
-          "type": "string"
-        },
-        "state": {
-          "type": "string"
-        },
-        "zip": {
-          "type": "string"
+        "email": {
+          "type": "string",
+          "format": "email",
+          "example": "paras@example.com"
         }
       }
     },
-    "AddressUpdateRequest": {
-      "description": "Payload to update an existing address.",
+    "GenericResponse": {
       "type": "object",
       "properties": {
-        "addressLine1": {
-          "type": "string"
-        },
-        "addressLine2": {
-          "type": "string"
-        },
-        "city": {
-          "type": "string"
-        },
-        "country": {
-          "type": "string"
+        "message": {
+          "type": "string",
+          "example": "OTP sent if account exists"
+        }
+      }
+    },
+    "ImpersonationRequest": {
+      "description": "Why the agent needs to act as the customer; kept in the audit log.",
+      "type": "object",
+      "required": [
+        "reason"
+      ],
+      "properties": {
+        "reason": {
+          "type": "string",
+          "example": "ticket #4821: order not visible"
+        }
+      }
+    },
+    "ImpersonationResponse": {
+      "description": "Short-lived, read-only access token for the target user.",
+      "type": "object",
+      "required": [
+        "token",
+        "expiresIn"
+      ],
+      "properties": {
+        "expiresIn": {
+          "description": "Seconds until token expires",
+          "type": "integer",
+          "example": 900
         },
-        "state": {
+        "token": {
           "type": "string"
         },
-        "zip": {
-          "type": "string"
+        "user": {
+          "$ref": "#/definitions/User"
         }
       }
     },
-    "AuthResponse": {
-      "description": "Response containing JWT token and user details after login.",
+    "JSONWebKey": {
+      "description": "Public half of a token signing key (RFC 7517).",
       "type": "object",
       "required": [
-        "token",
-        "user"
+        "kty",
+        "kid"
       ],
       "properties": {
-        "refreshToken": {
+        "alg": {
+          "type": "string",
+          "example": "EdDSA"
+        },
+        "crv": {
+          "type": "string",
+          "example": "Ed25519"
+        },
+        "e": {
+          "description": "RSA public exponent (base64url)",
           "type": "string"
         },
-        "token": {
+        "kid": {
           "type": "string",
-          "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+          "example": "2026-01"
         },
-        "user": {
-          "$ref": "#/definitions/User"
+        "kty": {
+          "type": "string",
+          "example": "OKP"
+        },
+        "n": {
+          "description": "RSA modulus (base64url)",
+          "type": "string"
+        },
+        "use": {
+          "type": "string",
+          "example": "sig"
+        },
+        "x": {
+          "description": "Ed25519 public key (base64url)",
+          "type": "string"
         }
       }
     },
-    "Cart": {
-      "description": "Shopping cart belonging to a user.",
+    "JWKSet": {
+      "description": "Keys that currently validate access and refresh tokens.",
       "type": "object",
       "properties": {
-        "items": {
+        "keys": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/CartItem"
+            "$ref": "#/definitions/JSONWebKey"
           }
-        },
-        "totalPrice": {
-          "type": "number",
-          "format": "float",
-          "example": 2999.5
         }
       }
     },
-    "CartItem": {
-      "description": "An item inside the shopping cart.",
+    "LoginRequest": {
+      "description": "Payload to authenticate a user.",
       "type": "object",
+      "required": [
+        "email",
+        "password"
+      ],
       "properties": {
-        "name": {
+        "email": {
           "type": "string",
-          "example": "Gold Ring"
+          "format": "email",
+          "example": "paras@example.com"
         },
-        "price": {
-          "type": "number",
-          "format": "float",
-          "example": 1499.75
+        "password": {
+          "type": "string",
+          "example": "strongPassword123"
+        }
+      }
+    },
+    "MFAChallenge": {
+      "description": "Returned instead of tokens when the login needs a second factor.",
+      "type": "object",
+      "required": [
+        "mfaToken"
+      ],
+      "properties": {
+        "enrollRequired": {
+          "description": "True when the account must enrol an authenticator before verifying",
+          "type": "boolean"
         },
-        "productId": {
+        "expiresIn": {
+          "description": "Seconds until mfaToken expires",
           "type": "integer",
-          "example": 101
+          "example": 300
         },
-        "quantity": {
-          "type": "integer",
-          "example": 2
+        "methods": {
+          "description": "Second factors the account can use: totp at /auth/mfa/verify, passkey at /auth/passkey/login",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "totp",
+              "passkey"
+            ]
+          }
         },
-        "subtotal": {
-          "type": "number",
-          "format": "float",
-          "example": 2999.5
+        "mfaToken": {
+          "description": "Short-lived token for /auth/mfa/verify (and /auth/mfa/enroll)",
+          "type": "string"
         }
       }
     },
-    "CartItemRequest": {
-      "description": "Payload to add a product to cart.",
+    "MFACodeRequest": {
+      "description": "A current authenticator code.",
       "type": "object",
       "required": [
-        "productId",
-        "quantity"
+        "code"
       ],
       "properties": {
-        "productId": {
-          "type": "integer"
-        },
-        "quantity": {
-          "type": "integer",
-          "minimum": 1
+        "code": {
+          "type": "string",
+          "example": "123456"
         }
       }
     },
-    "CartItemUpdateRequest": {
-      "description": "Payload to update cart item quantity.",
+    "MFAEnrollRequest": {
+      "description": "Pending login token of an account that must enrol MFA.",
       "type": "object",
       "required": [
-        "productId",
-        "quantity"
+        "mfaToken"
       ],
       "properties": {
-        "productId": {
-          "type": "integer"
-        },
-        "quantity": {
-          "type": "integer",
-          "minimum": 1
+        "mfaToken": {
+          "type": "string"
         }
       }
     },
-    "DependenciesAnon": {
+    "MFAEnrollment": {
+      "description": "New TOTP secret; recovery codes are shown only once.",
       "type": "object",
+      "required": [
+        "secret",
+        "otpauthUri",
+        "recoveryCodes"
+      ],
       "properties": {
-        "errorMessage": {
-          "description": "Error while checking dependencies",
-          "type": "string",
-          "example": "errorMessage"
-        },
-        "lastChecked": {
-          "description": "Time when the dependency was last checked",
+        "otpauthUri": {
+          "description": "Provisioning URI to render as a QR code",
           "type": "string",
-          "format": "date-time",
-          "example": "2025-11-09T17:25:30Z"
+          "example": "otpauth://totp/Adornme:paras%40example.com?secret=JBSWY3DPEHPK3PXP\u0026issuer=Adornme"
         },
-        "latencyMs": {
-          "description": "Response latency in milliseconds",
-          "type": "number",
-          "format": "float",
-          "example": 12.34
-        },
-        "status": {
-          "description": "Health status of the dependency",
-          "type": "string",
-          "example": "healthy"
+        "recoveryCodes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "uptime": {
-          "description": "How long the dependency has been up",
+        "secret": {
+          "description": "Base32 secret for manual entry",
           "type": "string",
-          "example": "48h12m45s"
+          "example": "JBSWY3DPEHPK3PXP"
         }
       }
     },
-    "ErrorResponse": {
-      "description": "Standard error response.",
+    "MFAVerifyRequest": {
+      "description": "Second login step: the pending token plus an authenticator or recovery code.",
       "type": "object",
       "required": [
-        "error",
-        "message"
+        "mfaToken",
+        "code"
       ],
       "properties": {
-        "error": {
+        "code": {
+          "description": "6-digit TOTP code or a recovery code",
           "type": "string",
-          "example": "BadRequest"
+          "example": "123456"
         },
-        "message": {
-          "type": "string",
-          "example": "Invalid request parameters"
+        "mfaToken": {
+          "type": "string"
         }
       }
     },
-    "ForgotPasswordRequest": {
-      "description": "Request to initiate password reset.",
+    "MagicLinkRequest": {
+      "description": "Request a passwordless sign-in link by email.",
       "type": "object",
       "required": [
-        "email"
+        "email",
+        "deviceId"
       ],
       "properties": {
+        "deviceId": {
+          "description": "Random id the client generates and keeps (e.g. in local storage); the link only signs in on the device that sent it",
+          "type": "string",
+          "maxLength": 128,
+          "minLength": 16,
+          "example": "6f1c2a9e4b7d4e0f9a3b"
+        },
         "email": {
           "type": "string",
           "format": "email",
@@ -3940,31 +11531,24 @@ func init() {
         }
       }
     },
-    "GenericResponse": {
-      "type": "object",
-      "properties": {
-        "message": {
-          "type": "string",
-          "example": "OTP sent if account exists"
-        }
-      }
-    },
-    "LoginRequest": {
-      "description": "Payload to authenticate a user.",
+    "MagicLinkVerifyRequest": {
+      "description": "Token from the sign-in link, with the id of the device that requested it.",
       "type": "object",
       "required": [
-        "email",
-        "password"
+        "token",
+        "deviceId"
       ],
       "properties": {
-        "email": {
+        "deviceId": {
+          "description": "Random id the client generates and keeps (e.g. in local storage); the link only signs in on the device that sent it",
           "type": "string",
-          "format": "email",
-          "example": "paras@example.com"
+          "maxLength": 128,
+          "minLength": 16,
+          "example": "6f1c2a9e4b7d4e0f9a3b"
         },
-        "password": {
+        "token": {
           "type": "string",
-          "example": "strongPassword123"
+          "example": "eyJhbGciOiJFZERTQSIs..."
         }
       }
     },
@@ -4057,6 +11641,205 @@ func init() {
         }
       }
     },
+    "Passkey": {
+      "description": "A registered passkey.",
+      "type": "object",
+      "required": [
+        "id",
+        "name"
+      ],
+      "properties": {
+        "backedUp": {
+          "description": "Synced to the platform's cloud keychain",
+          "type": "boolean"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "example": 12
+        },
+        "lastUsedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "name": {
+          "type": "string",
+          "example": "Pixel 8"
+        },
+        "transports": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "PasskeyAssertion": {
+      "description": "Result of navigator.credentials.get(), as serialised by PublicKeyCredential.toJSON().",
+      "type": "object",
+      "required": [
+        "id",
+        "response"
+      ],
+      "properties": {
+        "id": {
+          "description": "Credential id, base64url",
+          "type": "string"
+        },
+        "rawId": {
+          "description": "base64url",
+          "type": "string"
+        },
+        "response": {
+          "$ref": "#/definitions/PasskeyAssertionResponse"
+        },
+        "type": {
+          "type": "string",
+          "example": "public-key"
+        }
+      }
+    },
+    "PasskeyAssertionResponse": {
+      "type": "object",
+      "required": [
+        "clientDataJSON",
+        "authenticatorData",
+        "signature"
+      ],
+      "properties": {
+        "authenticatorData": {
+          "description": "base64url",
+          "type": "string"
+        },
+        "clientDataJSON": {
+          "description": "base64url",
+          "type": "string"
+        },
+        "signature": {
+          "description": "base64url",
+          "type": "string"
+        },
+        "userHandle": {
+          "description": "base64url",
+          "type": "string"
+        }
+      }
+    },
+    "PasskeyAttestation": {
+      "description": "Result of navigator.credentials.create(), as serialised by PublicKeyCredential.toJSON().",
+      "type": "object",
+      "required": [
+        "id",
+        "response"
+      ],
+      "properties": {
+        "id": {
+          "description": "Credential id, base64url",
+          "type": "string"
+        },
+        "rawId": {
+          "description": "base64url",
+          "type": "string"
+        },
+        "response": {
+          "$ref": "#/definitions/PasskeyAttestationResponse"
+        },
+        "type": {
+          "type": "string",
+          "example": "public-key"
+        }
+      }
+    },
+    "PasskeyAttestationResponse": {
+      "type": "object",
+      "required": [
+        "clientDataJSON",
+        "attestationObject"
+      ],
+      "properties": {
+        "attestationObject": {
+          "description": "base64url",
+          "type": "string"
+        },
+        "clientDataJSON": {
+          "description": "base64url",
+          "type": "string"
+        },
+        "transports": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "internal",
+            "hybrid"
+          ]
+        }
+      }
+    },
+    "PasskeyLoginOptionsRequest": {
+      "description": "Starts a passkey login. With mfaToken the passkey is the second factor of a password or OTP login; with email only that account's passkeys are offered; with neither any discoverable passkey may be used.",
+      "type": "object",
+      "properties": {
+        "email": {
+          "type": "string",
+          "format": "email",
+          "example": "paras@example.com"
+        },
+        "mfaToken": {
+          "description": "From an MFAChallenge",
+          "type": "string"
+        }
+      }
+    },
+    "PasskeyLoginRequest": {
+      "description": "Completes a passkey login started with beginPasskeyLogin.",
+      "type": "object",
+      "required": [
+        "credential"
+      ],
+      "properties": {
+        "credential": {
+          "$ref": "#/definitions/PasskeyAssertion"
+        },
+        "mfaToken": {
+          "description": "Same mfaToken as for beginPasskeyLogin, when the passkey is the second factor",
+          "type": "string"
+        }
+      }
+    },
+    "PasskeyOptions": {
+      "description": "WebAuthn options; pass publicKey to PublicKeyCredential.parseCreationOptionsFromJSON or parseRequestOptionsFromJSON.",
+      "type": "object",
+      "properties": {
+        "publicKey": {
+          "description": "PublicKeyCredentialCreationOptionsJSON or PublicKeyCredentialRequestOptionsJSON",
+          "type": "object"
+        }
+      }
+    },
+    "PasskeyRegistrationRequest": {
+      "description": "Registers the credential created with the options from beginPasskeyRegistration.",
+      "type": "object",
+      "required": [
+        "credential"
+      ],
+      "properties": {
+        "credential": {
+          "$ref": "#/definitions/PasskeyAttestation"
+        },
+        "name": {
+          "description": "Label shown in the passkey list",
+          "type": "string",
+          "maxLength": 64,
+          "example": "Pixel 8"
+        }
+      }
+    },
     "Payment": {
       "description": "Represents a payment transaction.",
       "type": "object",
@@ -4162,10 +11945,18 @@ func init() {
           "description": "Workaround for AT event tracker",
           "type": "string"
         },
+        "impersonatorId": {
+          "description": "ID of the support agent acting as this user (act claim); requests are read-only",
+          "type": "string"
+        },
         "region": {
           "description": "Workaround for API's not having region in their context",
           "type": "string"
         },
+        "sessionId": {
+          "description": "ID of the session the access token was issued for",
+          "type": "string"
+        },
         "token": {
           "description": "OAuth2 Token",
           "$ref": "#/definitions/Token"
@@ -4188,6 +11979,75 @@ func init() {
         }
       }
     },
+    "PrivacyJob": {
+      "description": "An asynchronous data export or erasure job.",
+      "type": "object",
+      "required": [
+        "id",
+        "kind",
+        "status",
+        "progress"
+      ],
+      "properties": {
+        "completedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "downloadUrl": {
+          "description": "Signed link to the ZIP archive; export jobs only, until expiresAt",
+          "type": "string"
+        },
+        "error": {
+          "description": "Why the job failed",
+          "type": "string"
+        },
+        "expiresAt": {
+          "description": "When the download link stops being issued",
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "example": "3b1f7a52-8c0e-4d8e-9a3f-1f2e5c6d7b80"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "export",
+            "erasure"
+          ]
+        },
+        "progress": {
+          "description": "Percent done",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 100,
+          "minimum": 0
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "queued",
+            "running",
+            "completed",
+            "failed"
+          ]
+        },
+        "step": {
+          "description": "Store being processed",
+          "type": "string",
+          "example": "orders"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "Product": {
       "description": "Represents a product available in the catalog.",
       "type": "object",
@@ -4196,9 +12056,30 @@ func init() {
         "name",
         "price",
         "stock",
-        "categoryId"
+        "categoryId",
+        "currency",
+        "version"
       ],
       "properties": {
+        "archivedAt": {
+          "description": "Set once the product is deleted; archived products are hidden from listProducts but still resolve by id",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "attributes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProductAttribute"
+          }
+        },
+        "breadcrumbs": {
+          "description": "Path from the top-level category down to categoryId",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CategoryRef"
+          }
+        },
         "categoryId": {
           "type": "integer",
           "example": 5
@@ -4207,6 +12088,10 @@ func init() {
           "type": "string",
           "format": "date-time"
         },
+        "currency": {
+          "type": "string",
+          "example": "INR"
+        },
         "description": {
           "type": "string",
           "example": "22K pure gold necklace with intricate design"
@@ -4229,18 +12114,58 @@ func init() {
           "type": "string",
           "example": "Gold Necklace"
         },
+        "options": {
+          "description": "Option axes the product varies along",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProductOption"
+          }
+        },
         "price": {
+          "description": "Lowest variant price when the product has variants",
           "type": "number",
           "format": "float",
           "example": 14999.99
         },
         "stock": {
+          "description": "Total stock of all variants when the product has variants",
           "type": "integer",
           "example": 20
         },
         "updatedAt": {
           "type": "string",
           "format": "date-time"
+        },
+        "variants": {
+          "description": "The variant matrix: one SKU per offered combination of option values",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProductVariant"
+          }
+        },
+        "version": {
+          "description": "Increases with every change; send it back with updateProduct",
+          "type": "integer",
+          "example": 3
+        }
+      }
+    },
+    "ProductAttribute": {
+      "description": "A product's value for one attribute of its category.",
+      "type": "object",
+      "required": [
+        "name",
+        "value"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "example": "metal"
+        },
+        "value": {
+          "description": "Numbers and booleans are sent as text, e.g. \"0.75\" or \"true\"",
+          "type": "string",
+          "example": "gold"
         }
       }
     },
@@ -4254,27 +12179,57 @@ func init() {
         "categoryId"
       ],
       "properties": {
+        "attributes": {
+          "description": "Values for the attributes of the category and its parents",
+          "type": "array",
+          "maxItems": 50,
+          "items": {
+            "$ref": "#/definitions/ProductAttribute"
+          }
+        },
         "categoryId": {
-          "type": "integer"
+          "type": "integer",
+          "minimum": 1
+        },
+        "currency": {
+          "description": "ISO 4217 code; INR when omitted",
+          "type": "string",
+          "pattern": "^[A-Z]{3}$",
+          "example": "INR"
         },
         "description": {
-          "type": "string"
+          "type": "string",
+          "maxLength": 5000
         },
         "images": {
+          "description": "Absolute http(s) URLs, at most 10; the first is the cover",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
         "name": {
-          "type": "string"
+          "type": "string",
+          "maxLength": 200,
+          "minLength": 1
+        },
+        "options": {
+          "description": "Option axes, such as Size, Metal and Purity",
+          "type": "array",
+          "maxItems": 5,
+          "items": {
+            "$ref": "#/definitions/ProductOption"
+          }
         },
         "price": {
           "type": "number",
-          "format": "float"
+          "format": "float",
+          "minimum": 0,
+          "exclusiveMinimum": true
         },
         "stock": {
-          "type": "integer"
+          "type": "integer",
+          "minimum": 0
         }
       }
     },
@@ -4282,6 +12237,12 @@ func init() {
       "description": "Paginated list of products.",
       "type": "object",
       "properties": {
+        "facets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Facet"
+          }
+        },
         "items": {
           "type": "array",
           "items": {
@@ -4299,18 +12260,71 @@ func init() {
         "total": {
           "type": "integer",
           "example": 100
+        },
+        "totalPages": {
+          "type": "integer",
+          "example": 5
+        }
+      }
+    },
+    "ProductOption": {
+      "description": "One axis a product varies along, such as ring size or metal purity, with the values it is offered in.",
+      "type": "object",
+      "required": [
+        "name",
+        "values"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "maxLength": 50,
+          "minLength": 1,
+          "example": "Purity"
+        },
+        "values": {
+          "type": "array",
+          "maxItems": 50,
+          "minItems": 1,
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "14K",
+            "18K",
+            "22K"
+          ]
         }
       }
     },
     "ProductUpdateRequest": {
-      "description": "Request to update product details.",
+      "description": "Changes to a product. Omitted fields are left unchanged; images replaces the whole list. version must match the stored one.",
       "type": "object",
+      "required": [
+        "version"
+      ],
       "properties": {
+        "attributes": {
+          "description": "Replaces all attribute values",
+          "type": "array",
+          "maxItems": 50,
+          "items": {
+            "$ref": "#/definitions/ProductAttribute"
+          }
+        },
         "categoryId": {
-          "type": "integer"
+          "type": "integer",
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "currency": {
+          "type": "string",
+          "pattern": "^[A-Z]{3}$",
+          "x-nullable": true
         },
         "description": {
-          "type": "string"
+          "type": "string",
+          "maxLength": 5000,
+          "x-nullable": true
         },
         "images": {
           "type": "array",
@@ -4319,14 +12333,89 @@ func init() {
           }
         },
         "name": {
-          "type": "string"
+          "type": "string",
+          "maxLength": 200,
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "options": {
+          "description": "Replaces the option axes; every live variant must still fit them",
+          "type": "array",
+          "maxItems": 5,
+          "items": {
+            "$ref": "#/definitions/ProductOption"
+          }
         },
         "price": {
+          "description": "Only for products without variants",
           "type": "number",
-          "format": "float"
+          "format": "float",
+          "minimum": 0,
+          "exclusiveMinimum": true,
+          "x-nullable": true
         },
         "stock": {
-          "type": "integer"
+          "description": "Only for products without variants",
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "version": {
+          "description": "version of the product this change is based on",
+          "type": "integer",
+          "example": 3
+        }
+      }
+    },
+    "ProductVariant": {
+      "description": "A sellable SKU: one combination of option values with its own price, weight and stock.",
+      "type": "object",
+      "required": [
+        "id",
+        "productId",
+        "sku",
+        "options",
+        "price",
+        "stock"
+      ],
+      "properties": {
+        "barcode": {
+          "type": "string",
+          "example": "8901234567890"
+        },
+        "id": {
+          "type": "integer",
+          "example": 7001
+        },
+        "options": {
+          "description": "One value per product option, in the product's option order",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VariantOption"
+          }
+        },
+        "price": {
+          "type": "number",
+          "format": "float",
+          "example": 18999
+        },
+        "productId": {
+          "type": "integer",
+          "example": 101
+        },
+        "sku": {
+          "type": "string",
+          "example": "RING-101-18K-Y-7"
+        },
+        "stock": {
+          "type": "integer",
+          "example": 4
+        },
+        "weight": {
+          "description": "Metal weight in grams",
+          "type": "number",
+          "format": "float",
+          "example": 3.25
         }
       }
     },
@@ -4361,12 +12450,10 @@ func init() {
       }
     },
     "RegisterRequest": {
-      "description": "Payload to register a new user.",
+      "description": "Payload to register a new user. Either email and password, or phone and the code sent to it by sendOTP for a passwordless account.",
       "type": "object",
       "required": [
-        "name",
-        "email",
-        "password"
+        "name"
       ],
       "properties": {
         "email": {
@@ -4378,13 +12465,19 @@ func init() {
           "type": "string",
           "example": "Paras Jain"
         },
+        "otp": {
+          "description": "Code sent to the phone by sendOTP; registers without a password",
+          "type": "string",
+          "example": "123456"
+        },
         "password": {
           "type": "string",
           "example": "strongPassword123"
         },
         "phone": {
+          "description": "Mobile number; national numbers are read in the default region and stored in E.164",
           "type": "string",
-          "example": 919876543210
+          "example": "+919876543210"
         }
       }
     },
@@ -4419,6 +12512,39 @@ func init() {
         }
       }
     },
+    "Session": {
+      "description": "A signed-in device (one per refresh token).",
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "current": {
+          "description": "True for the session making this request",
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string",
+          "example": "6f1c2b9e-3d4a-4f7e-9a51-0c2d8e7b1a44"
+        },
+        "ipAddress": {
+          "type": "string",
+          "example": "203.0.113.24"
+        },
+        "lastUsedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "userAgent": {
+          "type": "string",
+          "example": "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X)"
+        }
+      }
+    },
     "ShippingOption": {
       "description": "Represents available shipping option.",
       "type": "object",
@@ -4522,12 +12648,20 @@ func init() {
           "type": "string",
           "format": "date-time"
         },
+        "disabled": {
+          "description": "True while an admin has the account disabled",
+          "type": "boolean"
+        },
         "email": {
           "description": "User email address",
           "type": "string",
           "format": "email",
           "example": "paras@example.com"
         },
+        "emailVerified": {
+          "description": "True once the email has been confirmed",
+          "type": "boolean"
+        },
         "id": {
           "description": "Unique user identifier",
           "type": "integer",
@@ -4542,10 +12676,38 @@ func init() {
           "description": "Password of user",
           "type": "string"
         },
+        "pendingEmail": {
+          "description": "New email waiting for confirmation from the link sent to it",
+          "type": "string",
+          "format": "email"
+        },
+        "pendingPhone": {
+          "description": "New phone waiting for confirmation with the code sent to it",
+          "type": "string"
+        },
         "phone": {
-          "description": "User phone number",
+          "description": "Mobile number in E.164",
+          "type": "string",
+          "example": "+919876543210"
+        },
+        "phoneCountry": {
+          "description": "ISO 3166-1 region of the phone number",
           "type": "string",
-          "example": 919876543210
+          "example": "IN"
+        },
+        "phoneVerified": {
+          "description": "True once the phone has been confirmed",
+          "type": "boolean"
+        },
+        "roles": {
+          "description": "Roles granted to the user (admin views only)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "customer"
+          ]
         },
         "updatedAt": {
           "description": "Last update timestamp",
@@ -4571,20 +12733,18 @@ func init() {
         "baseRole": {
           "description": "Base Role for this User",
           "type": "string",
-          "default": "user-readonly",
+          "default": "customer",
           "enum": [
-            "user-admin",
-            "user-readonly",
-            "service-admin",
-            "service-readonly",
-            "disabled",
-            "pending"
+            "admin",
+            "catalog-manager",
+            "support",
+            "customer"
           ],
           "x-go-custom-tag": "datastore:\"baseRole\"",
           "x-isnullable": false
         },
         "memberOf": {
-          "description": "Member of (Tenants)",
+          "description": "Roles granted to this User",
           "type": "array",
           "items": {
             "type": "string"
@@ -4607,17 +12767,174 @@ func init() {
         }
       }
     },
+    "UserListResponse": {
+      "description": "Paginated list of users.",
+      "type": "object",
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/User"
+          }
+        },
+        "limit": {
+          "type": "integer",
+          "example": 20
+        },
+        "page": {
+          "type": "integer",
+          "example": 1
+        },
+        "total": {
+          "type": "integer",
+          "example": 100
+        }
+      }
+    },
     "UserUpdateRequest": {
-      "description": "Payload to update user profile.",
+      "description": "Partial update of the own profile. A new email or phone only takes effect once confirmed; a new password needs the current one.",
       "type": "object",
       "properties": {
+        "currentPassword": {
+          "type": "string"
+        },
+        "email": {
+          "type": "string",
+          "format": "email",
+          "example": "paras@example.com"
+        },
         "name": {
           "type": "string",
           "example": "Paras Jain"
         },
+        "newPassword": {
+          "type": "string"
+        },
         "phone": {
           "type": "string",
-          "example": 919876543210
+          "example": "+919876543210"
+        }
+      }
+    },
+    "VariantCreateRequest": {
+      "description": "Request to add a variant to a product.",
+      "type": "object",
+      "required": [
+        "sku",
+        "options",
+        "price",
+        "stock"
+      ],
+      "properties": {
+        "barcode": {
+          "description": "GTIN (EAN-8, UPC-A, EAN-13 or GTIN-14)",
+          "type": "string",
+          "pattern": "^[0-9]{8,14}$"
+        },
+        "options": {
+          "description": "Exactly one value for each of the product options",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VariantOption"
+          }
+        },
+        "price": {
+          "type": "number",
+          "format": "float",
+          "minimum": 0,
+          "exclusiveMinimum": true
+        },
+        "sku": {
+          "type": "string",
+          "maxLength": 64,
+          "minLength": 1,
+          "pattern": "^[A-Za-z0-9][A-Za-z0-9._-]*$"
+        },
+        "stock": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "weight": {
+          "description": "Metal weight in grams",
+          "type": "number",
+          "format": "float",
+          "minimum": 0
+        }
+      }
+    },
+    "VariantOption": {
+      "description": "The value a variant takes on one option axis.",
+      "type": "object",
+      "required": [
+        "name",
+        "value"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "example": "Purity"
+        },
+        "value": {
+          "type": "string",
+          "example": "18K"
+        }
+      }
+    },
+    "VariantUpdateRequest": {
+      "description": "Changes to a variant. Omitted fields are left unchanged; options replaces the whole combination.",
+      "type": "object",
+      "properties": {
+        "barcode": {
+          "description": "GTIN; an empty string clears it",
+          "type": "string",
+          "pattern": "^([0-9]{8,14})?$",
+          "x-nullable": true
+        },
+        "options": {
+          "description": "Exactly one value for each of the product options",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VariantOption"
+          }
+        },
+        "price": {
+          "type": "number",
+          "format": "float",
+          "minimum": 0,
+          "exclusiveMinimum": true,
+          "x-nullable": true
+        },
+        "sku": {
+          "type": "string",
+          "maxLength": 64,
+          "minLength": 1,
+          "pattern": "^[A-Za-z0-9][A-Za-z0-9._-]*$",
+          "x-nullable": true
+        },
+        "stock": {
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "weight": {
+          "description": "Metal weight in grams",
+          "type": "number",
+          "format": "float",
+          "minimum": 0,
+          "x-nullable": true
+        }
+      }
+    },
+    "VerifyEmailRequest": {
+      "description": "Token from the email verification link.",
+      "type": "object",
+      "required": [
+        "token"
+      ],
+      "properties": {
+        "token": {
+          "type": "string",
+          "example": "verify_token_xyz"
         }
       }
     },
@@ -4645,17 +12962,42 @@ func init() {
           "type": "string",
           "example": "Login successful"
         },
+        "newUser": {
+          "description": "True when this login created the account",
+          "type": "boolean"
+        },
+        "refreshToken": {
+          "type": "string",
+          "example": "refresh-token-here"
+        },
         "token": {
           "type": "string",
           "example": "jwt-token-here"
+        },
+        "user": {
+          "$ref": "#/definitions/User"
+        }
+      }
+    },
+    "VerifyPhoneRequest": {
+      "description": "Code sent to the phone by SMS.",
+      "type": "object",
+      "required": [
+        "code"
+      ],
+      "properties": {
+        "code": {
+          "type": "string",
+          "example": "123456"
         }
       }
     }
   },
   "securityDefinitions": {
     "apiKey": {
+      "description": "Back-office API key (admin operations only)",
       "type": "apiKey",
-      "name": "x-api-key",
+      "name": "X-API-Key",
       "in": "header"
     },
     "basicAuth": {
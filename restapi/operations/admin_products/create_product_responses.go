@@ -6,6 +6,8 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+
+	"Adornme/models"
 )
 
 // CreateProductCreatedCode is the HTTP code returned for type CreateProductCreated
@@ -17,6 +19,11 @@ CreateProductCreated Product created successfully
 swagger:response createProductCreated
 */
 type CreateProductCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Product `json:"body,omitempty"`
 }
 
 // NewCreateProductCreated creates CreateProductCreated with default headers values
@@ -25,12 +32,27 @@ func NewCreateProductCreated() *CreateProductCreated {
 	return &CreateProductCreated{}
 }
 
+// WithPayload adds the payload to the create product created response
+func (o *CreateProductCreated) WithPayload(payload *models.Product) *CreateProductCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create product created response
+func (o *CreateProductCreated) SetPayload(payload *models.Product) {
+	o.Payload = payload
+}
+
 // WriteResponse to the client
 func (o *CreateProductCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.Header().Del(runtime.HeaderContentType) // Remove Content-Type on empty responses
-
 	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
 
 // CreateProductBadRequestCode is the HTTP code returned for type CreateProductBadRequest
@@ -42,6 +64,11 @@ CreateProductBadRequest Validation error
 swagger:response createProductBadRequest
 */
 type CreateProductBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
 }
 
 // NewCreateProductBadRequest creates CreateProductBadRequest with default headers values
@@ -50,12 +77,27 @@ func NewCreateProductBadRequest() *CreateProductBadRequest {
 	return &CreateProductBadRequest{}
 }
 
+// WithPayload adds the payload to the create product bad request response
+func (o *CreateProductBadRequest) WithPayload(payload *models.ErrorResponse) *CreateProductBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create product bad request response
+func (o *CreateProductBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
 // WriteResponse to the client
 func (o *CreateProductBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.Header().Del(runtime.HeaderContentType) // Remove Content-Type on empty responses
-
 	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
 
 // CreateProductUnauthorizedCode is the HTTP code returned for type CreateProductUnauthorized
@@ -67,6 +109,11 @@ CreateProductUnauthorized Unauthorized
 swagger:response createProductUnauthorized
 */
 type CreateProductUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
 }
 
 // NewCreateProductUnauthorized creates CreateProductUnauthorized with default headers values
@@ -75,10 +122,70 @@ func NewCreateProductUnauthorized() *CreateProductUnauthorized {
 	return &CreateProductUnauthorized{}
 }
 
+// WithPayload adds the payload to the create product unauthorized response
+func (o *CreateProductUnauthorized) WithPayload(payload *models.ErrorResponse) *CreateProductUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create product unauthorized response
+func (o *CreateProductUnauthorized) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
 // WriteResponse to the client
 func (o *CreateProductUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.Header().Del(runtime.HeaderContentType) // Remove Content-Type on empty responses
-
 	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateProductForbiddenCode is the HTTP code returned for type CreateProductForbidden
+const CreateProductForbiddenCode int = 403
+
+/*
+CreateProductForbidden Forbidden
+
+swagger:response createProductForbidden
+*/
+type CreateProductForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateProductForbidden creates CreateProductForbidden with default headers values
+func NewCreateProductForbidden() *CreateProductForbidden {
+
+	return &CreateProductForbidden{}
+}
+
+// WithPayload adds the payload to the create product forbidden response
+func (o *CreateProductForbidden) WithPayload(payload *models.ErrorResponse) *CreateProductForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create product forbidden response
+func (o *CreateProductForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateProductForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
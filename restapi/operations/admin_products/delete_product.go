@@ -31,7 +31,9 @@ func NewDeleteProduct(ctx *middleware.Context, handler DeleteProductHandler) *De
 /*
 	DeleteProduct swagger:route DELETE /products/{id} AdminProducts deleteProduct
 
-Delete a product
+# Delete a product
+
+Archives the product. It disappears from listings but still resolves by id for existing orders.
 */
 type DeleteProduct struct {
 	Context *middleware.Context
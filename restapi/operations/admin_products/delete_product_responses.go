@@ -6,6 +6,8 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+
+	"Adornme/models"
 )
 
 // DeleteProductNoContentCode is the HTTP code returned for type DeleteProductNoContent
@@ -32,3 +34,138 @@ func (o *DeleteProductNoContent) WriteResponse(rw http.ResponseWriter, producer
 
 	rw.WriteHeader(204)
 }
+
+// DeleteProductUnauthorizedCode is the HTTP code returned for type DeleteProductUnauthorized
+const DeleteProductUnauthorizedCode int = 401
+
+/*
+DeleteProductUnauthorized Unauthorized
+
+swagger:response deleteProductUnauthorized
+*/
+type DeleteProductUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeleteProductUnauthorized creates DeleteProductUnauthorized with default headers values
+func NewDeleteProductUnauthorized() *DeleteProductUnauthorized {
+
+	return &DeleteProductUnauthorized{}
+}
+
+// WithPayload adds the payload to the delete product unauthorized response
+func (o *DeleteProductUnauthorized) WithPayload(payload *models.ErrorResponse) *DeleteProductUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete product unauthorized response
+func (o *DeleteProductUnauthorized) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteProductUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteProductForbiddenCode is the HTTP code returned for type DeleteProductForbidden
+const DeleteProductForbiddenCode int = 403
+
+/*
+DeleteProductForbidden Forbidden
+
+swagger:response deleteProductForbidden
+*/
+type DeleteProductForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeleteProductForbidden creates DeleteProductForbidden with default headers values
+func NewDeleteProductForbidden() *DeleteProductForbidden {
+
+	return &DeleteProductForbidden{}
+}
+
+// WithPayload adds the payload to the delete product forbidden response
+func (o *DeleteProductForbidden) WithPayload(payload *models.ErrorResponse) *DeleteProductForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete product forbidden response
+func (o *DeleteProductForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteProductForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteProductNotFoundCode is the HTTP code returned for type DeleteProductNotFound
+const DeleteProductNotFoundCode int = 404
+
+/*
+DeleteProductNotFound Product not found or already archived
+
+swagger:response deleteProductNotFound
+*/
+type DeleteProductNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeleteProductNotFound creates DeleteProductNotFound with default headers values
+func NewDeleteProductNotFound() *DeleteProductNotFound {
+
+	return &DeleteProductNotFound{}
+}
+
+// WithPayload adds the payload to the delete product not found response
+func (o *DeleteProductNotFound) WithPayload(payload *models.ErrorResponse) *DeleteProductNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete product not found response
+func (o *DeleteProductNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteProductNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+
+	"Adornme/models"
 )
 
 // UpdateProductOKCode is the HTTP code returned for type UpdateProductOK
@@ -17,6 +19,11 @@ UpdateProductOK Product updated
 swagger:response updateProductOK
 */
 type UpdateProductOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Product `json:"body,omitempty"`
 }
 
 // NewUpdateProductOK creates UpdateProductOK with default headers values
@@ -25,10 +32,250 @@ func NewUpdateProductOK() *UpdateProductOK {
 	return &UpdateProductOK{}
 }
 
+// WithPayload adds the payload to the update product o k response
+func (o *UpdateProductOK) WithPayload(payload *models.Product) *UpdateProductOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update product o k response
+func (o *UpdateProductOK) SetPayload(payload *models.Product) {
+	o.Payload = payload
+}
+
 // WriteResponse to the client
 func (o *UpdateProductOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.Header().Del(runtime.HeaderContentType) // Remove Content-Type on empty responses
-
 	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateProductBadRequestCode is the HTTP code returned for type UpdateProductBadRequest
+const UpdateProductBadRequestCode int = 400
+
+/*
+UpdateProductBadRequest Validation error
+
+swagger:response updateProductBadRequest
+*/
+type UpdateProductBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateProductBadRequest creates UpdateProductBadRequest with default headers values
+func NewUpdateProductBadRequest() *UpdateProductBadRequest {
+
+	return &UpdateProductBadRequest{}
+}
+
+// WithPayload adds the payload to the update product bad request response
+func (o *UpdateProductBadRequest) WithPayload(payload *models.ErrorResponse) *UpdateProductBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update product bad request response
+func (o *UpdateProductBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateProductBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateProductUnauthorizedCode is the HTTP code returned for type UpdateProductUnauthorized
+const UpdateProductUnauthorizedCode int = 401
+
+/*
+UpdateProductUnauthorized Unauthorized
+
+swagger:response updateProductUnauthorized
+*/
+type UpdateProductUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateProductUnauthorized creates UpdateProductUnauthorized with default headers values
+func NewUpdateProductUnauthorized() *UpdateProductUnauthorized {
+
+	return &UpdateProductUnauthorized{}
+}
+
+// WithPayload adds the payload to the update product unauthorized response
+func (o *UpdateProductUnauthorized) WithPayload(payload *models.ErrorResponse) *UpdateProductUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update product unauthorized response
+func (o *UpdateProductUnauthorized) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateProductUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateProductForbiddenCode is the HTTP code returned for type UpdateProductForbidden
+const UpdateProductForbiddenCode int = 403
+
+/*
+UpdateProductForbidden Forbidden
+
+swagger:response updateProductForbidden
+*/
+type UpdateProductForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateProductForbidden creates UpdateProductForbidden with default headers values
+func NewUpdateProductForbidden() *UpdateProductForbidden {
+
+	return &UpdateProductForbidden{}
+}
+
+// WithPayload adds the payload to the update product forbidden response
+func (o *UpdateProductForbidden) WithPayload(payload *models.ErrorResponse) *UpdateProductForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update product forbidden response
+func (o *UpdateProductForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateProductForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateProductNotFoundCode is the HTTP code returned for type UpdateProductNotFound
+const UpdateProductNotFoundCode int = 404
+
+/*
+UpdateProductNotFound Product not found or archived
+
+swagger:response updateProductNotFound
+*/
+type UpdateProductNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateProductNotFound creates UpdateProductNotFound with default headers values
+func NewUpdateProductNotFound() *UpdateProductNotFound {
+
+	return &UpdateProductNotFound{}
+}
+
+// WithPayload adds the payload to the update product not found response
+func (o *UpdateProductNotFound) WithPayload(payload *models.ErrorResponse) *UpdateProductNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update product not found response
+func (o *UpdateProductNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateProductNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateProductConflictCode is the HTTP code returned for type UpdateProductConflict
+const UpdateProductConflictCode int = 409
+
+/*
+UpdateProductConflict Product was changed since the given version; reload and retry
+
+swagger:response updateProductConflict
+*/
+type UpdateProductConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateProductConflict creates UpdateProductConflict with default headers values
+func NewUpdateProductConflict() *UpdateProductConflict {
+
+	return &UpdateProductConflict{}
+}
+
+// WithPayload adds the payload to the update product conflict response
+func (o *UpdateProductConflict) WithPayload(payload *models.ErrorResponse) *UpdateProductConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update product conflict response
+func (o *UpdateProductConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateProductConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
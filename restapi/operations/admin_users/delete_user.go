@@ -31,7 +31,7 @@ func NewDeleteUser(ctx *middleware.Context, handler DeleteUserHandler) *DeleteUs
 /*
 	DeleteUser swagger:route DELETE /users/{id} AdminUsers deleteUser
 
-Delete a user
+Delete a user (anonymised; order history is kept)
 */
 type DeleteUser struct {
 	Context *middleware.Context
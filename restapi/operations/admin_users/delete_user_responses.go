@@ -6,6 +6,8 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+
+	"Adornme/models"
 )
 
 // DeleteUserNoContentCode is the HTTP code returned for type DeleteUserNoContent
@@ -32,3 +34,138 @@ func (o *DeleteUserNoContent) WriteResponse(rw http.ResponseWriter, producer run
 
 	rw.WriteHeader(204)
 }
+
+// DeleteUserBadRequestCode is the HTTP code returned for type DeleteUserBadRequest
+const DeleteUserBadRequestCode int = 400
+
+/*
+DeleteUserBadRequest Cannot delete your own account
+
+swagger:response deleteUserBadRequest
+*/
+type DeleteUserBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeleteUserBadRequest creates DeleteUserBadRequest with default headers values
+func NewDeleteUserBadRequest() *DeleteUserBadRequest {
+
+	return &DeleteUserBadRequest{}
+}
+
+// WithPayload adds the payload to the delete user bad request response
+func (o *DeleteUserBadRequest) WithPayload(payload *models.ErrorResponse) *DeleteUserBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete user bad request response
+func (o *DeleteUserBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteUserBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteUserForbiddenCode is the HTTP code returned for type DeleteUserForbidden
+const DeleteUserForbiddenCode int = 403
+
+/*
+DeleteUserForbidden Forbidden
+
+swagger:response deleteUserForbidden
+*/
+type DeleteUserForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeleteUserForbidden creates DeleteUserForbidden with default headers values
+func NewDeleteUserForbidden() *DeleteUserForbidden {
+
+	return &DeleteUserForbidden{}
+}
+
+// WithPayload adds the payload to the delete user forbidden response
+func (o *DeleteUserForbidden) WithPayload(payload *models.ErrorResponse) *DeleteUserForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete user forbidden response
+func (o *DeleteUserForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteUserForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteUserNotFoundCode is the HTTP code returned for type DeleteUserNotFound
+const DeleteUserNotFoundCode int = 404
+
+/*
+DeleteUserNotFound User not found
+
+swagger:response deleteUserNotFound
+*/
+type DeleteUserNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeleteUserNotFound creates DeleteUserNotFound with default headers values
+func NewDeleteUserNotFound() *DeleteUserNotFound {
+
+	return &DeleteUserNotFound{}
+}
+
+// WithPayload adds the payload to the delete user not found response
+func (o *DeleteUserNotFound) WithPayload(payload *models.ErrorResponse) *DeleteUserNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete user not found response
+func (o *DeleteUserNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteUserNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+
+	"Adornme/models"
 )
 
 // GetUserOKCode is the HTTP code returned for type GetUserOK
@@ -17,6 +19,11 @@ GetUserOK User details
 swagger:response getUserOK
 */
 type GetUserOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.User `json:"body,omitempty"`
 }
 
 // NewGetUserOK creates GetUserOK with default headers values
@@ -25,10 +32,115 @@ func NewGetUserOK() *GetUserOK {
 	return &GetUserOK{}
 }
 
+// WithPayload adds the payload to the get user o k response
+func (o *GetUserOK) WithPayload(payload *models.User) *GetUserOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user o k response
+func (o *GetUserOK) SetPayload(payload *models.User) {
+	o.Payload = payload
+}
+
 // WriteResponse to the client
 func (o *GetUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.Header().Del(runtime.HeaderContentType) // Remove Content-Type on empty responses
-
 	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetUserForbiddenCode is the HTTP code returned for type GetUserForbidden
+const GetUserForbiddenCode int = 403
+
+/*
+GetUserForbidden Forbidden
+
+swagger:response getUserForbidden
+*/
+type GetUserForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetUserForbidden creates GetUserForbidden with default headers values
+func NewGetUserForbidden() *GetUserForbidden {
+
+	return &GetUserForbidden{}
+}
+
+// WithPayload adds the payload to the get user forbidden response
+func (o *GetUserForbidden) WithPayload(payload *models.ErrorResponse) *GetUserForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user forbidden response
+func (o *GetUserForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetUserNotFoundCode is the HTTP code returned for type GetUserNotFound
+const GetUserNotFoundCode int = 404
+
+/*
+GetUserNotFound User not found
+
+swagger:response getUserNotFound
+*/
+type GetUserNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetUserNotFound creates GetUserNotFound with default headers values
+func NewGetUserNotFound() *GetUserNotFound {
+
+	return &GetUserNotFound{}
+}
+
+// WithPayload adds the payload to the get user not found response
+func (o *GetUserNotFound) WithPayload(payload *models.ErrorResponse) *GetUserNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user not found response
+func (o *GetUserNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
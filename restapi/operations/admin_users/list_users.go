@@ -31,7 +31,7 @@ func NewListUsers(ctx *middleware.Context, handler ListUsersHandler) *ListUsers
 /*
 	ListUsers swagger:route GET /users AdminUsers listUsers
 
-List all users
+List users with filters
 */
 type ListUsers struct {
 	Context *middleware.Context
@@ -10,6 +10,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NewListUsersParams creates a new ListUsersParams object
@@ -38,6 +39,28 @@ type ListUsersParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Created at or after
+	  In: query
+	  Format: date-time
+	*/
+	CreatedFrom *strfmt.DateTime
+
+	/*Created before
+	  In: query
+	  Format: date-time
+	*/
+	CreatedTo *strfmt.DateTime
+
+	/*
+	  In: query
+	*/
+	Disabled *bool
+
+	/*Email contains (case-insensitive)
+	  In: query
+	*/
+	Email *string
+
 	/*
 	  In: query
 	  Default: 20
@@ -49,6 +72,22 @@ type ListUsersParams struct {
 	  Default: 1
 	*/
 	Page *int64
+
+	/*Phone contains
+	  In: query
+	*/
+	Phone *string
+
+	/*
+	  In: query
+	  Enum: ["admin","catalog-manager","support","customer"]
+	*/
+	Role *string
+
+	/*Has (or lacks) a verified email or phone
+	  In: query
+	*/
+	Verified *bool
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -61,6 +100,26 @@ func (o *ListUsersParams) BindRequest(r *http.Request, route *middleware.Matched
 	o.HTTPRequest = r
 	qs := runtime.Values(r.URL.Query())
 
+	qCreatedFrom, qhkCreatedFrom, _ := qs.GetOK("createdFrom")
+	if err := o.bindCreatedFrom(qCreatedFrom, qhkCreatedFrom, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCreatedTo, qhkCreatedTo, _ := qs.GetOK("createdTo")
+	if err := o.bindCreatedTo(qCreatedTo, qhkCreatedTo, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qDisabled, qhkDisabled, _ := qs.GetOK("disabled")
+	if err := o.bindDisabled(qDisabled, qhkDisabled, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qEmail, qhkEmail, _ := qs.GetOK("email")
+	if err := o.bindEmail(qEmail, qhkEmail, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qLimit, qhkLimit, _ := qs.GetOK("limit")
 	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
 		res = append(res, err)
@@ -70,12 +129,142 @@ func (o *ListUsersParams) BindRequest(r *http.Request, route *middleware.Matched
 	if err := o.bindPage(qPage, qhkPage, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qPhone, qhkPhone, _ := qs.GetOK("phone")
+	if err := o.bindPhone(qPhone, qhkPhone, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qRole, qhkRole, _ := qs.GetOK("role")
+	if err := o.bindRole(qRole, qhkRole, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qVerified, qhkVerified, _ := qs.GetOK("verified")
+	if err := o.bindVerified(qVerified, qhkVerified, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindCreatedFrom binds and validates parameter CreatedFrom from query.
+func (o *ListUsersParams) bindCreatedFrom(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("createdFrom", "query", "strfmt.DateTime", raw)
+	}
+	o.CreatedFrom = (value.(*strfmt.DateTime))
+
+	if err := o.validateCreatedFrom(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateCreatedFrom carries on validations for parameter CreatedFrom
+func (o *ListUsersParams) validateCreatedFrom(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("createdFrom", "query", "date-time", o.CreatedFrom.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindCreatedTo binds and validates parameter CreatedTo from query.
+func (o *ListUsersParams) bindCreatedTo(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("createdTo", "query", "strfmt.DateTime", raw)
+	}
+	o.CreatedTo = (value.(*strfmt.DateTime))
+
+	if err := o.validateCreatedTo(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateCreatedTo carries on validations for parameter CreatedTo
+func (o *ListUsersParams) validateCreatedTo(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("createdTo", "query", "date-time", o.CreatedTo.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindDisabled binds and validates parameter Disabled from query.
+func (o *ListUsersParams) bindDisabled(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("disabled", "query", "bool", raw)
+	}
+	o.Disabled = &value
+
+	return nil
+}
+
+// bindEmail binds and validates parameter Email from query.
+func (o *ListUsersParams) bindEmail(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Email = &raw
+
+	return nil
+}
+
 // bindLimit binds and validates parameter Limit from query.
 func (o *ListUsersParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -123,3 +312,76 @@ func (o *ListUsersParams) bindPage(rawData []string, hasKey bool, formats strfmt
 
 	return nil
 }
+
+// bindPhone binds and validates parameter Phone from query.
+func (o *ListUsersParams) bindPhone(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Phone = &raw
+
+	return nil
+}
+
+// bindRole binds and validates parameter Role from query.
+func (o *ListUsersParams) bindRole(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Role = &raw
+
+	if err := o.validateRole(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateRole carries on validations for parameter Role
+func (o *ListUsersParams) validateRole(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("role", "query", *o.Role, []any{"admin", "catalog-manager", "support", "customer"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindVerified binds and validates parameter Verified from query.
+func (o *ListUsersParams) bindVerified(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("verified", "query", "bool", raw)
+	}
+	o.Verified = &value
+
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+
+	"Adornme/models"
 )
 
 // ListUsersOKCode is the HTTP code returned for type ListUsersOK
@@ -17,6 +19,11 @@ ListUsersOK List of users
 swagger:response listUsersOK
 */
 type ListUsersOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.UserListResponse `json:"body,omitempty"`
 }
 
 // NewListUsersOK creates ListUsersOK with default headers values
@@ -25,10 +32,115 @@ func NewListUsersOK() *ListUsersOK {
 	return &ListUsersOK{}
 }
 
+// WithPayload adds the payload to the list users o k response
+func (o *ListUsersOK) WithPayload(payload *models.UserListResponse) *ListUsersOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list users o k response
+func (o *ListUsersOK) SetPayload(payload *models.UserListResponse) {
+	o.Payload = payload
+}
+
 // WriteResponse to the client
 func (o *ListUsersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.Header().Del(runtime.HeaderContentType) // Remove Content-Type on empty responses
-
 	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListUsersBadRequestCode is the HTTP code returned for type ListUsersBadRequest
+const ListUsersBadRequestCode int = 400
+
+/*
+ListUsersBadRequest Invalid filter
+
+swagger:response listUsersBadRequest
+*/
+type ListUsersBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewListUsersBadRequest creates ListUsersBadRequest with default headers values
+func NewListUsersBadRequest() *ListUsersBadRequest {
+
+	return &ListUsersBadRequest{}
+}
+
+// WithPayload adds the payload to the list users bad request response
+func (o *ListUsersBadRequest) WithPayload(payload *models.ErrorResponse) *ListUsersBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list users bad request response
+func (o *ListUsersBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUsersBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListUsersForbiddenCode is the HTTP code returned for type ListUsersForbidden
+const ListUsersForbiddenCode int = 403
+
+/*
+ListUsersForbidden Forbidden
+
+swagger:response listUsersForbidden
+*/
+type ListUsersForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewListUsersForbidden creates ListUsersForbidden with default headers values
+func NewListUsersForbidden() *ListUsersForbidden {
+
+	return &ListUsersForbidden{}
+}
+
+// WithPayload adds the payload to the list users forbidden response
+func (o *ListUsersForbidden) WithPayload(payload *models.ErrorResponse) *ListUsersForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list users forbidden response
+func (o *ListUsersForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUsersForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
@@ -7,13 +7,21 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
 
 // ListUsersURL generates an URL for the list users operation
 type ListUsersURL struct {
-	Limit *int64
-	Page  *int64
+	CreatedFrom *strfmt.DateTime
+	CreatedTo   *strfmt.DateTime
+	Disabled    *bool
+	Email       *string
+	Limit       *int64
+	Page        *int64
+	Phone       *string
+	Role        *string
+	Verified    *bool
 
 	_basePath string
 	// avoid unkeyed usage
@@ -46,6 +54,38 @@ func (o *ListUsersURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var createdFromQ string
+	if o.CreatedFrom != nil {
+		createdFromQ = o.CreatedFrom.String()
+	}
+	if createdFromQ != "" {
+		qs.Set("createdFrom", createdFromQ)
+	}
+
+	var createdToQ string
+	if o.CreatedTo != nil {
+		createdToQ = o.CreatedTo.String()
+	}
+	if createdToQ != "" {
+		qs.Set("createdTo", createdToQ)
+	}
+
+	var disabledQ string
+	if o.Disabled != nil {
+		disabledQ = swag.FormatBool(*o.Disabled)
+	}
+	if disabledQ != "" {
+		qs.Set("disabled", disabledQ)
+	}
+
+	var emailQ string
+	if o.Email != nil {
+		emailQ = *o.Email
+	}
+	if emailQ != "" {
+		qs.Set("email", emailQ)
+	}
+
 	var limitQ string
 	if o.Limit != nil {
 		limitQ = swag.FormatInt64(*o.Limit)
@@ -62,6 +102,30 @@ func (o *ListUsersURL) Build() (*url.URL, error) {
 		qs.Set("page", pageQ)
 	}
 
+	var phoneQ string
+	if o.Phone != nil {
+		phoneQ = *o.Phone
+	}
+	if phoneQ != "" {
+		qs.Set("phone", phoneQ)
+	}
+
+	var roleQ string
+	if o.Role != nil {
+		roleQ = *o.Role
+	}
+	if roleQ != "" {
+		qs.Set("role", roleQ)
+	}
+
+	var verifiedQ string
+	if o.Verified != nil {
+		verifiedQ = swag.FormatBool(*o.Verified)
+	}
+	if verifiedQ != "" {
+		qs.Set("verified", verifiedQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
@@ -31,7 +31,7 @@ func NewUpdateUser(ctx *middleware.Context, handler UpdateUserHandler) *UpdateUs
 /*
 	UpdateUser swagger:route PUT /users/{id} AdminUsers updateUser
 
-Update user info
+Update user info and roles
 */
 type UpdateUser struct {
 	Context *middleware.Context
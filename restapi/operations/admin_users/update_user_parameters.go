@@ -37,7 +37,7 @@ type UpdateUserParams struct {
 	  Required: true
 	  In: body
 	*/
-	Body *models.UserUpdateRequest
+	Body *models.AdminUserUpdateRequest
 
 	/*
 	  Required: true
@@ -59,7 +59,7 @@ func (o *UpdateUserParams) BindRequest(r *http.Request, route *middleware.Matche
 		defer func() {
 			_ = r.Body.Close()
 		}()
-		var body models.UserUpdateRequest
+		var body models.AdminUserUpdateRequest
 		if err := route.Consumer.Consume(r.Body, &body); err != nil {
 			if stderrors.Is(err, io.EOF) {
 				res = append(res, errors.Required("body", "body", ""))
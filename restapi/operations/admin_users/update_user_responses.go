@@ -6,6 +6,8 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+
+	"Adornme/models"
 )
 
 // UpdateUserOKCode is the HTTP code returned for type UpdateUserOK
@@ -17,6 +19,11 @@ UpdateUserOK User updated
 swagger:response updateUserOK
 */
 type UpdateUserOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.User `json:"body,omitempty"`
 }
 
 // NewUpdateUserOK creates UpdateUserOK with default headers values
@@ -25,10 +32,205 @@ func NewUpdateUserOK() *UpdateUserOK {
 	return &UpdateUserOK{}
 }
 
+// WithPayload adds the payload to the update user o k response
+func (o *UpdateUserOK) WithPayload(payload *models.User) *UpdateUserOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user o k response
+func (o *UpdateUserOK) SetPayload(payload *models.User) {
+	o.Payload = payload
+}
+
 // WriteResponse to the client
 func (o *UpdateUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.Header().Del(runtime.HeaderContentType) // Remove Content-Type on empty responses
-
 	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateUserBadRequestCode is the HTTP code returned for type UpdateUserBadRequest
+const UpdateUserBadRequestCode int = 400
+
+/*
+UpdateUserBadRequest Invalid input
+
+swagger:response updateUserBadRequest
+*/
+type UpdateUserBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateUserBadRequest creates UpdateUserBadRequest with default headers values
+func NewUpdateUserBadRequest() *UpdateUserBadRequest {
+
+	return &UpdateUserBadRequest{}
+}
+
+// WithPayload adds the payload to the update user bad request response
+func (o *UpdateUserBadRequest) WithPayload(payload *models.ErrorResponse) *UpdateUserBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user bad request response
+func (o *UpdateUserBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateUserForbiddenCode is the HTTP code returned for type UpdateUserForbidden
+const UpdateUserForbiddenCode int = 403
+
+/*
+UpdateUserForbidden Forbidden
+
+swagger:response updateUserForbidden
+*/
+type UpdateUserForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateUserForbidden creates UpdateUserForbidden with default headers values
+func NewUpdateUserForbidden() *UpdateUserForbidden {
+
+	return &UpdateUserForbidden{}
+}
+
+// WithPayload adds the payload to the update user forbidden response
+func (o *UpdateUserForbidden) WithPayload(payload *models.ErrorResponse) *UpdateUserForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user forbidden response
+func (o *UpdateUserForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateUserNotFoundCode is the HTTP code returned for type UpdateUserNotFound
+const UpdateUserNotFoundCode int = 404
+
+/*
+UpdateUserNotFound User not found
+
+swagger:response updateUserNotFound
+*/
+type UpdateUserNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateUserNotFound creates UpdateUserNotFound with default headers values
+func NewUpdateUserNotFound() *UpdateUserNotFound {
+
+	return &UpdateUserNotFound{}
+}
+
+// WithPayload adds the payload to the update user not found response
+func (o *UpdateUserNotFound) WithPayload(payload *models.ErrorResponse) *UpdateUserNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user not found response
+func (o *UpdateUserNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateUserConflictCode is the HTTP code returned for type UpdateUserConflict
+const UpdateUserConflictCode int = 409
+
+/*
+UpdateUserConflict Email or phone already in use
+
+swagger:response updateUserConflict
+*/
+type UpdateUserConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateUserConflict creates UpdateUserConflict with default headers values
+func NewUpdateUserConflict() *UpdateUserConflict {
+
+	return &UpdateUserConflict{}
+}
+
+// WithPayload adds the payload to the update user conflict response
+func (o *UpdateUserConflict) WithPayload(payload *models.ErrorResponse) *UpdateUserConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user conflict response
+func (o *UpdateUserConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
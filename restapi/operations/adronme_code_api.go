@@ -17,11 +17,15 @@ import (
 	"github.com/go-openapi/swag"
 
 	"Adornme/models"
+	"Adornme/restapi/operations/admin_api_keys"
+	"Adornme/restapi/operations/admin_categories"
 	"Adornme/restapi/operations/admin_products"
 	"Adornme/restapi/operations/admin_users"
 	"Adornme/restapi/operations/cart"
+	"Adornme/restapi/operations/categories"
 	"Adornme/restapi/operations/orders"
 	"Adornme/restapi/operations/payments"
+	"Adornme/restapi/operations/products"
 	"Adornme/restapi/operations/shipping"
 	"Adornme/restapi/operations/system"
 	"Adornme/restapi/operations/users"
@@ -45,7 +49,8 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 		APIKeyAuthenticator: security.APIKeyAuth,
 		BearerAuthenticator: security.BearerAuth,
 
-		JSONConsumer: runtime.JSONConsumer(),
+		JSONConsumer:    runtime.JSONConsumer(),
+		UrlformConsumer: runtime.DiscardConsumer,
 
 		JSONProducer: runtime.JSONProducer(),
 
@@ -55,12 +60,6 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation users.OTPLogin has not yet been implemented")
 		}),
 
-		UsersPostAuthOtpResendHandler: users.PostAuthOtpResendHandlerFunc(func(params users.PostAuthOtpResendParams) middleware.Responder {
-			_ = params
-
-			return middleware.NotImplemented("operation users.PostAuthOtpResend has not yet been implemented")
-		}),
-
 		UsersVerifyOTPHandler: users.VerifyOTPHandlerFunc(func(params users.VerifyOTPParams) middleware.Responder {
 			_ = params
 
@@ -81,6 +80,19 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation shipping.AddShippingAddress has not yet been implemented")
 		}),
 
+		UsersBeginPasskeyLoginHandler: users.BeginPasskeyLoginHandlerFunc(func(params users.BeginPasskeyLoginParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation users.BeginPasskeyLogin has not yet been implemented")
+		}),
+
+		UsersBeginPasskeyRegistrationHandler: users.BeginPasskeyRegistrationHandlerFunc(func(params users.BeginPasskeyRegistrationParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.BeginPasskeyRegistration has not yet been implemented")
+		}),
+
 		CartClearCartHandler: cart.ClearCartHandlerFunc(func(params cart.ClearCartParams, principal *models.Principal) middleware.Responder {
 			_ = params
 			_ = principal
@@ -88,12 +100,53 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation cart.ClearCart has not yet been implemented")
 		}),
 
+		UsersConfirmEmailChangeHandler: users.ConfirmEmailChangeHandlerFunc(func(params users.ConfirmEmailChangeParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation users.ConfirmEmailChange has not yet been implemented")
+		}),
+
+		UsersConfirmMFAHandler: users.ConfirmMFAHandlerFunc(func(params users.ConfirmMFAParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.ConfirmMFA has not yet been implemented")
+		}),
+
 		PaymentsConfirmPaymentHandler: payments.ConfirmPaymentHandlerFunc(func(params payments.ConfirmPaymentParams) middleware.Responder {
 			_ = params
 
 			return middleware.NotImplemented("operation payments.ConfirmPayment has not yet been implemented")
 		}),
 
+		UsersConfirmPhoneChangeHandler: users.ConfirmPhoneChangeHandlerFunc(func(params users.ConfirmPhoneChangeParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.ConfirmPhoneChange has not yet been implemented")
+		}),
+
+		AdminAPIKeysCreateAPIKeyHandler: admin_api_keys.CreateAPIKeyHandlerFunc(func(params admin_api_keys.CreateAPIKeyParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_api_keys.CreateAPIKey has not yet been implemented")
+		}),
+
+		AdminCategoriesCreateCategoryHandler: admin_categories.CreateCategoryHandlerFunc(func(params admin_categories.CreateCategoryParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_categories.CreateCategory has not yet been implemented")
+		}),
+
+		AdminCategoriesCreateCategoryAttributeHandler: admin_categories.CreateCategoryAttributeHandlerFunc(func(params admin_categories.CreateCategoryAttributeParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_categories.CreateCategoryAttribute has not yet been implemented")
+		}),
+
 		AdminProductsCreateProductHandler: admin_products.CreateProductHandlerFunc(func(params admin_products.CreateProductParams, principal *models.Principal) middleware.Responder {
 			_ = params
 			_ = principal
@@ -101,6 +154,34 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation admin_products.CreateProduct has not yet been implemented")
 		}),
 
+		AdminProductsCreateVariantHandler: admin_products.CreateVariantHandlerFunc(func(params admin_products.CreateVariantParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_products.CreateVariant has not yet been implemented")
+		}),
+
+		AdminCategoriesDeleteCategoryHandler: admin_categories.DeleteCategoryHandlerFunc(func(params admin_categories.DeleteCategoryParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_categories.DeleteCategory has not yet been implemented")
+		}),
+
+		AdminCategoriesDeleteCategoryAttributeHandler: admin_categories.DeleteCategoryAttributeHandlerFunc(func(params admin_categories.DeleteCategoryAttributeParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_categories.DeleteCategoryAttribute has not yet been implemented")
+		}),
+
+		UsersDeletePasskeyHandler: users.DeletePasskeyHandlerFunc(func(params users.DeletePasskeyParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.DeletePasskey has not yet been implemented")
+		}),
+
 		AdminProductsDeleteProductHandler: admin_products.DeleteProductHandlerFunc(func(params admin_products.DeleteProductParams, principal *models.Principal) middleware.Responder {
 			_ = params
 			_ = principal
@@ -122,6 +203,40 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation admin_users.DeleteUser has not yet been implemented")
 		}),
 
+		AdminProductsDeleteVariantHandler: admin_products.DeleteVariantHandlerFunc(func(params admin_products.DeleteVariantParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_products.DeleteVariant has not yet been implemented")
+		}),
+
+		UsersDisableMFAHandler: users.DisableMFAHandlerFunc(func(params users.DisableMFAParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.DisableMFA has not yet been implemented")
+		}),
+
+		AdminUsersDisableUserHandler: admin_users.DisableUserHandlerFunc(func(params admin_users.DisableUserParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_users.DisableUser has not yet been implemented")
+		}),
+
+		AdminUsersEnableUserHandler: admin_users.EnableUserHandlerFunc(func(params admin_users.EnableUserParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_users.EnableUser has not yet been implemented")
+		}),
+
+		UsersEnrollMFAHandler: users.EnrollMFAHandlerFunc(func(params users.EnrollMFAParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation users.EnrollMFA has not yet been implemented")
+		}),
+
 		UsersForgetPasswordHandler: users.ForgetPasswordHandlerFunc(func(params users.ForgetPasswordParams) middleware.Responder {
 			_ = params
 
@@ -141,6 +256,12 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation system.GetHealth has not yet been implemented")
 		}),
 
+		SystemGetJWKSHandler: system.GetJWKSHandlerFunc(func(params system.GetJWKSParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation system.GetJWKS has not yet been implemented")
+		}),
+
 		OrdersGetOrderHandler: orders.GetOrderHandlerFunc(func(params orders.GetOrderParams, principal *models.Principal) middleware.Responder {
 			_ = params
 			_ = principal
@@ -155,6 +276,19 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation payments.GetPayment has not yet been implemented")
 		}),
 
+		UsersGetPrivacyJobHandler: users.GetPrivacyJobHandlerFunc(func(params users.GetPrivacyJobParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.GetPrivacyJob has not yet been implemented")
+		}),
+
+		ProductsGetProductHandler: products.GetProductHandlerFunc(func(params products.GetProductParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation products.GetProduct has not yet been implemented")
+		}),
+
 		AdminUsersGetUserHandler: admin_users.GetUserHandlerFunc(func(params admin_users.GetUserParams, principal *models.Principal) middleware.Responder {
 			_ = params
 			_ = principal
@@ -175,6 +309,13 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation users.IdentifyUser has not yet been implemented")
 		}),
 
+		AdminUsersImpersonateUserHandler: admin_users.ImpersonateUserHandlerFunc(func(params admin_users.ImpersonateUserParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_users.ImpersonateUser has not yet been implemented")
+		}),
+
 		PaymentsInitiatePaymentHandler: payments.InitiatePaymentHandlerFunc(func(params payments.InitiatePaymentParams, principal *models.Principal) middleware.Responder {
 			_ = params
 			_ = principal
@@ -182,6 +323,25 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation payments.InitiatePayment has not yet been implemented")
 		}),
 
+		AdminAPIKeysListAPIKeysHandler: admin_api_keys.ListAPIKeysHandlerFunc(func(params admin_api_keys.ListAPIKeysParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_api_keys.ListAPIKeys has not yet been implemented")
+		}),
+
+		CategoriesListCategoriesHandler: categories.ListCategoriesHandlerFunc(func(params categories.ListCategoriesParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation categories.ListCategories has not yet been implemented")
+		}),
+
+		CategoriesListCategoryAttributesHandler: categories.ListCategoryAttributesHandlerFunc(func(params categories.ListCategoryAttributesParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation categories.ListCategoryAttributes has not yet been implemented")
+		}),
+
 		OrdersListOrdersHandler: orders.ListOrdersHandlerFunc(func(params orders.ListOrdersParams, principal *models.Principal) middleware.Responder {
 			_ = params
 			_ = principal
@@ -189,6 +349,19 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation orders.ListOrders has not yet been implemented")
 		}),
 
+		UsersListPasskeysHandler: users.ListPasskeysHandlerFunc(func(params users.ListPasskeysParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.ListPasskeys has not yet been implemented")
+		}),
+
+		ProductsListProductsHandler: products.ListProductsHandlerFunc(func(params products.ListProductsParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation products.ListProducts has not yet been implemented")
+		}),
+
 		ShippingListShippingAddressesHandler: shipping.ListShippingAddressesHandlerFunc(func(params shipping.ListShippingAddressesParams, principal *models.Principal) middleware.Responder {
 			_ = params
 			_ = principal
@@ -202,6 +375,13 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation shipping.ListShippingOptions has not yet been implemented")
 		}),
 
+		UsersListUserSessionsHandler: users.ListUserSessionsHandlerFunc(func(params users.ListUserSessionsParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.ListUserSessions has not yet been implemented")
+		}),
+
 		AdminUsersListUsersHandler: admin_users.ListUsersHandlerFunc(func(params admin_users.ListUsersParams, principal *models.Principal) middleware.Responder {
 			_ = params
 			_ = principal
@@ -215,6 +395,12 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation users.LoginUser has not yet been implemented")
 		}),
 
+		UsersLoginWithPasskeyHandler: users.LoginWithPasskeyHandlerFunc(func(params users.LoginWithPasskeyParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation users.LoginWithPasskey has not yet been implemented")
+		}),
+
 		UsersLogoutUserHandler: users.LogoutUserHandlerFunc(func(params users.LogoutUserParams) middleware.Responder {
 			_ = params
 
@@ -241,18 +427,92 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation payments.RefundPayment has not yet been implemented")
 		}),
 
+		UsersRegisterPasskeyHandler: users.RegisterPasskeyHandlerFunc(func(params users.RegisterPasskeyParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.RegisterPasskey has not yet been implemented")
+		}),
+
 		UsersRegisterUserHandler: users.RegisterUserHandlerFunc(func(params users.RegisterUserParams) middleware.Responder {
 			_ = params
 
 			return middleware.NotImplemented("operation users.RegisterUser has not yet been implemented")
 		}),
 
+		UsersRequestDataExportHandler: users.RequestDataExportHandlerFunc(func(params users.RequestDataExportParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.RequestDataExport has not yet been implemented")
+		}),
+
+		UsersRequestErasureHandler: users.RequestErasureHandlerFunc(func(params users.RequestErasureParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.RequestErasure has not yet been implemented")
+		}),
+
+		UsersResendOTPHandler: users.ResendOTPHandlerFunc(func(params users.ResendOTPParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation users.ResendOTP has not yet been implemented")
+		}),
+
 		UsersResetPasswordHandler: users.ResetPasswordHandlerFunc(func(params users.ResetPasswordParams) middleware.Responder {
 			_ = params
 
 			return middleware.NotImplemented("operation users.ResetPassword has not yet been implemented")
 		}),
 
+		AdminAPIKeysRevokeAPIKeyHandler: admin_api_keys.RevokeAPIKeyHandlerFunc(func(params admin_api_keys.RevokeAPIKeyParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_api_keys.RevokeAPIKey has not yet been implemented")
+		}),
+
+		UsersRevokeUserSessionHandler: users.RevokeUserSessionHandlerFunc(func(params users.RevokeUserSessionParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.RevokeUserSession has not yet been implemented")
+		}),
+
+		UsersSendEmailVerificationHandler: users.SendEmailVerificationHandlerFunc(func(params users.SendEmailVerificationParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.SendEmailVerification has not yet been implemented")
+		}),
+
+		UsersSendMagicLinkHandler: users.SendMagicLinkHandlerFunc(func(params users.SendMagicLinkParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation users.SendMagicLink has not yet been implemented")
+		}),
+
+		UsersSendPhoneVerificationHandler: users.SendPhoneVerificationHandlerFunc(func(params users.SendPhoneVerificationParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.SendPhoneVerification has not yet been implemented")
+		}),
+
+		SystemSmsDeliveryStatusHandler: system.SmsDeliveryStatusHandlerFunc(func(params system.SmsDeliveryStatusParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation system.SmsDeliveryStatus has not yet been implemented")
+		}),
+
+		UsersStartMFAEnrollmentHandler: users.StartMFAEnrollmentHandlerFunc(func(params users.StartMFAEnrollmentParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.StartMFAEnrollment has not yet been implemented")
+		}),
+
 		ShippingTrackShipmentHandler: shipping.TrackShipmentHandlerFunc(func(params shipping.TrackShipmentParams, principal *models.Principal) middleware.Responder {
 			_ = params
 			_ = principal
@@ -260,6 +520,13 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation shipping.TrackShipment has not yet been implemented")
 		}),
 
+		AdminUsersUnlockUserHandler: admin_users.UnlockUserHandlerFunc(func(params admin_users.UnlockUserParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_users.UnlockUser has not yet been implemented")
+		}),
+
 		CartUpdateCartItemHandler: cart.UpdateCartItemHandlerFunc(func(params cart.UpdateCartItemParams, principal *models.Principal) middleware.Responder {
 			_ = params
 			_ = principal
@@ -267,6 +534,20 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation cart.UpdateCartItem has not yet been implemented")
 		}),
 
+		AdminCategoriesUpdateCategoryHandler: admin_categories.UpdateCategoryHandlerFunc(func(params admin_categories.UpdateCategoryParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_categories.UpdateCategory has not yet been implemented")
+		}),
+
+		AdminCategoriesUpdateCategoryAttributeHandler: admin_categories.UpdateCategoryAttributeHandlerFunc(func(params admin_categories.UpdateCategoryAttributeParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_categories.UpdateCategoryAttribute has not yet been implemented")
+		}),
+
 		AdminProductsUpdateProductHandler: admin_products.UpdateProductHandlerFunc(func(params admin_products.UpdateProductParams, principal *models.Principal) middleware.Responder {
 			_ = params
 			_ = principal
@@ -295,6 +576,44 @@ func NewAdronmeCodeAPI(spec *loads.Document) *AdronmeCodeAPI {
 			return middleware.NotImplemented("operation users.UpdateUserProfile has not yet been implemented")
 		}),
 
+		AdminProductsUpdateVariantHandler: admin_products.UpdateVariantHandlerFunc(func(params admin_products.UpdateVariantParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation admin_products.UpdateVariant has not yet been implemented")
+		}),
+
+		UsersVerifyEmailHandler: users.VerifyEmailHandlerFunc(func(params users.VerifyEmailParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation users.VerifyEmail has not yet been implemented")
+		}),
+
+		UsersVerifyMFAHandler: users.VerifyMFAHandlerFunc(func(params users.VerifyMFAParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation users.VerifyMFA has not yet been implemented")
+		}),
+
+		UsersVerifyMagicLinkHandler: users.VerifyMagicLinkHandlerFunc(func(params users.VerifyMagicLinkParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation users.VerifyMagicLink has not yet been implemented")
+		}),
+
+		UsersVerifyPhoneHandler: users.VerifyPhoneHandlerFunc(func(params users.VerifyPhoneParams, principal *models.Principal) middleware.Responder {
+			_ = params
+			_ = principal
+
+			return middleware.NotImplemented("operation users.VerifyPhone has not yet been implemented")
+		}),
+
+		// Applies when the "X-API-Key" header is set
+		APIKeyAuth: func(token string) (*models.Principal, error) {
+			_ = token
+
+			return nil, errors.NotImplemented("api key auth (apiKey) X-API-Key from header param [X-API-Key] has not yet been implemented")
+		},
 		// Applies when the "Authorization" header is set
 		BearerAuthAuth: func(token string) (*models.Principal, error) {
 			_ = token
@@ -335,10 +654,18 @@ type AdronmeCodeAPI struct {
 	//   - application/json
 	JSONConsumer runtime.Consumer
 
+	// UrlformConsumer registers a consumer for the following mime types:
+	//   - application/x-www-form-urlencoded
+	UrlformConsumer runtime.Consumer
+
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
 
+	// APIKeyAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key X-API-Key provided in the header
+	APIKeyAuth func(string) (*models.Principal, error)
+
 	// BearerAuthAuth registers a function that takes a token and returns a principal
 	// it performs authentication based on an api key Authorization provided in the header
 	BearerAuthAuth func(string) (*models.Principal, error)
@@ -348,54 +675,108 @@ type AdronmeCodeAPI struct {
 
 	// UsersOTPLoginHandler sets the operation handler for the o t p login operation
 	UsersOTPLoginHandler users.OTPLoginHandler
-	// UsersPostAuthOtpResendHandler sets the operation handler for the post auth otp resend operation
-	UsersPostAuthOtpResendHandler users.PostAuthOtpResendHandler
 	// UsersVerifyOTPHandler sets the operation handler for the verify o t p operation
 	UsersVerifyOTPHandler users.VerifyOTPHandler
 	// CartAddItemToCartHandler sets the operation handler for the add item to cart operation
 	CartAddItemToCartHandler cart.AddItemToCartHandler
 	// ShippingAddShippingAddressHandler sets the operation handler for the add shipping address operation
 	ShippingAddShippingAddressHandler shipping.AddShippingAddressHandler
+	// UsersBeginPasskeyLoginHandler sets the operation handler for the begin passkey login operation
+	UsersBeginPasskeyLoginHandler users.BeginPasskeyLoginHandler
+	// UsersBeginPasskeyRegistrationHandler sets the operation handler for the begin passkey registration operation
+	UsersBeginPasskeyRegistrationHandler users.BeginPasskeyRegistrationHandler
 	// CartClearCartHandler sets the operation handler for the clear cart operation
 	CartClearCartHandler cart.ClearCartHandler
+	// UsersConfirmEmailChangeHandler sets the operation handler for the confirm email change operation
+	UsersConfirmEmailChangeHandler users.ConfirmEmailChangeHandler
+	// UsersConfirmMFAHandler sets the operation handler for the confirm m f a operation
+	UsersConfirmMFAHandler users.ConfirmMFAHandler
 	// PaymentsConfirmPaymentHandler sets the operation handler for the confirm payment operation
 	PaymentsConfirmPaymentHandler payments.ConfirmPaymentHandler
+	// UsersConfirmPhoneChangeHandler sets the operation handler for the confirm phone change operation
+	UsersConfirmPhoneChangeHandler users.ConfirmPhoneChangeHandler
+	// AdminAPIKeysCreateAPIKeyHandler sets the operation handler for the create API key operation
+	AdminAPIKeysCreateAPIKeyHandler admin_api_keys.CreateAPIKeyHandler
+	// AdminCategoriesCreateCategoryHandler sets the operation handler for the create category operation
+	AdminCategoriesCreateCategoryHandler admin_categories.CreateCategoryHandler
+	// AdminCategoriesCreateCategoryAttributeHandler sets the operation handler for the create category attribute operation
+	AdminCategoriesCreateCategoryAttributeHandler admin_categories.CreateCategoryAttributeHandler
 	// AdminProductsCreateProductHandler sets the operation handler for the create product operation
 	AdminProductsCreateProductHandler admin_products.CreateProductHandler
+	// AdminProductsCreateVariantHandler sets the operation handler for the create variant operation
+	AdminProductsCreateVariantHandler admin_products.CreateVariantHandler
+	// AdminCategoriesDeleteCategoryHandler sets the operation handler for the delete category operation
+	AdminCategoriesDeleteCategoryHandler admin_categories.DeleteCategoryHandler
+	// AdminCategoriesDeleteCategoryAttributeHandler sets the operation handler for the delete category attribute operation
+	AdminCategoriesDeleteCategoryAttributeHandler admin_categories.DeleteCategoryAttributeHandler
+	// UsersDeletePasskeyHandler sets the operation handler for the delete passkey operation
+	UsersDeletePasskeyHandler users.DeletePasskeyHandler
 	// AdminProductsDeleteProductHandler sets the operation handler for the delete product operation
 	AdminProductsDeleteProductHandler admin_products.DeleteProductHandler
 	// ShippingDeleteShippingAddressHandler sets the operation handler for the delete shipping address operation
 	ShippingDeleteShippingAddressHandler shipping.DeleteShippingAddressHandler
 	// AdminUsersDeleteUserHandler sets the operation handler for the delete user operation
 	AdminUsersDeleteUserHandler admin_users.DeleteUserHandler
+	// AdminProductsDeleteVariantHandler sets the operation handler for the delete variant operation
+	AdminProductsDeleteVariantHandler admin_products.DeleteVariantHandler
+	// UsersDisableMFAHandler sets the operation handler for the disable m f a operation
+	UsersDisableMFAHandler users.DisableMFAHandler
+	// AdminUsersDisableUserHandler sets the operation handler for the disable user operation
+	AdminUsersDisableUserHandler admin_users.DisableUserHandler
+	// AdminUsersEnableUserHandler sets the operation handler for the enable user operation
+	AdminUsersEnableUserHandler admin_users.EnableUserHandler
+	// UsersEnrollMFAHandler sets the operation handler for the enroll m f a operation
+	UsersEnrollMFAHandler users.EnrollMFAHandler
 	// UsersForgetPasswordHandler sets the operation handler for the forget password operation
 	UsersForgetPasswordHandler users.ForgetPasswordHandler
 	// CartGetCartHandler sets the operation handler for the get cart operation
 	CartGetCartHandler cart.GetCartHandler
 	// SystemGetHealthHandler sets the operation handler for the get health operation
 	SystemGetHealthHandler system.GetHealthHandler
+	// SystemGetJWKSHandler sets the operation handler for the get j w k s operation
+	SystemGetJWKSHandler system.GetJWKSHandler
 	// OrdersGetOrderHandler sets the operation handler for the get order operation
 	OrdersGetOrderHandler orders.GetOrderHandler
 	// PaymentsGetPaymentHandler sets the operation handler for the get payment operation
 	PaymentsGetPaymentHandler payments.GetPaymentHandler
+	// UsersGetPrivacyJobHandler sets the operation handler for the get privacy job operation
+	UsersGetPrivacyJobHandler users.GetPrivacyJobHandler
+	// ProductsGetProductHandler sets the operation handler for the get product operation
+	ProductsGetProductHandler products.GetProductHandler
 	// AdminUsersGetUserHandler sets the operation handler for the get user operation
 	AdminUsersGetUserHandler admin_users.GetUserHandler
 	// UsersGetUserProfileHandler sets the operation handler for the get user profile operation
 	UsersGetUserProfileHandler users.GetUserProfileHandler
 	// UsersIdentifyUserHandler sets the operation handler for the identify user operation
 	UsersIdentifyUserHandler users.IdentifyUserHandler
+	// AdminUsersImpersonateUserHandler sets the operation handler for the impersonate user operation
+	AdminUsersImpersonateUserHandler admin_users.ImpersonateUserHandler
 	// PaymentsInitiatePaymentHandler sets the operation handler for the initiate payment operation
 	PaymentsInitiatePaymentHandler payments.InitiatePaymentHandler
+	// AdminAPIKeysListAPIKeysHandler sets the operation handler for the list API keys operation
+	AdminAPIKeysListAPIKeysHandler admin_api_keys.ListAPIKeysHandler
+	// CategoriesListCategoriesHandler sets the operation handler for the list categories operation
+	CategoriesListCategoriesHandler categories.ListCategoriesHandler
+	// CategoriesListCategoryAttributesHandler sets the operation handler for the list category attributes operation
+	CategoriesListCategoryAttributesHandler categories.ListCategoryAttributesHandler
 	// OrdersListOrdersHandler sets the operation handler for the list orders operation
 	OrdersListOrdersHandler orders.ListOrdersHandler
+	// UsersListPasskeysHandler sets the operation handler for the list passkeys operation
+	UsersListPasskeysHandler users.ListPasskeysHandler
+	// ProductsListProductsHandler sets the operation handler for the list products operation
+	ProductsListProductsHandler products.ListProductsHandler
 	// ShippingListShippingAddressesHandler sets the operation handler for the list shipping addresses operation
 	ShippingListShippingAddressesHandler shipping.ListShippingAddressesHandler
 	// ShippingListShippingOptionsHandler sets the operation handler for the list shipping options operation
 	ShippingListShippingOptionsHandler shipping.ListShippingOptionsHandler
+	// UsersListUserSessionsHandler sets the operation handler for the list user sessions operation
+	UsersListUserSessionsHandler users.ListUserSessionsHandler
 	// AdminUsersListUsersHandler sets the operation handler for the list users operation
 	AdminUsersListUsersHandler admin_users.ListUsersHandler
 	// UsersLoginUserHandler sets the operation handler for the login user operation
 	UsersLoginUserHandler users.LoginUserHandler
+	// UsersLoginWithPasskeyHandler sets the operation handler for the login with passkey operation
+	UsersLoginWithPasskeyHandler users.LoginWithPasskeyHandler
 	// UsersLogoutUserHandler sets the operation handler for the logout user operation
 	UsersLogoutUserHandler users.LogoutUserHandler
 	// OrdersPlaceOrderHandler sets the operation handler for the place order operation
@@ -404,14 +785,42 @@ type AdronmeCodeAPI struct {
 	UsersRefreshTokenHandler users.RefreshTokenHandler
 	// PaymentsRefundPaymentHandler sets the operation handler for the refund payment operation
 	PaymentsRefundPaymentHandler payments.RefundPaymentHandler
+	// UsersRegisterPasskeyHandler sets the operation handler for the register passkey operation
+	UsersRegisterPasskeyHandler users.RegisterPasskeyHandler
 	// UsersRegisterUserHandler sets the operation handler for the register user operation
 	UsersRegisterUserHandler users.RegisterUserHandler
+	// UsersRequestDataExportHandler sets the operation handler for the request data export operation
+	UsersRequestDataExportHandler users.RequestDataExportHandler
+	// UsersRequestErasureHandler sets the operation handler for the request erasure operation
+	UsersRequestErasureHandler users.RequestErasureHandler
+	// UsersResendOTPHandler sets the operation handler for the resend o t p operation
+	UsersResendOTPHandler users.ResendOTPHandler
 	// UsersResetPasswordHandler sets the operation handler for the reset password operation
 	UsersResetPasswordHandler users.ResetPasswordHandler
+	// AdminAPIKeysRevokeAPIKeyHandler sets the operation handler for the revoke API key operation
+	AdminAPIKeysRevokeAPIKeyHandler admin_api_keys.RevokeAPIKeyHandler
+	// UsersRevokeUserSessionHandler sets the operation handler for the revoke user session operation
+	UsersRevokeUserSessionHandler users.RevokeUserSessionHandler
+	// UsersSendEmailVerificationHandler sets the operation handler for the send email verification operation
+	UsersSendEmailVerificationHandler users.SendEmailVerificationHandler
+	// UsersSendMagicLinkHandler sets the operation handler for the send magic link operation
+	UsersSendMagicLinkHandler users.SendMagicLinkHandler
+	// UsersSendPhoneVerificationHandler sets the operation handler for the send phone verification operation
+	UsersSendPhoneVerificationHandler users.SendPhoneVerificationHandler
+	// SystemSmsDeliveryStatusHandler sets the operation handler for the sms delivery status operation
+	SystemSmsDeliveryStatusHandler system.SmsDeliveryStatusHandler
+	// UsersStartMFAEnrollmentHandler sets the operation handler for the start m f a enrollment operation
+	UsersStartMFAEnrollmentHandler users.StartMFAEnrollmentHandler
 	// ShippingTrackShipmentHandler sets the operation handler for the track shipment operation
 	ShippingTrackShipmentHandler shipping.TrackShipmentHandler
+	// AdminUsersUnlockUserHandler sets the operation handler for the unlock user operation
+	AdminUsersUnlockUserHandler admin_users.UnlockUserHandler
 	// CartUpdateCartItemHandler sets the operation handler for the update cart item operation
 	CartUpdateCartItemHandler cart.UpdateCartItemHandler
+	// AdminCategoriesUpdateCategoryHandler sets the operation handler for the update category operation
+	AdminCategoriesUpdateCategoryHandler admin_categories.UpdateCategoryHandler
+	// AdminCategoriesUpdateCategoryAttributeHandler sets the operation handler for the update category attribute operation
+	AdminCategoriesUpdateCategoryAttributeHandler admin_categories.UpdateCategoryAttributeHandler
 	// AdminProductsUpdateProductHandler sets the operation handler for the update product operation
 	AdminProductsUpdateProductHandler admin_products.UpdateProductHandler
 	// ShippingUpdateShippingAddressHandler sets the operation handler for the update shipping address operation
@@ -420,6 +829,16 @@ type AdronmeCodeAPI struct {
 	AdminUsersUpdateUserHandler admin_users.UpdateUserHandler
 	// UsersUpdateUserProfileHandler sets the operation handler for the update user profile operation
 	UsersUpdateUserProfileHandler users.UpdateUserProfileHandler
+	// AdminProductsUpdateVariantHandler sets the operation handler for the update variant operation
+	AdminProductsUpdateVariantHandler admin_products.UpdateVariantHandler
+	// UsersVerifyEmailHandler sets the operation handler for the verify email operation
+	UsersVerifyEmailHandler users.VerifyEmailHandler
+	// UsersVerifyMFAHandler sets the operation handler for the verify m f a operation
+	UsersVerifyMFAHandler users.VerifyMFAHandler
+	// UsersVerifyMagicLinkHandler sets the operation handler for the verify magic link operation
+	UsersVerifyMagicLinkHandler users.VerifyMagicLinkHandler
+	// UsersVerifyPhoneHandler sets the operation handler for the verify phone operation
+	UsersVerifyPhoneHandler users.VerifyPhoneHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -493,10 +912,17 @@ func (o *AdronmeCodeAPI) Validate() error {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
 
+	if o.UrlformConsumer == nil {
+		unregistered = append(unregistered, "UrlformConsumer")
+	}
+
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
 
+	if o.APIKeyAuth == nil {
+		unregistered = append(unregistered, "XAPIKeyAuth")
+	}
 	if o.BearerAuthAuth == nil {
 		unregistered = append(unregistered, "AuthorizationAuth")
 	}
@@ -504,9 +930,6 @@ func (o *AdronmeCodeAPI) Validate() error {
 	if o.UsersOTPLoginHandler == nil {
 		unregistered = append(unregistered, "users.OTPLoginHandler")
 	}
-	if o.UsersPostAuthOtpResendHandler == nil {
-		unregistered = append(unregistered, "users.PostAuthOtpResendHandler")
-	}
 	if o.UsersVerifyOTPHandler == nil {
 		unregistered = append(unregistered, "users.VerifyOTPHandler")
 	}
@@ -516,15 +939,51 @@ func (o *AdronmeCodeAPI) Validate() error {
 	if o.ShippingAddShippingAddressHandler == nil {
 		unregistered = append(unregistered, "shipping.AddShippingAddressHandler")
 	}
+	if o.UsersBeginPasskeyLoginHandler == nil {
+		unregistered = append(unregistered, "users.BeginPasskeyLoginHandler")
+	}
+	if o.UsersBeginPasskeyRegistrationHandler == nil {
+		unregistered = append(unregistered, "users.BeginPasskeyRegistrationHandler")
+	}
 	if o.CartClearCartHandler == nil {
 		unregistered = append(unregistered, "cart.ClearCartHandler")
 	}
+	if o.UsersConfirmEmailChangeHandler == nil {
+		unregistered = append(unregistered, "users.ConfirmEmailChangeHandler")
+	}
+	if o.UsersConfirmMFAHandler == nil {
+		unregistered = append(unregistered, "users.ConfirmMFAHandler")
+	}
 	if o.PaymentsConfirmPaymentHandler == nil {
 		unregistered = append(unregistered, "payments.ConfirmPaymentHandler")
 	}
+	if o.UsersConfirmPhoneChangeHandler == nil {
+		unregistered = append(unregistered, "users.ConfirmPhoneChangeHandler")
+	}
+	if o.AdminAPIKeysCreateAPIKeyHandler == nil {
+		unregistered = append(unregistered, "admin_api_keys.CreateAPIKeyHandler")
+	}
+	if o.AdminCategoriesCreateCategoryHandler == nil {
+		unregistered = append(unregistered, "admin_categories.CreateCategoryHandler")
+	}
+	if o.AdminCategoriesCreateCategoryAttributeHandler == nil {
+		unregistered = append(unregistered, "admin_categories.CreateCategoryAttributeHandler")
+	}
 	if o.AdminProductsCreateProductHandler == nil {
 		unregistered = append(unregistered, "admin_products.CreateProductHandler")
 	}
+	if o.AdminProductsCreateVariantHandler == nil {
+		unregistered = append(unregistered, "admin_products.CreateVariantHandler")
+	}
+	if o.AdminCategoriesDeleteCategoryHandler == nil {
+		unregistered = append(unregistered, "admin_categories.DeleteCategoryHandler")
+	}
+	if o.AdminCategoriesDeleteCategoryAttributeHandler == nil {
+		unregistered = append(unregistered, "admin_categories.DeleteCategoryAttributeHandler")
+	}
+	if o.UsersDeletePasskeyHandler == nil {
+		unregistered = append(unregistered, "users.DeletePasskeyHandler")
+	}
 	if o.AdminProductsDeleteProductHandler == nil {
 		unregistered = append(unregistered, "admin_products.DeleteProductHandler")
 	}
@@ -534,6 +993,21 @@ func (o *AdronmeCodeAPI) Validate() error {
 	if o.AdminUsersDeleteUserHandler == nil {
 		unregistered = append(unregistered, "admin_users.DeleteUserHandler")
 	}
+	if o.AdminProductsDeleteVariantHandler == nil {
+		unregistered = append(unregistered, "admin_products.DeleteVariantHandler")
+	}
+	if o.UsersDisableMFAHandler == nil {
+		unregistered = append(unregistered, "users.DisableMFAHandler")
+	}
+	if o.AdminUsersDisableUserHandler == nil {
+		unregistered = append(unregistered, "admin_users.DisableUserHandler")
+	}
+	if o.AdminUsersEnableUserHandler == nil {
+		unregistered = append(unregistered, "admin_users.EnableUserHandler")
+	}
+	if o.UsersEnrollMFAHandler == nil {
+		unregistered = append(unregistered, "users.EnrollMFAHandler")
+	}
 	if o.UsersForgetPasswordHandler == nil {
 		unregistered = append(unregistered, "users.ForgetPasswordHandler")
 	}
@@ -543,12 +1017,21 @@ func (o *AdronmeCodeAPI) Validate() error {
 	if o.SystemGetHealthHandler == nil {
 		unregistered = append(unregistered, "system.GetHealthHandler")
 	}
+	if o.SystemGetJWKSHandler == nil {
+		unregistered = append(unregistered, "system.GetJWKSHandler")
+	}
 	if o.OrdersGetOrderHandler == nil {
 		unregistered = append(unregistered, "orders.GetOrderHandler")
 	}
 	if o.PaymentsGetPaymentHandler == nil {
 		unregistered = append(unregistered, "payments.GetPaymentHandler")
 	}
+	if o.UsersGetPrivacyJobHandler == nil {
+		unregistered = append(unregistered, "users.GetPrivacyJobHandler")
+	}
+	if o.ProductsGetProductHandler == nil {
+		unregistered = append(unregistered, "products.GetProductHandler")
+	}
 	if o.AdminUsersGetUserHandler == nil {
 		unregistered = append(unregistered, "admin_users.GetUserHandler")
 	}
@@ -558,24 +1041,48 @@ func (o *AdronmeCodeAPI) Validate() error {
 	if o.UsersIdentifyUserHandler == nil {
 		unregistered = append(unregistered, "users.IdentifyUserHandler")
 	}
+	if o.AdminUsersImpersonateUserHandler == nil {
+		unregistered = append(unregistered, "admin_users.ImpersonateUserHandler")
+	}
 	if o.PaymentsInitiatePaymentHandler == nil {
 		unregistered = append(unregistered, "payments.InitiatePaymentHandler")
 	}
+	if o.AdminAPIKeysListAPIKeysHandler == nil {
+		unregistered = append(unregistered, "admin_api_keys.ListAPIKeysHandler")
+	}
+	if o.CategoriesListCategoriesHandler == nil {
+		unregistered = append(unregistered, "categories.ListCategoriesHandler")
+	}
+	if o.CategoriesListCategoryAttributesHandler == nil {
+		unregistered = append(unregistered, "categories.ListCategoryAttributesHandler")
+	}
 	if o.OrdersListOrdersHandler == nil {
 		unregistered = append(unregistered, "orders.ListOrdersHandler")
 	}
+	if o.UsersListPasskeysHandler == nil {
+		unregistered = append(unregistered, "users.ListPasskeysHandler")
+	}
+	if o.ProductsListProductsHandler == nil {
+		unregistered = append(unregistered, "products.ListProductsHandler")
+	}
 	if o.ShippingListShippingAddressesHandler == nil {
 		unregistered = append(unregistered, "shipping.ListShippingAddressesHandler")
 	}
 	if o.ShippingListShippingOptionsHandler == nil {
 		unregistered = append(unregistered, "shipping.ListShippingOptionsHandler")
 	}
+	if o.UsersListUserSessionsHandler == nil {
+		unregistered = append(unregistered, "users.ListUserSessionsHandler")
+	}
 	if o.AdminUsersListUsersHandler == nil {
 		unregistered = append(unregistered, "admin_users.ListUsersHandler")
 	}
 	if o.UsersLoginUserHandler == nil {
 		unregistered = append(unregistered, "users.LoginUserHandler")
 	}
+	if o.UsersLoginWithPasskeyHandler == nil {
+		unregistered = append(unregistered, "users.LoginWithPasskeyHandler")
+	}
 	if o.UsersLogoutUserHandler == nil {
 		unregistered = append(unregistered, "users.LogoutUserHandler")
 	}
@@ -588,18 +1095,60 @@ func (o *AdronmeCodeAPI) Validate() error {
 	if o.PaymentsRefundPaymentHandler == nil {
 		unregistered = append(unregistered, "payments.RefundPaymentHandler")
 	}
+	if o.UsersRegisterPasskeyHandler == nil {
+		unregistered = append(unregistered, "users.RegisterPasskeyHandler")
+	}
 	if o.UsersRegisterUserHandler == nil {
 		unregistered = append(unregistered, "users.RegisterUserHandler")
 	}
+	if o.UsersRequestDataExportHandler == nil {
+		unregistered = append(unregistered, "users.RequestDataExportHandler")
+	}
+	if o.UsersRequestErasureHandler == nil {
+		unregistered = append(unregistered, "users.RequestErasureHandler")
+	}
+	if o.UsersResendOTPHandler == nil {
+		unregistered = append(unregistered, "users.ResendOTPHandler")
+	}
 	if o.UsersResetPasswordHandler == nil {
 		unregistered = append(unregistered, "users.ResetPasswordHandler")
 	}
+	if o.AdminAPIKeysRevokeAPIKeyHandler == nil {
+		unregistered = append(unregistered, "admin_api_keys.RevokeAPIKeyHandler")
+	}
+	if o.UsersRevokeUserSessionHandler == nil {
+		unregistered = append(unregistered, "users.RevokeUserSessionHandler")
+	}
+	if o.UsersSendEmailVerificationHandler == nil {
+		unregistered = append(unregistered, "users.SendEmailVerificationHandler")
+	}
+	if o.UsersSendMagicLinkHandler == nil {
+		unregistered = append(unregistered, "users.SendMagicLinkHandler")
+	}
+	if o.UsersSendPhoneVerificationHandler == nil {
+		unregistered = append(unregistered, "users.SendPhoneVerificationHandler")
+	}
+	if o.SystemSmsDeliveryStatusHandler == nil {
+		unregistered = append(unregistered, "system.SmsDeliveryStatusHandler")
+	}
+	if o.UsersStartMFAEnrollmentHandler == nil {
+		unregistered = append(unregistered, "users.StartMFAEnrollmentHandler")
+	}
 	if o.ShippingTrackShipmentHandler == nil {
 		unregistered = append(unregistered, "shipping.TrackShipmentHandler")
 	}
+	if o.AdminUsersUnlockUserHandler == nil {
+		unregistered = append(unregistered, "admin_users.UnlockUserHandler")
+	}
 	if o.CartUpdateCartItemHandler == nil {
 		unregistered = append(unregistered, "cart.UpdateCartItemHandler")
 	}
+	if o.AdminCategoriesUpdateCategoryHandler == nil {
+		unregistered = append(unregistered, "admin_categories.UpdateCategoryHandler")
+	}
+	if o.AdminCategoriesUpdateCategoryAttributeHandler == nil {
+		unregistered = append(unregistered, "admin_categories.UpdateCategoryAttributeHandler")
+	}
 	if o.AdminProductsUpdateProductHandler == nil {
 		unregistered = append(unregistered, "admin_products.UpdateProductHandler")
 	}
@@ -612,6 +1161,21 @@ func (o *AdronmeCodeAPI) Validate() error {
 	if o.UsersUpdateUserProfileHandler == nil {
 		unregistered = append(unregistered, "users.UpdateUserProfileHandler")
 	}
+	if o.AdminProductsUpdateVariantHandler == nil {
+		unregistered = append(unregistered, "admin_products.UpdateVariantHandler")
+	}
+	if o.UsersVerifyEmailHandler == nil {
+		unregistered = append(unregistered, "users.VerifyEmailHandler")
+	}
+	if o.UsersVerifyMFAHandler == nil {
+		unregistered = append(unregistered, "users.VerifyMFAHandler")
+	}
+	if o.UsersVerifyMagicLinkHandler == nil {
+		unregistered = append(unregistered, "users.VerifyMagicLinkHandler")
+	}
+	if o.UsersVerifyPhoneHandler == nil {
+		unregistered = append(unregistered, "users.VerifyPhoneHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -629,6 +1193,12 @@ func (o *AdronmeCodeAPI) ServeErrorFor(operationID string) func(http.ResponseWri
 func (o *AdronmeCodeAPI) AuthenticatorsFor(schemes map[string]spec.SecurityScheme) map[string]runtime.Authenticator {
 	result := make(map[string]runtime.Authenticator)
 	for name := range schemes {
+		if name == "apiKey" {
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, func(token string) (any, error) {
+				return o.APIKeyAuth(token)
+			})
+		}
 		if name == "bearerAuth" {
 			scheme := schemes[name]
 			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, func(token string) (any, error) {
@@ -651,8 +1221,11 @@ func (o *AdronmeCodeAPI) Authorizer() runtime.Authorizer {
 func (o *AdronmeCodeAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Consumer {
 	result := make(map[string]runtime.Consumer, len(mediaTypes))
 	for _, mt := range mediaTypes {
-		if mt == "application/json" {
+		switch mt {
+		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "application/x-www-form-urlencoded":
+			result["application/x-www-form-urlencoded"] = o.UrlformConsumer
 		}
 
 		if c, ok := o.customConsumers[mt]; ok {
@@ -719,10 +1292,6 @@ func (o *AdronmeCodeAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
-	o.handlers["POST"]["/auth/otp/resend"] = users.NewPostAuthOtpResend(o.context, o.UsersPostAuthOtpResendHandler)
-	if o.handlers["POST"] == nil {
-		o.handlers["POST"] = make(map[string]http.Handler)
-	}
 	o.handlers["POST"]["/auth/otp/verify"] = users.NewVerifyOTP(o.context, o.UsersVerifyOTPHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -732,6 +1301,14 @@ func (o *AdronmeCodeAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/shipping/addresses"] = shipping.NewAddShippingAddress(o.context, o.ShippingAddShippingAddressHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/passkey/options"] = users.NewBeginPasskeyLogin(o.context, o.UsersBeginPasskeyLoginHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/me/passkeys/options"] = users.NewBeginPasskeyRegistration(o.context, o.UsersBeginPasskeyRegistrationHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -739,11 +1316,51 @@ func (o *AdronmeCodeAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/auth/confirm-email-change"] = users.NewConfirmEmailChange(o.context, o.UsersConfirmEmailChangeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/me/mfa/confirm"] = users.NewConfirmMFA(o.context, o.UsersConfirmMFAHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/payments/{id}/confirm"] = payments.NewConfirmPayment(o.context, o.PaymentsConfirmPaymentHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/users/me/phone/confirm"] = users.NewConfirmPhoneChange(o.context, o.UsersConfirmPhoneChangeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api-keys"] = admin_api_keys.NewCreateAPIKey(o.context, o.AdminAPIKeysCreateAPIKeyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/categories"] = admin_categories.NewCreateCategory(o.context, o.AdminCategoriesCreateCategoryHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/categories/{id}/attributes"] = admin_categories.NewCreateCategoryAttribute(o.context, o.AdminCategoriesCreateCategoryAttributeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/products"] = admin_products.NewCreateProduct(o.context, o.AdminProductsCreateProductHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/products/{id}/variants"] = admin_products.NewCreateVariant(o.context, o.AdminProductsCreateVariantHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/categories/{id}"] = admin_categories.NewDeleteCategory(o.context, o.AdminCategoriesDeleteCategoryHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/categories/{id}/attributes/{code}"] = admin_categories.NewDeleteCategoryAttribute(o.context, o.AdminCategoriesDeleteCategoryAttributeHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/users/me/passkeys/{id}"] = users.NewDeletePasskey(o.context, o.UsersDeletePasskeyHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -756,6 +1373,26 @@ func (o *AdronmeCodeAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/users/{id}"] = admin_users.NewDeleteUser(o.context, o.AdminUsersDeleteUserHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/products/{id}/variants/{variantId}"] = admin_products.NewDeleteVariant(o.context, o.AdminProductsDeleteVariantHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/me/mfa/disable"] = users.NewDisableMFA(o.context, o.UsersDisableMFAHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/{id}/disable"] = admin_users.NewDisableUser(o.context, o.AdminUsersDisableUserHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/{id}/enable"] = admin_users.NewEnableUser(o.context, o.AdminUsersEnableUserHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/mfa/enroll"] = users.NewEnrollMFA(o.context, o.UsersEnrollMFAHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -771,6 +1408,10 @@ func (o *AdronmeCodeAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/.well-known/jwks.json"] = system.NewGetJWKS(o.context, o.SystemGetJWKSHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/orders/{id}"] = orders.NewGetOrder(o.context, o.OrdersGetOrderHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -779,6 +1420,14 @@ func (o *AdronmeCodeAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/users/me/privacy-jobs/{id}"] = users.NewGetPrivacyJob(o.context, o.UsersGetPrivacyJobHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/products/{id}"] = products.NewGetProduct(o.context, o.ProductsGetProductHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/users/{id}"] = admin_users.NewGetUser(o.context, o.AdminUsersGetUserHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -791,14 +1440,38 @@ func (o *AdronmeCodeAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/users/{id}/impersonate"] = admin_users.NewImpersonateUser(o.context, o.AdminUsersImpersonateUserHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/payments/initiate"] = payments.NewInitiatePayment(o.context, o.PaymentsInitiatePaymentHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/api-keys"] = admin_api_keys.NewListAPIKeys(o.context, o.AdminAPIKeysListAPIKeysHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/categories"] = categories.NewListCategories(o.context, o.CategoriesListCategoriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/categories/{id}/attributes"] = categories.NewListCategoryAttributes(o.context, o.CategoriesListCategoryAttributesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/orders"] = orders.NewListOrders(o.context, o.OrdersListOrdersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/users/me/passkeys"] = users.NewListPasskeys(o.context, o.UsersListPasskeysHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/products"] = products.NewListProducts(o.context, o.ProductsListProductsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/shipping/addresses"] = shipping.NewListShippingAddresses(o.context, o.ShippingListShippingAddressesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -807,6 +1480,10 @@ func (o *AdronmeCodeAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/users/me/sessions"] = users.NewListUserSessions(o.context, o.UsersListUserSessionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/users"] = admin_users.NewListUsers(o.context, o.AdminUsersListUsersHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -815,6 +1492,10 @@ func (o *AdronmeCodeAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/auth/passkey/login"] = users.NewLoginWithPasskey(o.context, o.UsersLoginWithPasskeyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/auth/logout"] = users.NewLogoutUser(o.context, o.UsersLogoutUserHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -831,15 +1512,63 @@ func (o *AdronmeCodeAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/users/me/passkeys"] = users.NewRegisterPasskey(o.context, o.UsersRegisterPasskeyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/auth/register"] = users.NewRegisterUser(o.context, o.UsersRegisterUserHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/users/me/data-export"] = users.NewRequestDataExport(o.context, o.UsersRequestDataExportHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/me/erasure"] = users.NewRequestErasure(o.context, o.UsersRequestErasureHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/otp/resend"] = users.NewResendOTP(o.context, o.UsersResendOTPHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/auth/reset-password"] = users.NewResetPassword(o.context, o.UsersResetPasswordHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/api-keys/{id}"] = admin_api_keys.NewRevokeAPIKey(o.context, o.AdminAPIKeysRevokeAPIKeyHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/users/me/sessions/{id}"] = users.NewRevokeUserSession(o.context, o.UsersRevokeUserSessionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/me/verify/email"] = users.NewSendEmailVerification(o.context, o.UsersSendEmailVerificationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/magic-link"] = users.NewSendMagicLink(o.context, o.UsersSendMagicLinkHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/me/verify/phone/send"] = users.NewSendPhoneVerification(o.context, o.UsersSendPhoneVerificationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks/sms/{provider}"] = system.NewSmsDeliveryStatus(o.context, o.SystemSmsDeliveryStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/me/mfa"] = users.NewStartMFAEnrollment(o.context, o.UsersStartMFAEnrollmentHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/shipping/track/{orderId}"] = shipping.NewTrackShipment(o.context, o.ShippingTrackShipmentHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/{id}/unlock"] = admin_users.NewUnlockUser(o.context, o.AdminUsersUnlockUserHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -847,6 +1576,14 @@ func (o *AdronmeCodeAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/categories/{id}"] = admin_categories.NewUpdateCategory(o.context, o.AdminCategoriesUpdateCategoryHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/categories/{id}/attributes/{code}"] = admin_categories.NewUpdateCategoryAttribute(o.context, o.AdminCategoriesUpdateCategoryAttributeHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/products/{id}"] = admin_products.NewUpdateProduct(o.context, o.AdminProductsUpdateProductHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -860,6 +1597,26 @@ func (o *AdronmeCodeAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/users/me"] = users.NewUpdateUserProfile(o.context, o.UsersUpdateUserProfileHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/products/{id}/variants/{variantId}"] = admin_products.NewUpdateVariant(o.context, o.AdminProductsUpdateVariantHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/verify-email"] = users.NewVerifyEmail(o.context, o.UsersVerifyEmailHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/mfa/verify"] = users.NewVerifyMFA(o.context, o.UsersVerifyMFAHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/auth/magic-link/verify"] = users.NewVerifyMagicLink(o.context, o.UsersVerifyMagicLinkHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/me/verify/phone"] = users.NewVerifyPhone(o.context, o.UsersVerifyPhoneHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -99,3 +99,48 @@ func (o *PlaceOrderBadRequest) WriteResponse(rw http.ResponseWriter, producer ru
 		}
 	}
 }
+
+// PlaceOrderForbiddenCode is the HTTP code returned for type PlaceOrderForbidden
+const PlaceOrderForbiddenCode int = 403
+
+/*
+PlaceOrderForbidden Contact not verified
+
+swagger:response placeOrderForbidden
+*/
+type PlaceOrderForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPlaceOrderForbidden creates PlaceOrderForbidden with default headers values
+func NewPlaceOrderForbidden() *PlaceOrderForbidden {
+
+	return &PlaceOrderForbidden{}
+}
+
+// WithPayload adds the payload to the place order forbidden response
+func (o *PlaceOrderForbidden) WithPayload(payload *models.ErrorResponse) *PlaceOrderForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the place order forbidden response
+func (o *PlaceOrderForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PlaceOrderForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -99,3 +99,48 @@ func (o *InitiatePaymentBadRequest) WriteResponse(rw http.ResponseWriter, produc
 		}
 	}
 }
+
+// InitiatePaymentForbiddenCode is the HTTP code returned for type InitiatePaymentForbidden
+const InitiatePaymentForbiddenCode int = 403
+
+/*
+InitiatePaymentForbidden Contact not verified
+
+swagger:response initiatePaymentForbidden
+*/
+type InitiatePaymentForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewInitiatePaymentForbidden creates InitiatePaymentForbidden with default headers values
+func NewInitiatePaymentForbidden() *InitiatePaymentForbidden {
+
+	return &InitiatePaymentForbidden{}
+}
+
+// WithPayload adds the payload to the initiate payment forbidden response
+func (o *InitiatePaymentForbidden) WithPayload(payload *models.ErrorResponse) *InitiatePaymentForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the initiate payment forbidden response
+func (o *InitiatePaymentForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *InitiatePaymentForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
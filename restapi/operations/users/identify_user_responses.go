@@ -79,3 +79,48 @@ func (o *IdentifyUserBadRequest) WriteResponse(rw http.ResponseWriter, producer
 		}
 	}
 }
+
+// IdentifyUserTooManyRequestsCode is the HTTP code returned for type IdentifyUserTooManyRequests
+const IdentifyUserTooManyRequestsCode int = 429
+
+/*
+IdentifyUserTooManyRequests Too many requests from this client
+
+swagger:response identifyUserTooManyRequests
+*/
+type IdentifyUserTooManyRequests struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewIdentifyUserTooManyRequests creates IdentifyUserTooManyRequests with default headers values
+func NewIdentifyUserTooManyRequests() *IdentifyUserTooManyRequests {
+
+	return &IdentifyUserTooManyRequests{}
+}
+
+// WithPayload adds the payload to the identify user too many requests response
+func (o *IdentifyUserTooManyRequests) WithPayload(payload *models.ErrorResponse) *IdentifyUserTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the identify user too many requests response
+func (o *IdentifyUserTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *IdentifyUserTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -55,6 +55,51 @@ func (o *LoginUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pro
 	}
 }
 
+// LoginUserAcceptedCode is the HTTP code returned for type LoginUserAccepted
+const LoginUserAcceptedCode int = 202
+
+/*
+LoginUserAccepted Password accepted, second factor required
+
+swagger:response loginUserAccepted
+*/
+type LoginUserAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.MFAChallenge `json:"body,omitempty"`
+}
+
+// NewLoginUserAccepted creates LoginUserAccepted with default headers values
+func NewLoginUserAccepted() *LoginUserAccepted {
+
+	return &LoginUserAccepted{}
+}
+
+// WithPayload adds the payload to the login user accepted response
+func (o *LoginUserAccepted) WithPayload(payload *models.MFAChallenge) *LoginUserAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the login user accepted response
+func (o *LoginUserAccepted) SetPayload(payload *models.MFAChallenge) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *LoginUserAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // LoginUserUnauthorizedCode is the HTTP code returned for type LoginUserUnauthorized
 const LoginUserUnauthorizedCode int = 401
 
@@ -99,3 +144,48 @@ func (o *LoginUserUnauthorized) WriteResponse(rw http.ResponseWriter, producer r
 		}
 	}
 }
+
+// LoginUserTooManyRequestsCode is the HTTP code returned for type LoginUserTooManyRequests
+const LoginUserTooManyRequestsCode int = 429
+
+/*
+LoginUserTooManyRequests Too many failed attempts, account or client temporarily locked
+
+swagger:response loginUserTooManyRequests
+*/
+type LoginUserTooManyRequests struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewLoginUserTooManyRequests creates LoginUserTooManyRequests with default headers values
+func NewLoginUserTooManyRequests() *LoginUserTooManyRequests {
+
+	return &LoginUserTooManyRequests{}
+}
+
+// WithPayload adds the payload to the login user too many requests response
+func (o *LoginUserTooManyRequests) WithPayload(payload *models.ErrorResponse) *LoginUserTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the login user too many requests response
+func (o *LoginUserTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *LoginUserTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -99,3 +99,48 @@ func (o *OTPLoginBadRequest) WriteResponse(rw http.ResponseWriter, producer runt
 		}
 	}
 }
+
+// OTPLoginTooManyRequestsCode is the HTTP code returned for type OTPLoginTooManyRequests
+const OTPLoginTooManyRequestsCode int = 429
+
+/*
+OTPLoginTooManyRequests A code was sent recently or too many requests from this client
+
+swagger:response oTPLoginTooManyRequests
+*/
+type OTPLoginTooManyRequests struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewOTPLoginTooManyRequests creates OTPLoginTooManyRequests with default headers values
+func NewOTPLoginTooManyRequests() *OTPLoginTooManyRequests {
+
+	return &OTPLoginTooManyRequests{}
+}
+
+// WithPayload adds the payload to the o t p login too many requests response
+func (o *OTPLoginTooManyRequests) WithPayload(payload *models.ErrorResponse) *OTPLoginTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the o t p login too many requests response
+func (o *OTPLoginTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *OTPLoginTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
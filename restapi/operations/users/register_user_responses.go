@@ -99,3 +99,93 @@ func (o *RegisterUserBadRequest) WriteResponse(rw http.ResponseWriter, producer
 		}
 	}
 }
+
+// RegisterUserConflictCode is the HTTP code returned for type RegisterUserConflict
+const RegisterUserConflictCode int = 409
+
+/*
+RegisterUserConflict Email or phone already in use
+
+swagger:response registerUserConflict
+*/
+type RegisterUserConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRegisterUserConflict creates RegisterUserConflict with default headers values
+func NewRegisterUserConflict() *RegisterUserConflict {
+
+	return &RegisterUserConflict{}
+}
+
+// WithPayload adds the payload to the register user conflict response
+func (o *RegisterUserConflict) WithPayload(payload *models.ErrorResponse) *RegisterUserConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the register user conflict response
+func (o *RegisterUserConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RegisterUserConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RegisterUserTooManyRequestsCode is the HTTP code returned for type RegisterUserTooManyRequests
+const RegisterUserTooManyRequestsCode int = 429
+
+/*
+RegisterUserTooManyRequests Too many wrong attempts, request a new code
+
+swagger:response registerUserTooManyRequests
+*/
+type RegisterUserTooManyRequests struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRegisterUserTooManyRequests creates RegisterUserTooManyRequests with default headers values
+func NewRegisterUserTooManyRequests() *RegisterUserTooManyRequests {
+
+	return &RegisterUserTooManyRequests{}
+}
+
+// WithPayload adds the payload to the register user too many requests response
+func (o *RegisterUserTooManyRequests) WithPayload(payload *models.ErrorResponse) *RegisterUserTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the register user too many requests response
+func (o *RegisterUserTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RegisterUserTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
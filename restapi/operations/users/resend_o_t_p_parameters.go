@@ -15,19 +15,19 @@ import (
 	"Adornme/models"
 )
 
-// NewPostAuthOtpResendParams creates a new PostAuthOtpResendParams object
+// NewResendOTPParams creates a new ResendOTPParams object
 //
 // There are no default values defined in the spec.
-func NewPostAuthOtpResendParams() PostAuthOtpResendParams {
+func NewResendOTPParams() ResendOTPParams {
 
-	return PostAuthOtpResendParams{}
+	return ResendOTPParams{}
 }
 
-// PostAuthOtpResendParams contains all the bound params for the post auth otp resend operation
+// ResendOTPParams contains all the bound params for the resend o t p operation
 // typically these are obtained from a http.Request
 //
-// swagger:parameters PostAuthOtpResend
-type PostAuthOtpResendParams struct {
+// swagger:parameters resendOTP
+type ResendOTPParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
@@ -41,8 +41,8 @@ type PostAuthOtpResendParams struct {
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
 // for simple values it will use straight method calls.
 //
-// To ensure default values, the struct must have been initialized with NewPostAuthOtpResendParams() beforehand.
-func (o *PostAuthOtpResendParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+// To ensure default values, the struct must have been initialized with NewResendOTPParams() beforehand.
+func (o *ResendOTPParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
 	var res []error
 
 	o.HTTPRequest = r
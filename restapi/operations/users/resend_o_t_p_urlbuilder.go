@@ -8,15 +8,15 @@ import (
 	golangswaggerpaths "path"
 )
 
-// PostAuthOtpResendURL generates an URL for the post auth otp resend operation
-type PostAuthOtpResendURL struct {
+// ResendOTPURL generates an URL for the resend o t p operation
+type ResendOTPURL struct {
 	_basePath string
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
 // base path specified in the swagger spec.
 // When the value of the base path is an empty string
-func (o *PostAuthOtpResendURL) WithBasePath(bp string) *PostAuthOtpResendURL {
+func (o *ResendOTPURL) WithBasePath(bp string) *ResendOTPURL {
 	o.SetBasePath(bp)
 	return o
 }
@@ -24,12 +24,12 @@ func (o *PostAuthOtpResendURL) WithBasePath(bp string) *PostAuthOtpResendURL {
 // SetBasePath sets the base path for this url builder, only required when it's different from the
 // base path specified in the swagger spec.
 // When the value of the base path is an empty string
-func (o *PostAuthOtpResendURL) SetBasePath(bp string) {
+func (o *ResendOTPURL) SetBasePath(bp string) {
 	o._basePath = bp
 }
 
 // Build a url path and query string
-func (o *PostAuthOtpResendURL) Build() (*url.URL, error) {
+func (o *ResendOTPURL) Build() (*url.URL, error) {
 	var _result url.URL
 
 	var _path = "/auth/otp/resend"
@@ -41,7 +41,7 @@ func (o *PostAuthOtpResendURL) Build() (*url.URL, error) {
 }
 
 // Must is a helper function to panic when the url builder returns an error
-func (o *PostAuthOtpResendURL) Must(u *url.URL, err error) *url.URL {
+func (o *ResendOTPURL) Must(u *url.URL, err error) *url.URL {
 	if err != nil {
 		panic(err)
 	}
@@ -52,17 +52,17 @@ func (o *PostAuthOtpResendURL) Must(u *url.URL, err error) *url.URL {
 }
 
 // String returns the string representation of the path with query string
-func (o *PostAuthOtpResendURL) String() string {
+func (o *ResendOTPURL) String() string {
 	return o.Must(o.Build()).String()
 }
 
 // BuildFull builds a full url with scheme, host, path and query string
-func (o *PostAuthOtpResendURL) BuildFull(scheme, host string) (*url.URL, error) {
+func (o *ResendOTPURL) BuildFull(scheme, host string) (*url.URL, error) {
 	if scheme == "" {
-		return nil, errors.New("scheme is required for a full url on PostAuthOtpResendURL")
+		return nil, errors.New("scheme is required for a full url on ResendOTPURL")
 	}
 	if host == "" {
-		return nil, errors.New("host is required for a full url on PostAuthOtpResendURL")
+		return nil, errors.New("host is required for a full url on ResendOTPURL")
 	}
 
 	base, err := o.Build()
@@ -76,6 +76,6 @@ func (o *PostAuthOtpResendURL) BuildFull(scheme, host string) (*url.URL, error)
 }
 
 // StringFull returns the string representation of a complete url
-func (o *PostAuthOtpResendURL) StringFull(scheme, host string) string {
+func (o *ResendOTPURL) StringFull(scheme, host string) string {
 	return o.Must(o.BuildFull(scheme, host)).String()
 }
@@ -19,6 +19,11 @@ ResetPasswordOK Password reset successful
 swagger:response resetPasswordOK
 */
 type ResetPasswordOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.SuccessResponse `json:"body,omitempty"`
 }
 
 // NewResetPasswordOK creates ResetPasswordOK with default headers values
@@ -27,19 +32,34 @@ func NewResetPasswordOK() *ResetPasswordOK {
 	return &ResetPasswordOK{}
 }
 
+// WithPayload adds the payload to the reset password o k response
+func (o *ResetPasswordOK) WithPayload(payload *models.SuccessResponse) *ResetPasswordOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reset password o k response
+func (o *ResetPasswordOK) SetPayload(payload *models.SuccessResponse) {
+	o.Payload = payload
+}
+
 // WriteResponse to the client
 func (o *ResetPasswordOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.Header().Del(runtime.HeaderContentType) // Remove Content-Type on empty responses
-
 	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
 
 // ResetPasswordBadRequestCode is the HTTP code returned for type ResetPasswordBadRequest
 const ResetPasswordBadRequestCode int = 400
 
 /*
-ResetPasswordBadRequest Invalid or expired token
+ResetPasswordBadRequest Invalid or expired token, or password rejected by policy
 
 swagger:response resetPasswordBadRequest
 */
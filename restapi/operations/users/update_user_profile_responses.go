@@ -144,3 +144,93 @@ func (o *UpdateUserProfileUnauthorized) WriteResponse(rw http.ResponseWriter, pr
 		}
 	}
 }
+
+// UpdateUserProfileForbiddenCode is the HTTP code returned for type UpdateUserProfileForbidden
+const UpdateUserProfileForbiddenCode int = 403
+
+/*
+UpdateUserProfileForbidden Current password is incorrect
+
+swagger:response updateUserProfileForbidden
+*/
+type UpdateUserProfileForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateUserProfileForbidden creates UpdateUserProfileForbidden with default headers values
+func NewUpdateUserProfileForbidden() *UpdateUserProfileForbidden {
+
+	return &UpdateUserProfileForbidden{}
+}
+
+// WithPayload adds the payload to the update user profile forbidden response
+func (o *UpdateUserProfileForbidden) WithPayload(payload *models.ErrorResponse) *UpdateUserProfileForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user profile forbidden response
+func (o *UpdateUserProfileForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserProfileForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateUserProfileConflictCode is the HTTP code returned for type UpdateUserProfileConflict
+const UpdateUserProfileConflictCode int = 409
+
+/*
+UpdateUserProfileConflict Email or phone already in use
+
+swagger:response updateUserProfileConflict
+*/
+type UpdateUserProfileConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateUserProfileConflict creates UpdateUserProfileConflict with default headers values
+func NewUpdateUserProfileConflict() *UpdateUserProfileConflict {
+
+	return &UpdateUserProfileConflict{}
+}
+
+// WithPayload adds the payload to the update user profile conflict response
+func (o *UpdateUserProfileConflict) WithPayload(payload *models.ErrorResponse) *UpdateUserProfileConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user profile conflict response
+func (o *UpdateUserProfileConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserProfileConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -19,6 +19,11 @@ VerifyOTPOK Successful login
 swagger:response verifyOTPOK
 */
 type VerifyOTPOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.VerifyOTPResponse `json:"body,omitempty"`
 }
 
 // NewVerifyOTPOK creates VerifyOTPOK with default headers values
@@ -27,19 +32,124 @@ func NewVerifyOTPOK() *VerifyOTPOK {
 	return &VerifyOTPOK{}
 }
 
+// WithPayload adds the payload to the verify o t p o k response
+func (o *VerifyOTPOK) WithPayload(payload *models.VerifyOTPResponse) *VerifyOTPOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the verify o t p o k response
+func (o *VerifyOTPOK) SetPayload(payload *models.VerifyOTPResponse) {
+	o.Payload = payload
+}
+
 // WriteResponse to the client
 func (o *VerifyOTPOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.Header().Del(runtime.HeaderContentType) // Remove Content-Type on empty responses
-
 	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// VerifyOTPAcceptedCode is the HTTP code returned for type VerifyOTPAccepted
+const VerifyOTPAcceptedCode int = 202
+
+/*
+VerifyOTPAccepted Code accepted, second factor required
+
+swagger:response verifyOTPAccepted
+*/
+type VerifyOTPAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.MFAChallenge `json:"body,omitempty"`
+}
+
+// NewVerifyOTPAccepted creates VerifyOTPAccepted with default headers values
+func NewVerifyOTPAccepted() *VerifyOTPAccepted {
+
+	return &VerifyOTPAccepted{}
+}
+
+// WithPayload adds the payload to the verify o t p accepted response
+func (o *VerifyOTPAccepted) WithPayload(payload *models.MFAChallenge) *VerifyOTPAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the verify o t p accepted response
+func (o *VerifyOTPAccepted) SetPayload(payload *models.MFAChallenge) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *VerifyOTPAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// VerifyOTPBadRequestCode is the HTTP code returned for type VerifyOTPBadRequest
+const VerifyOTPBadRequestCode int = 400
+
+/*
+VerifyOTPBadRequest Invalid identifier
+
+swagger:response verifyOTPBadRequest
+*/
+type VerifyOTPBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewVerifyOTPBadRequest creates VerifyOTPBadRequest with default headers values
+func NewVerifyOTPBadRequest() *VerifyOTPBadRequest {
+
+	return &VerifyOTPBadRequest{}
+}
+
+// WithPayload adds the payload to the verify o t p bad request response
+func (o *VerifyOTPBadRequest) WithPayload(payload *models.ErrorResponse) *VerifyOTPBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the verify o t p bad request response
+func (o *VerifyOTPBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *VerifyOTPBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
 
 // VerifyOTPUnauthorizedCode is the HTTP code returned for type VerifyOTPUnauthorized
 const VerifyOTPUnauthorizedCode int = 401
 
 /*
-VerifyOTPUnauthorized Invalid OTP
+VerifyOTPUnauthorized Invalid or expired OTP
 
 swagger:response verifyOTPUnauthorized
 */
@@ -79,3 +189,48 @@ func (o *VerifyOTPUnauthorized) WriteResponse(rw http.ResponseWriter, producer r
 		}
 	}
 }
+
+// VerifyOTPTooManyRequestsCode is the HTTP code returned for type VerifyOTPTooManyRequests
+const VerifyOTPTooManyRequestsCode int = 429
+
+/*
+VerifyOTPTooManyRequests Too many wrong attempts, request a new OTP
+
+swagger:response verifyOTPTooManyRequests
+*/
+type VerifyOTPTooManyRequests struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewVerifyOTPTooManyRequests creates VerifyOTPTooManyRequests with default headers values
+func NewVerifyOTPTooManyRequests() *VerifyOTPTooManyRequests {
+
+	return &VerifyOTPTooManyRequests{}
+}
+
+// WithPayload adds the payload to the verify o t p too many requests response
+func (o *VerifyOTPTooManyRequests) WithPayload(payload *models.ErrorResponse) *VerifyOTPTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the verify o t p too many requests response
+func (o *VerifyOTPTooManyRequests) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *VerifyOTPTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
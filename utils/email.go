@@ -9,8 +9,8 @@ import (
 	"os"
 )
 
-// 💎 FINAL PREMIUM TEMPLATE
-const resetTemplate = `
+// 💎 FINAL PREMIUM TEMPLATE (branded mail with one call-to-action link)
+const linkTemplate = `
 <!DOCTYPE html>
 <html>
 <head>
@@ -41,7 +41,7 @@ const resetTemplate = `
           <!-- Title -->
           <tr>
             <td align="center">
-              <h2 style="margin:0; color:#111;">Reset your password</h2>
+              <h2 style="margin:0; color:#111;">{{.Title}}</h2>
             </td>
           </tr>
 
@@ -49,7 +49,7 @@ const resetTemplate = `
           <tr>
             <td style="padding:20px; text-align:center; color:#555;">
               Hi <b>{{.Name}}</b>,<br><br>
-              We received a request to reset your password for your <b>Adornme</b> account.
+              {{.Intro}}
             </td>
           </tr>
 
@@ -71,7 +71,7 @@ const resetTemplate = `
                          text-decoration:none;
                          border-radius:10px;
                        ">
-                       Reset Password →
+                       {{.Button}} →
                     </a>
 
                   </td>
@@ -173,7 +173,7 @@ const resetTemplate = `
           <!-- Footer -->
           <tr>
             <td style="padding-top:15px; text-align:center; font-size:12px; color:#777;">
-              ⏳ Link expires in <b>15 minutes</b><br><br>
+              ⏳ Link expires in <b>{{.Expiry}}</b><br><br>
               If you didn’t request this, ignore this email.
               <br><br>
               <span style="font-size:11px; color:#aaa;">
@@ -193,8 +193,12 @@ const resetTemplate = `
 `
 
 type emailData struct {
-	Name string
-	Link string
+	Name   string
+	Link   string
+	Title  string
+	Intro  template.HTML
+	Button string
+	Expiry string
 }
 
 type EmailConfig struct {
@@ -214,16 +218,40 @@ func loadEmailConfig() EmailConfig {
 }
 
 func SendResetEmail(to, name, link string) error {
+	return sendLinkEmail(to, "Reset your password", emailData{
+		Name:   name,
+		Link:   link,
+		Title:  "Reset your password",
+		Intro:  "We received a request to reset your password for your <b>Adornme</b> account.",
+		Button: "Reset Password",
+		Expiry: "15 minutes",
+	})
+}
+
+// SendMagicLinkEmail mails a one-time sign-in link
+func SendMagicLinkEmail(to, name, link string, validMinutes int) error {
+	return sendLinkEmail(to, "Your Adornme sign-in link", emailData{
+		Name:   name,
+		Link:   link,
+		Title:  "Sign in to Adornme",
+		Intro:  "Use the button below to sign in to your <b>Adornme</b> account. It works once, on the device you asked from.",
+		Button: "Sign In",
+		Expiry: fmt.Sprintf("%d minutes", validMinutes),
+	})
+}
+
+// sendLinkEmail renders linkTemplate and sends it with the inline logo
+func sendLinkEmail(to, subject string, data emailData) error {
 
 	config := loadEmailConfig()
 
-	tmpl, err := template.New("reset").Parse(resetTemplate)
+	tmpl, err := template.New("link").Parse(linkTemplate)
 	if err != nil {
 		return err
 	}
 
 	var body bytes.Buffer
-	err = tmpl.Execute(&body, emailData{Name: name, Link: link})
+	err = tmpl.Execute(&body, data)
 	if err != nil {
 		return err
 	}
@@ -239,7 +267,7 @@ func SendResetEmail(to, name, link string) error {
 	msg := fmt.Sprintf(
 		"From: Adornme Support <%s>\r\n"+
 			"To: %s\r\n"+
-			"Subject: Reset your password\r\n"+
+			"Subject: %s\r\n"+
 			"MIME-Version: 1.0\r\n"+
 			"Content-Type: multipart/related; boundary=%s\r\n\r\n"+
 
@@ -254,6 +282,7 @@ func SendResetEmail(to, name, link string) error {
 			"--%s--",
 		config.FromEmail,
 		to,
+		subject,
 		boundary,
 		boundary,
 		body.String(),
@@ -272,3 +301,188 @@ func SendResetEmail(to, name, link string) error {
 		[]byte(msg),
 	)
 }
+
+func SendOTPEmail(to, otp string) error {
+
+	config := loadEmailConfig()
+
+	msg := fmt.Sprintf(
+		"From: Adornme Support <%s>\r\n"+
+			"To: %s\r\n"+
+			"Subject: Your Adornme login code\r\n"+
+			"MIME-Version: 1.0\r\n"+
+			"Content-Type: text/plain; charset=UTF-8\r\n\r\n"+
+			"Your one-time login code is %s. It expires in 5 minutes.\r\n"+
+			"If you did not request it, you can ignore this email.\r\n",
+		config.FromEmail,
+		to,
+		otp,
+	)
+
+	auth := smtp.PlainAuth("", config.FromEmail, config.Password, config.SMTPHost)
+
+	return smtp.SendMail(
+		config.SMTPHost+":"+config.SMTPPort,
+		auth,
+		config.FromEmail,
+		[]string{to},
+		[]byte(msg),
+	)
+}
+
+func SendVerificationEmail(to, name, link string) error {
+
+	config := loadEmailConfig()
+
+	msg := fmt.Sprintf(
+		"From: Adornme Support <%s>\r\n"+
+			"To: %s\r\n"+
+			"Subject: Confirm your email address\r\n"+
+			"MIME-Version: 1.0\r\n"+
+			"Content-Type: text/plain; charset=UTF-8\r\n\r\n"+
+			"Hi %s,\r\n\r\n"+
+			"Please confirm your email address by opening the link below. It expires in 24 hours.\r\n\r\n"+
+			"%s\r\n\r\n"+
+			"If you did not create an Adornme account, you can ignore this email.\r\n",
+		config.FromEmail,
+		to,
+		name,
+		link,
+	)
+
+	auth := smtp.PlainAuth("", config.FromEmail, config.Password, config.SMTPHost)
+
+	return smtp.SendMail(
+		config.SMTPHost+":"+config.SMTPPort,
+		auth,
+		config.FromEmail,
+		[]string{to},
+		[]byte(msg),
+	)
+}
+
+func SendEmailChangeEmail(to, name, link string) error {
+
+	config := loadEmailConfig()
+
+	msg := fmt.Sprintf(
+		"From: Adornme Support <%s>\r\n"+
+			"To: %s\r\n"+
+			"Subject: Confirm your new email address\r\n"+
+			"MIME-Version: 1.0\r\n"+
+			"Content-Type: text/plain; charset=UTF-8\r\n\r\n"+
+			"Hi %s,\r\n\r\n"+
+			"Open the link below to use this address for your Adornme account. It expires in 24 hours.\r\n\r\n"+
+			"%s\r\n\r\n"+
+			"If you did not ask for this change, you can ignore this email.\r\n",
+		config.FromEmail,
+		to,
+		name,
+		link,
+	)
+
+	auth := smtp.PlainAuth("", config.FromEmail, config.Password, config.SMTPHost)
+
+	return smtp.SendMail(
+		config.SMTPHost+":"+config.SMTPPort,
+		auth,
+		config.FromEmail,
+		[]string{to},
+		[]byte(msg),
+	)
+}
+
+// SendSecurityNotice tells the account owner about a sensitive change (email or password)
+func SendSecurityNotice(to, name, change string) error {
+
+	config := loadEmailConfig()
+
+	msg := fmt.Sprintf(
+		"From: Adornme Support <%s>\r\n"+
+			"To: %s\r\n"+
+			"Subject: Your Adornme account was changed\r\n"+
+			"MIME-Version: 1.0\r\n"+
+			"Content-Type: text/plain; charset=UTF-8\r\n\r\n"+
+			"Hi %s,\r\n\r\n"+
+			"%s\r\n\r\n"+
+			"If this wasn't you, reset your password right away and contact support.\r\n",
+		config.FromEmail,
+		to,
+		name,
+		change,
+	)
+
+	auth := smtp.PlainAuth("", config.FromEmail, config.Password, config.SMTPHost)
+
+	return smtp.SendMail(
+		config.SMTPHost+":"+config.SMTPPort,
+		auth,
+		config.FromEmail,
+		[]string{to},
+		[]byte(msg),
+	)
+}
+
+// SendDataExportEmail sends the download link of a finished personal data export
+func SendDataExportEmail(to, name, link string, validHours int) error {
+
+	config := loadEmailConfig()
+
+	msg := fmt.Sprintf(
+		"From: Adornme Support <%s>\r\n"+
+			"To: %s\r\n"+
+			"Subject: Your Adornme data export is ready\r\n"+
+			"MIME-Version: 1.0\r\n"+
+			"Content-Type: text/plain; charset=UTF-8\r\n\r\n"+
+			"Hi %s,\r\n\r\n"+
+			"The copy of your personal data you asked for is ready. Download it from the link below; it expires in %d hours.\r\n\r\n"+
+			"%s\r\n\r\n"+
+			"If you did not ask for an export, reset your password right away and contact support.\r\n",
+		config.FromEmail,
+		to,
+		name,
+		validHours,
+		link,
+	)
+
+	auth := smtp.PlainAuth("", config.FromEmail, config.Password, config.SMTPHost)
+
+	return smtp.SendMail(
+		config.SMTPHost+":"+config.SMTPPort,
+		auth,
+		config.FromEmail,
+		[]string{to},
+		[]byte(msg),
+	)
+}
+
+// SendErasureConfirmation tells a former customer their personal data was erased
+func SendErasureConfirmation(to, name string) error {
+
+	config := loadEmailConfig()
+
+	msg := fmt.Sprintf(
+		"From: Adornme Support <%s>\r\n"+
+			"To: %s\r\n"+
+			"Subject: Your Adornme account was deleted\r\n"+
+			"MIME-Version: 1.0\r\n"+
+			"Content-Type: text/plain; charset=UTF-8\r\n\r\n"+
+			"Hi %s,\r\n\r\n"+
+			"As you asked, we have erased the personal data of your Adornme account. "+
+			"Invoices and order records we must keep by law no longer carry your name or contact details.\r\n\r\n"+
+			"This is the last email you will get from us.\r\n",
+		config.FromEmail,
+		to,
+		name,
+	)
+
+	auth := smtp.PlainAuth("", config.FromEmail, config.Password, config.SMTPHost)
+
+	return smtp.SendMail(
+		config.SMTPHost+":"+config.SMTPPort,
+		auth,
+		config.FromEmail,
+		[]string{to},
+		[]byte(msg),
+	)
+}
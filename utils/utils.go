@@ -3,9 +3,10 @@ package utils
 import (
 	"context"
 	"log"
+	"net"
+	"net/http"
 	"net/mail"
 	"os"
-	"regexp"
 	"strings"
 	"time"
 )
@@ -44,40 +45,42 @@ func RequestIDFromContext(ctx context.Context) string {
 	return ""
 }
 
-func IsEmail(input string) bool {
-	_, err := mail.ParseAddress(input)
-	return err == nil
+// WithClientInfo stores the caller's device details (used for session tracking)
+func WithClientInfo(ctx context.Context, userAgent, ip string) context.Context {
+	ctx = context.WithValue(ctx, "userAgent", userAgent)
+	return context.WithValue(ctx, "clientIP", ip)
 }
 
-func NormalizeEmail(email string) string {
-	return strings.ToLower(strings.TrimSpace(email))
-}
-
-func IsValidIndianPhone(phone string) bool {
-	if len(phone) != 10 {
-		return false
+func ClientInfoFromContext(ctx context.Context) (userAgent, ip string) {
+	if v, ok := ctx.Value("userAgent").(string); ok {
+		userAgent = v
 	}
-	return phone[0] >= '6' && phone[0] <= '9'
+	if v, ok := ctx.Value("clientIP").(string); ok {
+		ip = v
+	}
+	return userAgent, ip
 }
 
-var phoneRegex = regexp.MustCompile(`^\+?[0-9]{10,15}$`)
-
-func CleanInput(input string) string {
-	input = strings.TrimSpace(input)
-	input = strings.ReplaceAll(input, "\u00A0", "")
-	input = strings.ReplaceAll(input, "＋", "+")
-	return input
+// ClientIP returns the originating client IP, honouring proxy headers
+func ClientIP(r *http.Request) string {
+	if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+		return strings.TrimSpace(strings.Split(fwd, ",")[0])
+	}
+	if ip := r.Header.Get("X-Real-IP"); ip != "" {
+		return strings.TrimSpace(ip)
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
 }
 
-func IsPhone(input string) bool {
-	input = CleanInput(input)
-	return phoneRegex.MatchString(input)
+func IsEmail(input string) bool {
+	_, err := mail.ParseAddress(input)
+	return err == nil
 }
 
-func NormalizePhone(phone string) string {
-	phone = CleanInput(phone)
-	phone = strings.ReplaceAll(phone, " ", "")
-	phone = strings.TrimPrefix(phone, "+91")
-	phone = strings.TrimPrefix(phone, "91")
-	return phone
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
 }